@@ -12,13 +12,13 @@ var ErrFileNotFound = errors.New("Not Found")
 var cssbootstrapMinCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\x7b\x8f\xe3\x38\x92\x20\xfe\xff\x7d\x0a\x6f\x15\x0a\xd5\x39\x2d\xb9\x24\xd9\xf2\x2b\x51\x8d\x9d\x1d\xec\xe2\x16\xe8\x9e\x3f\xa6\x6f\x81\x03\xfa\xea\x00\xd9\xa2\x6d\x4d\xe9\x75\x92\xb2\x52\xd9\x46\xee\x67\xff\x81\x2f\x89\x8f\x20\x25\xd9\xce\x9a\x99\xfd\xed\xf6\x4e\xa5\x4c\x06\x23\x82\x11\x41\x2a\x82\x22\x83\x9f\xfe\xf0\x4f\xff\x63\xf6\x87\xd9\xbf\x14\x45\x53\x37\x55\x54\xce\xbe\x2d\xe7\xcb\xb9\x3f\xfb\xe1\xdc\x34\x65\xbd\xfb\xf4\xe9\x84\x9a\x3d\xaf\x9c\x1f\x8a\xec\xd3\x03\x86\xff\x53\x51\xbe\x54\xc9\xe9\xdc\xcc\x02\xcf\xf7\xdd\xc0\xf3\xb7\xb3\xff\x75\x46\x02\x9e\x3f\x3e\x35\xe7\xa2\xaa\x8d\xc0\xcf\x49\xd3\xa0\xca\x99\xfd\x7b\x7e\x98\x63\xa0\x9f\x93\x03\xca\x6b\x14\xcf\x9e\xf2\x18\x55\xb3\x5f\xfe\xfd\x7f\x09\x3c\x24\xcd\xf9\x69\x4f\xa8\x37\xcf\xfb\xfa\x53\xc7\xd0\xa7\x7d\x5a\xec\x3f\x65\x51\xdd\xa0\xea\xd3\xcf\xff\xfe\xa7\x7f\xfd\xf3\xaf\xff\x8a\xf9\xfb\xb4\xab\x8a\xa2\xb9\xb8\xee\x3e\x7d\x42\xbb\xf7\x9e\xb7\xde\x1f\x8f\x8f\xae\x9b\xe4\x71\x72\x2a\x76\xef\x57\x2b\xdf\x3b\x06\x8f\xae\x5b\x3e\x55\x65\x8a\x76\xef\x57\xc7\x65\x70\xf0\x71\x41\x92\x7f\xdd\xbd\x47\x9b\x05\xda\x1c\x1e\x5d\xb7\x42\xf1\xee\x7d\x7c\x58\x84\xcb\xf0\xd1\x75\x8b\x2a\xca\x4f\x68\xf7\xfe\x18\xaf\x91\xbf\x7c\x74\xdd\x17\x94\xa6\xc5\xf3\xee\xfd\xf1\x78\xf0\xbd\xf5\xa3\xeb\x9e\x2a\x84\xf2\xdd\xfb\x60\x13\xad\x49\x8b\x06\x45\xe9\xee\x7d\xe0\x1d\xb6\x5b\x5c\x7d\x78\x89\xf2\xdd\x7b\x7f\x1d\x05\xfb\xcd\xa3\xeb\x3e\x9f\x93\x06\xa3\x23\xbc\x9d\xaa\xe8\x65\xf7\x7e\x75\x58\x87\xeb\x98\xfd\x74\xe3\xa8\xfa\xba\x7b\xbf\x58\x2e\xa2\xa5\x87\x99\xab\x92\x2c\xaa\x5e\x84\x0e\xd5\xe8\x50\xe4\x31\x29\xeb\x5a\xd6\x4f\x87\x03\xaa\x6b\x81\x8b\x24\x3f\x16\x22\xd9\xa8\xca\x93\xfc\x24\xb0\x1d\xe3\x7e\x55\x42\x4f\x53\xac\xae\xdd\xfb\xe3\xe6\xb8\x3d\x46\x04\x40\x62\x64\x5f\xa1\xe8\x6b\x59\x24\x79\xe3\xb6\xf5\x4e\x29\xa9\xb3\x5d\xb8\x5e\x95\xad\x5c\x9a\xc5\xbb\xf5\x6a\xa3\x96\xa6\xa7\xdd\x76\x1b\xa8\xa5\x6d\xba\xf3\x03\xcf\x23\xc5\xc7\x22\x6f\xdc\x63\x94\x25\xe9\x8b\x5b\x47\x79\xed\xd6\xa8\x4a\x8e\x3b\x37\x2a\xcb\x14\xb9\xf5\x4b\xdd\xa0\xcc\xf9\x97\x34\xc9\xbf\xfe\x12\x1d\x7e\x25\x3f\xff\xad\xc8\x1b\xe7\xdd\xaf\xe8\x54\xa0\xd9\x7f\xfc\xfb\x3b\xe7\x2f\xc5\xbe\x68\x0a\xe7\xdd\xff\x44\xe9\x37\xd4\x24\x87\x68\xf6\x67\xf4\x84\xde\x39\x7f\xac\x92\x28\x75\xde\xfd\xb9\x68\x8a\xd9\xaf\x51\x5e\xbf\x73\x7a\x02\xce\xbb\x3f\x62\x02\xb3\x3f\x15\x69\x51\xcd\xfe\x35\x2b\xfe\x9a\xbc\xeb\x71\xea\x05\xbf\xbe\x64\xfb\x22\x7d\xc7\xb0\x89\xad\x94\x3e\x64\x45\x5e\xd4\x65\x74\x40\xbb\x5f\xff\xed\x97\x22\x2f\xdc\xbf\xa0\xd3\x53\x1a\x55\xce\x2f\x28\x4f\x0b\xe7\x97\x22\x8f\x0e\x85\xf3\xa7\x22\xaf\x8b\x34\xaa\x9d\x77\x3f\x27\x7b\x54\x45\x4d\x52\xe4\x33\x0c\xfe\xce\x79\xf7\xa7\xe2\xa9\x4a\x50\x35\xfb\x33\x7a\x7e\xe7\x74\xe8\x5e\xff\xe0\xec\x76\xd1\x11\x8f\xa9\xdd\x6e\x8f\x8e\x45\x85\x2e\xfb\xa2\x75\xeb\xe4\x77\xac\xeb\x7d\x51\xc5\xa8\x72\xf7\x45\xfb\x7a\x6e\xb2\xf4\x22\xb0\xb4\xeb\x7b\xfd\x98\x26\x39\x72\xcf\x88\x68\xdf\x9f\xfb\xe1\xa3\xfb\x8c\xf6\x5f\x93\xc6\x6d\x50\xdb\x60\x5c\xc8\x8d\xe2\xbf\x3e\xd5\xcd\xce\xf7\xbc\x0f\x7d\x6d\x54\xba\xe7\xe4\x74\x26\x66\xe3\x1e\x70\xef\x77\x4d\x15\xe5\x75\x19\x55\x28\x6f\x5e\xa3\xaa\x49\x0e\x29\x72\xa2\x3a\x89\x91\x73\x4c\x4e\x87\xa8\xc4\x5d\xc2\x8f\x4f\x15\x72\x8e\x45\x81\x19\x3f\xa3\x28\xc6\x7f\x4e\x55\xf1\x54\x3a\x59\x94\xe4\x4e\x1e\x7d\x73\x6a\x74\xc0\xc0\x97\x38\xa9\xcb\x34\x7a\xd9\xed\xd3\xe2\xf0\xf5\x75\x5f\xc4\x2f\x97\x2c\xaa\x4e\x49\xbe\xf3\x1e\xc5\xfe\xfc\x03\xd9\x06\x61\x1b\x8b\x75\xe7\x57\x28\xa3\x3f\x9f\xa9\xf8\x97\x9e\xa7\xa8\x23\x7c\xa4\x92\x7d\x1f\xf8\x41\x18\x6c\x1f\x89\x4e\xa2\x34\x39\xe5\xbb\x14\x1d\x9b\xc7\x7d\x74\xf8\x8a\x45\x97\xc7\x4c\x05\x78\x66\x79\xfd\xad\x89\xf6\x49\x1e\xa3\xf6\xf3\x3b\xd7\x7f\xf7\x65\x77\x2c\x0e\x4f\xf5\x2e\x2f\x9a\x1f\xe8\xa3\xfb\x2d\xa9\x93\x7d\x8a\x1e\x2e\xc5\x53\x83\x09\xee\xbc\x7f\x4a\xb2\xb2\xa8\x9a\x28\x6f\x5e\xcf\x95\x68\x45\x87\x22\x6f\x50\xde\x60\x33\x7a\x64\x6c\x79\x8f\xc5\x37\x54\x1d\xf1\x34\xc8\x10\xbd\x9e\x7d\xe7\x1c\x38\xe7\x85\x73\x5e\x3a\xe7\xd0\x39\xaf\x98\x9a\xdc\xa6\x28\x77\xde\x23\xfb\xb1\x2f\x9a\xa6\xc8\x76\xf3\xb0\x42\xd9\x6b\x69\x03\xc1\xa2\x79\x8d\xf6\xfb\xea\xb7\x38\x6a\x22\xb7\xa8\x92\x53\x92\x47\xa9\xdb\x24\x4d\x8a\xbe\x38\xa4\x86\x3e\x5f\x88\x48\x62\x74\x28\xe8\x90\xd9\x91\x97\x09\xee\x94\x6c\xc8\x10\xc4\x2c\x2e\x9a\x06\xc5\x8f\x83\x00\x87\xa7\xaa\x2e\xaa\xdd\x19\xa5\xe5\x63\x37\xac\x08\xa3\x9e\x89\x8a\x5b\x7f\x4d\x4a\x17\xbf\x5a\xf2\x22\x47\x8f\xd6\xda\xd7\x28\x8e\x2b\x54\xd7\x17\x5d\x08\xcc\x5c\x9a\x97\x14\xed\xf2\xa2\xca\xa2\x54\xb2\x90\x24\x3f\xa3\x2a\x69\x5e\xe3\xd4\x29\x52\xe7\x29\x1d\x94\x69\x91\xce\x0a\x0c\x3b\x7b\xc2\xe0\x33\xd2\x68\xd6\xb7\xe3\xbd\x7a\x8d\x9b\x8b\x68\x99\x6b\xcf\x7b\x8d\xe3\x0b\xa0\x47\x4e\x04\x9b\xe3\xce\x7b\x25\x03\xf5\xff\x3d\x15\x0d\xea\x06\xea\xcc\x9b\x11\xd2\x7b\xa7\x6e\xaa\x22\x3f\x49\x88\xf7\x45\x1a\xa3\xea\xb5\xce\xa2\x94\xcd\x50\x64\x68\x6c\xbc\x0f\xaf\xf5\xd3\xde\xa9\x9f\xca\x4b\x59\xd4\x09\x51\x4b\x85\xd2\xa8\x49\xbe\x21\x61\x08\xad\xc3\x0f\x92\x3c\xbc\xc7\x6f\x08\x4f\x3c\x51\xca\x46\xc9\x3e\xaa\x11\x06\xc0\xd8\x2e\x8c\x6f\x77\x1e\x84\x28\x7b\xc5\xb8\xb1\x9c\xdc\x39\xfe\x15\x5d\xd8\x00\x62\x2f\x5a\xd5\x26\x88\x16\xb5\xe1\x26\xcd\x78\xbb\x33\x1e\x17\x3d\x9e\x70\xb5\x5f\x98\x6d\xeb\x35\x22\x23\xf2\xb7\x73\x85\x8e\x5f\x1e\x58\x2b\xa6\x4f\x90\xba\xdc\x40\x22\x66\x6d\x76\x28\x62\xe4\x7c\xdd\xc7\x4e\x59\x21\xa7\x8e\xb2\x52\x7a\x13\xdc\xf7\x95\x24\xce\x6e\x78\x8c\x57\x68\xc8\x22\xfb\xb9\x24\x7a\x6a\x8a\x57\xfa\x76\xd0\x6c\x27\xc9\x4e\x17\x45\xb3\x59\x12\xc7\x29\xe2\xc3\x91\x8f\x10\xac\xe9\x6f\xa7\x4b\x87\xf4\x9c\xc4\x31\xca\x1f\xc1\xb6\xaf\x4d\xb4\x4f\xf1\x2b\x93\x60\x38\x14\x69\x1a\x95\x35\xda\xf1\x87\x57\xf6\xc6\xba\x94\x51\x1c\x27\xf9\x89\x74\x61\xbe\x26\x46\xcf\x8b\xf8\x48\xa0\xa5\x4c\xf3\xcc\x2d\x53\xe7\x6a\x86\xce\xc5\x2f\xc4\x1d\x6d\xf8\xda\x9c\x2f\x02\x18\x1f\xcd\x69\xb4\x47\x69\xf7\xee\x4b\x72\x62\xe1\x64\x64\x81\x33\xe9\xfe\xa9\x69\x8a\x9c\xf7\xa3\x8a\xe2\xe4\xa9\xc6\x43\x91\x14\xd3\x19\xbf\x9b\xe8\xfd\xb2\xe5\x93\x1a\x2f\x0a\xcb\x76\x86\x65\x3f\xe3\x33\x19\x7d\x47\x54\xb8\x7f\xa4\x47\x0c\x93\x93\xe4\xe5\x53\xe3\x14\x65\x43\x5f\xd6\x35\x4a\xd1\xa1\x71\x30\xff\x51\x85\x22\xf8\xc5\xcc\x2d\xb3\xb7\x0b\x5e\x02\x4d\x63\x22\xa1\x8b\xf6\x92\x61\xb5\x94\x2e\x95\x1b\x19\x7f\xc7\xa2\xca\x98\xea\x69\xd5\x73\x51\xc5\xee\x73\x15\x95\x6c\xca\x7c\xfd\xad\x79\x29\xd1\x67\xda\xfe\x8b\x43\x7f\x55\xa8\x46\x0d\xff\x51\x3f\xed\xb3\xa4\xf9\xe2\x30\x51\x72\x49\x44\x65\x89\xa2\x2a\xca\x0f\x68\x47\x6b\x64\x4c\xf4\x95\x1a\x27\x35\xb6\xa3\xf8\x41\x42\x0c\xd7\x31\x3a\x6a\x25\x53\x95\x5c\x7a\x61\x6f\x1d\xe2\x20\xa3\x4a\x21\xbd\x73\xb3\xe2\x77\xa6\xaa\x24\xcf\x51\x25\x53\x37\x55\x73\x06\xf4\x7a\xc6\x83\x56\xc1\xcd\x7f\xe7\x01\x63\x8d\x68\x8a\x62\x3e\x9c\xd1\xe1\xeb\xbe\x68\xbf\x38\x42\x21\x36\xc6\xe2\x0b\xec\x95\x3e\x76\x88\x45\x34\x71\xd4\x20\x09\x05\x2e\x68\x92\x0c\xb9\x69\x71\x88\x52\xa9\x2a\x2b\xf2\xe6\x2c\x95\x60\xc0\x2f\x90\xfa\xd2\xa4\x6e\xb0\x27\xdc\x59\xab\x34\xed\x3c\x56\x88\xd8\x26\x9f\x26\x5e\x8f\x09\x4a\xe3\x1a\x35\x97\x2c\xc9\xdd\xe7\x24\x6e\xce\x3b\xaf\xe7\xf7\xb1\x33\x75\xda\x9b\x9d\xf7\x9a\xa2\x13\xca\x63\xd9\x5f\x7d\xa4\x0d\x89\xdb\x9c\x45\xad\x2b\xfc\x54\x51\xc9\xaf\x54\x61\x12\xa5\x05\xc0\x58\x79\x94\x67\x7e\x12\x57\xba\x34\xca\x60\x46\x5f\x56\xc5\x89\x78\x15\xa6\x57\x22\x15\x59\xfe\x94\xed\x51\x85\x2d\x82\x49\x8d\x68\xdd\xad\x4b\xcc\x15\x1d\x71\x06\xc0\xe2\xa9\x91\x01\x2f\x8c\x45\x32\x93\x33\x7b\x43\x51\x75\x38\x7f\xe1\xf3\x8f\x5b\x1c\x8f\x35\x6a\x76\x2e\x09\x00\x75\x35\x11\xa3\x92\x5a\xf6\xe4\x68\x81\xf0\x7a\xbb\x98\x10\xf4\x6d\x8e\x49\x8a\xdc\xa7\x32\x2d\xa2\x98\xf3\x88\x85\xdb\x89\xcd\x3c\xd0\x8b\xa7\x06\x4f\x42\xd0\x24\xfc\x5a\x3f\x65\x38\x28\xef\x2a\xb1\x71\xb9\x49\x83\xdf\x00\xf2\x90\x6d\x50\x56\xa6\x51\x83\x3a\x48\xda\x3f\xfa\x52\xfa\x22\x95\x0a\x0e\xf8\xfc\xec\x3b\xf3\x73\xe0\xcc\xcf\x0b\x67\x7e\x5e\x3a\xf3\x73\xe8\xcc\xcf\x2b\xc7\xe8\x64\xeb\xc6\xc3\xbc\xab\x50\x0b\x28\x02\x82\xfd\xec\x0b\xbe\x56\x40\xdf\x22\x98\xe2\x39\x10\xcb\x69\xe9\xc2\x39\x2f\x2e\xa2\x45\xae\x19\xf8\xd2\x39\x2f\x2f\xaa\xa9\xbe\x62\x66\xcf\xa1\x54\x1e\xb0\x8a\x15\x66\x59\x0e\x7f\x5e\xe7\x29\x8a\x62\x1d\x5a\xea\xc5\xc2\xf3\x5e\xe7\x4c\x56\xae\xc8\xf9\x0a\x80\xd4\xfb\xcb\x5b\x8a\x7d\x0b\xe7\xe1\xa4\xb6\xa2\x04\x96\x13\xdb\x8a\x42\x5a\x8c\x6b\x7b\xae\x44\xb7\xc9\x17\xdc\x6c\xd1\x73\xe2\x93\x0f\x9f\x97\x09\x6c\xd9\xce\xea\x22\x4d\xe2\x59\x75\xda\x47\x3f\x78\x0e\xfe\x6f\xee\x3f\xbc\xce\x89\x8b\xed\x00\x8e\xb6\x1a\x82\xbe\xce\xb3\xa8\xfa\xea\xe0\x7f\xba\xb9\x7f\x1e\x60\x7a\x7a\xb0\x79\x38\x6e\xd0\xe2\x75\x4e\x06\xc0\x53\x4e\x5e\x0c\x71\xe7\x2f\xd1\x90\xe0\x91\x54\x0a\xef\x0c\x0a\x4d\x07\xd4\x14\x58\x32\xc2\xe0\x01\xa9\x81\xd1\x57\x69\x1a\xd5\x8d\x7b\x38\x27\x69\xfc\xc0\xe5\x49\x16\x25\x99\xdf\x34\x4f\xf2\xa4\x49\xa2\x34\xa9\x33\x41\x22\x5b\xef\xc3\xa3\xe2\x5b\x3c\x95\x25\xaa\x0e\x51\x8d\x5e\xe7\x5a\x7c\x03\x04\x6b\x92\xd5\xf7\x0d\x5c\xba\xe4\xa1\xbc\x23\x64\x55\x48\x1e\x24\xd0\xb8\x5b\xe7\x61\x61\xf9\xee\xdd\xff\x09\x3c\x7f\xf9\x7f\x3c\xef\x8f\xde\xbb\xd7\x79\x92\x9d\xdc\x63\xfa\x94\xe0\x48\x4d\x7a\xdf\x88\x13\x33\x81\x6a\xce\x4f\xd9\x3e\x8f\x92\x54\x50\x31\x31\x4d\x70\x45\x81\x9b\x5a\x6f\x5d\xef\x63\x84\x02\xb4\x7a\x94\x9d\x4e\x86\xc3\x46\x9c\x39\xf8\xb0\x1a\x69\xa5\x8b\xbd\x7d\x68\x6a\x93\x86\x49\x07\xcd\xdd\x74\x59\x85\xb2\x24\x71\x0c\x24\x5a\xfd\x7a\x1e\x76\x20\x6c\xe5\xb7\x77\x1a\xc9\xb2\xa4\x8b\x7f\xbf\x46\x3f\x91\x96\xd2\xbb\xf6\xf5\xeb\x3e\x16\xa4\x56\xa1\x6c\x36\x5f\xca\xda\x97\xf0\x13\xf9\x69\x52\x65\x0b\x3a\xaa\xfc\xb0\xcd\x7c\xdd\xc7\x33\x91\x86\x27\xda\x95\xa7\x0c\x58\x1c\x99\x97\x82\x40\x35\xab\x12\x59\xa1\x44\x31\xfc\x4c\x91\x88\xc1\xab\xc0\x32\x21\xe2\xe0\x4e\xc5\xbc\xac\x90\x5b\x1f\x2a\x1c\x23\xe1\xe0\x09\xab\x9a\x69\x64\xb1\xf4\xca\xb6\x0b\xe7\xdc\x97\x1d\x05\x7b\x9d\x63\x5b\x8d\x12\xec\x4a\xea\x1e\x10\x1b\x90\x7e\x58\x76\xfe\x20\x9d\x0b\x48\x89\x34\x68\x89\xa3\x26\xae\x36\x10\x83\xfa\xe7\x0c\xc5\x49\x34\xfb\xa1\x77\xd4\xc8\x4a\xf4\xc3\x45\x20\xdb\xdb\x63\x88\x79\x7c\x05\x1a\x91\x85\x6a\x43\xa3\x75\x60\x68\x44\xd6\xb1\x0d\x8d\xb6\x2b\x43\x23\xba\xcc\x6d\x68\xe5\xfb\x94\xc1\xbe\x92\x8e\x67\x47\x28\x48\x4f\xe2\xaf\x4c\xaa\xab\x33\xf1\x57\x9b\xfe\x8d\x24\x2e\xb3\x74\x95\xfc\x6d\x7d\xbc\x4a\x37\xe3\x45\x78\x9d\x16\xaf\x56\x11\xa0\xfd\xaa\x78\xee\x06\xb4\x9b\xd5\xee\x31\x45\x2d\x8e\x98\x78\x19\xfe\xfd\xc8\x2b\xe8\xa4\x85\xff\x79\x54\x7e\x4a\xca\x74\x45\xfd\x12\x6d\x92\x92\xd7\x79\x5e\xb8\xa7\xa7\xa6\x41\x55\x2d\xbf\x24\x3d\x65\x69\x4f\x00\xfc\x69\x7e\x28\x52\x47\x2c\xf8\xed\x90\x46\x75\xfd\x87\xcf\x87\x22\x75\xbf\x5c\x64\x53\xf3\x64\x3b\xf3\x5e\x69\x6b\x0c\xea\xb3\x3f\x1e\xfb\xcb\x7f\x07\xf4\x2f\xfb\xb3\xa0\x7f\x96\xf4\x4f\x48\xff\xac\xe8\x9f\x35\xfd\xb3\xa1\x7f\xb6\xf4\x0f\xb6\x53\xfa\x44\x35\x81\xff\x72\x5a\xf8\xc9\xeb\x1f\x85\xd2\xa0\x7b\xec\x9f\x16\xdd\xd3\xb2\x7b\x0a\xbb\xa7\x55\xf7\xb4\xee\x9e\x36\xdd\xd3\xb6\x7b\xea\xf9\xa1\xb6\x80\xff\x72\x7e\xf0\x93\xd7\x3f\x0a\xa5\x41\xf7\xd8\x3f\x2d\xba\xa7\x65\xf7\x14\x76\x4f\xab\xee\x69\xdd\x3d\x6d\xba\xa7\x6d\xf7\xd4\xf3\x43\xad\x11\xff\xe5\xfc\xe0\x27\xaf\x7f\x14\x4a\x83\xee\xb1\x7f\x5a\x74\x4f\xcb\xee\x29\xec\x9e\x56\xdd\xd3\xba\x7b\xda\x74\x4f\xdb\xee\xa9\xe7\xa7\x4d\xf9\x5f\xce\x4f\xdb\x9b\x47\xdb\x5b\x48\xdb\x1b\x49\xdb\xd9\x49\xdb\x99\x4a\xdb\x59\x4b\xdb\x19\x4c\xdb\xd9\x4c\xdb\x99\x4d\xdb\x59\x4e\xdb\x19\x4f\x4b\xed\x07\x58\x77\x9e\x38\x99\x12\x3b\xbf\x74\xc3\xb4\xac\xd0\x11\x55\x15\x8a\xe9\x1b\xd7\xa3\xa3\x75\x1f\xd5\x09\xf9\x9e\xda\x81\x11\xb2\xdf\xd0\xce\xa7\x00\xa7\xaa\x78\xde\xf9\x8a\x4f\x45\xa6\x08\xec\x4a\xd4\xae\xff\xd3\x1f\x3a\x1a\x74\xc1\x94\xb8\x08\xd2\x2f\x63\xe3\x40\x6d\x1c\x8a\x6d\x43\xa9\x69\x28\xb5\x5c\xa8\x2d\x17\x8b\xf9\x82\xfc\x9f\x80\x40\x28\xeb\xf1\xf4\x85\x02\xba\xa5\x8a\x2e\x08\x05\x3c\xf8\x47\x8f\x20\x08\xc5\x96\xa1\xd6\x52\xec\x42\x20\x75\x21\x90\xba\xb0\xd2\x24\xb7\x9a\xaf\xf0\xff\xad\x45\xf9\xf5\x65\x82\x14\xbb\xc2\xd7\x6e\xbe\x91\x51\x91\x57\xa7\xfc\x8b\x36\x65\xef\x54\x59\x1f\x64\xce\x93\x11\x6c\x74\x69\x6e\x00\x61\x6e\x7a\x59\x92\x09\xf3\x4e\x1d\x5a\x4c\x53\x06\x99\x9d\xef\x60\x0e\x64\x7a\x97\xf1\x2c\x7d\xbd\x0b\x42\x59\x8f\xa7\x2f\xa4\x78\x56\xd3\x0c\x9b\xbc\x4b\x94\x26\x80\x0e\x42\x48\x09\xa1\xa2\x85\x8d\x8c\x67\x05\x68\x61\x05\x69\x61\xa5\x68\x61\x2b\xe3\x59\x8b\x5a\x58\x4b\x5a\x58\x73\x2d\xf8\x9e\x62\x46\x80\x1a\x36\x90\x1a\x36\x8a\x1a\x7c\xc5\x1e\xb7\x80\x1e\xb6\x90\x1e\xb6\x8a\x1e\xfc\x60\xf2\xf4\x44\x63\xa2\x63\x52\xd5\x4d\x3f\x7d\xd2\x08\xd4\xf5\x1f\xf9\x03\x87\xc3\x51\xbe\x0a\xe6\x2f\x1e\xf9\x03\x07\xf3\x54\x18\xef\x91\x2f\xde\x32\x08\x5f\xc3\xc2\x91\x70\x88\x40\x85\x08\x18\x44\xc0\x21\x16\x2a\x04\x67\xa4\xe3\x63\xa9\x42\x2c\x19\xc4\x92\x43\x84\x2a\x44\xc8\x20\x42\x0e\xb1\x52\x21\x56\x0c\x62\xc5\x21\xd6\x2a\xc4\x9a\x41\xac\x39\xc4\x46\x85\xd8\x30\x88\x0d\x87\xd8\xaa\x10\x5b\x06\xb1\xed\x24\xa6\x09\xd5\xe7\x52\xf5\x7b\xb1\xea\x72\xed\x04\xdb\x49\xd6\xd7\x44\xeb\x73\xd9\xfa\x58\xb8\x64\xd9\xd8\xf5\x2f\xa2\x4b\x2a\x8c\x3a\x56\x1f\x48\xf5\xe2\xa4\xc6\x00\x16\x12\x00\x99\xbb\x58\xcd\x52\xaa\x11\x27\x25\x06\x10\x4a\x00\xe2\x6c\xc3\x00\x56\x12\x00\x99\x54\x58\xcd\x5a\xae\xd1\xf9\xde\x48\x00\x2b\x9d\xef\xad\x04\xb0\x16\xf8\xf6\x3d\x59\x26\x3a\xe3\xbe\x2c\x35\x61\x7c\xda\x22\x39\xec\x97\xbd\xb9\xef\x82\xbd\xca\x5b\xdc\x17\xec\x8a\x5e\xed\xc1\x60\xef\xf5\xbe\x4e\x0c\xf6\x82\xaf\xf6\x63\xb0\xe3\x7c\xb5\x2b\x83\x7d\xed\xbb\x79\x33\xcc\x21\xbf\xd5\xa1\xc1\xca\xbd\x87\x4f\x83\x95\x7c\xbf\x9e\x5d\xe1\xd9\x60\xb5\xde\xc9\xb9\xc1\x4a\xbe\x93\x7f\x83\x55\x3e\xdd\xc5\xc1\xa1\xd8\x9d\xbc\x1c\x1c\xcb\xdd\xc9\xd1\xc1\xc1\xe0\x74\x5f\x87\x44\xaa\x77\x72\x77\x48\xa8\x7b\x27\x8f\x87\xc4\xca\x57\x3a\x3d\x75\x36\xda\xef\xa9\xb3\xb1\xae\x4f\x9d\x8d\xf0\x7e\xa4\xe1\x6a\x72\x80\xa4\xb1\x68\xf2\x81\xa4\x51\x66\x72\x83\xa4\x41\x65\xf2\x84\xa4\xe1\x62\x72\x86\xa4\x81\x60\xf2\x87\x24\xbb\x37\xb9\x44\x92\x45\x9b\xbc\x22\xc9\x56\x4d\x8e\x91\x6c\x9a\x66\xdf\x48\x36\x3b\xb3\x7b\x24\x9b\x94\xc5\x43\x22\xaa\x56\xd6\xed\xfa\xaa\x21\xff\x89\xa8\x77\xc0\x85\x22\xda\x35\x79\x51\x44\xab\x03\x8e\x14\x51\xea\x80\x2f\x45\x74\x6a\x72\xa7\x88\x2e\x07\x3c\x2a\xa2\xca\x01\xa7\x8a\x68\xd2\xe4\x57\x51\x0d\x0e\xb8\x56\x54\x7d\x06\xef\xca\xba\xca\x9d\xba\x59\xfc\xe6\xee\x55\x16\xdf\xe6\x5e\x65\xf1\x0d\xee\x55\x16\xdf\xdb\xbd\xca\xe2\x1b\xdc\xab\x2c\xbe\xc1\xbd\xca\xe2\x3b\xba\x57\x6c\xfd\xf5\x56\xf7\x0a\x2b\xf7\x1e\xee\x15\x56\xf2\xfd\x7a\x76\x85\x7b\x85\xd5\x7a\x27\xf7\x0a\x2b\xf9\x4e\xee\x15\x56\xf9\x74\xf7\x2a\x8b\xef\xe6\x5e\x65\xf1\xdd\xdc\xab\x2c\xbe\xc6\xbd\x22\x1f\x26\xee\xe4\x5e\x91\x2f\x1b\x77\x72\xaf\xc8\xa7\x91\x2b\xdd\xab\x2c\x1e\xed\x5e\x65\xf1\x58\xf7\x2a\x8b\x47\xb8\x57\xd2\x70\x35\xb9\x57\xd2\x58\x34\xb9\x57\xd2\x28\x33\xb9\x57\xd2\xa0\x32\xb9\x57\xd2\x70\x31\xb9\x57\xd2\x40\x30\xb9\x57\x92\xdd\x9b\xdc\x2b\xc9\xa2\x4d\xee\x95\x64\xab\x26\xf7\x4a\x36\x4d\xb3\x7b\x25\x9b\x9d\xd9\xbd\x92\x4d\xca\xe2\x5e\x11\x55\xc3\xee\x15\x51\xb0\xdd\xbd\x22\xea\x1d\x70\xaf\x88\x76\x4d\xee\x15\xd1\xea\x80\x7b\x45\x94\x3a\xe0\x5e\x11\x9d\x9a\xdc\x2b\xa2\xcb\x01\xf7\x8a\xa8\x72\xc0\xbd\x22\x9a\x34\xb9\x57\x54\x83\x03\xee\x15\x55\xdf\x78\xf7\xaa\xff\xe6\x9f\xba\xe9\xe9\xcd\xdd\xab\xf4\x74\x9b\x7b\x95\x9e\x6e\x70\xaf\xd2\xd3\xbd\xdd\xab\xf4\x74\x83\x7b\x95\x9e\x6e\x70\xaf\xd2\xd3\x1d\xdd\x2b\xf6\xb9\xfd\x56\xf7\x0a\x2b\xf7\x1e\xee\x15\x56\xf2\xfd\x7a\x76\x85\x7b\x85\xd5\x7a\x27\xf7\x0a\x2b\xf9\x4e\xee\x15\x56\xf9\x74\xf7\x2a\x3d\xdd\xcd\xbd\x4a\x4f\x77\x73\xaf\xd2\xd3\x35\xee\x15\xd9\x87\x72\x27\xf7\x8a\x6c\x64\xb9\x93\x7b\x45\x76\xc2\x5c\xe9\x5e\xa5\xa7\xf1\x5f\xed\x4e\x63\xdd\xab\xf4\x34\xc2\xbd\x92\x86\xab\xc9\xbd\x92\xc6\xa2\xc9\xbd\x92\x46\x99\xc9\xbd\x92\x06\x95\xc9\xbd\x92\x86\x8b\xc9\xbd\x92\x06\x82\xc9\xbd\x92\xec\xde\xe4\x5e\x49\x16\x6d\x72\xaf\x24\x5b\x35\xb9\x57\xb2\x69\x9a\xdd\x2b\xd9\xec\xcc\xee\x95\x6c\x52\x16\xf7\x8a\xa8\x1a\x76\xaf\x88\x82\xed\xee\x15\x51\xef\x80\x7b\x45\xb4\x6b\x72\xaf\x88\x56\x07\xdc\x2b\xa2\xd4\x01\xf7\x8a\xe8\xd4\xe4\x5e\x11\x5d\x0e\xb8\x57\x44\x95\x03\xee\x15\xd1\xa4\xc9\xbd\xa2\x1a\x1c\x70\xaf\xa8\xfa\xc6\xbb\x57\xc2\x9e\xc7\xd4\x6d\xdf\x7e\x67\x53\x9b\xde\xe6\x5f\xb5\xe9\x0d\xfe\x55\x9b\xde\xdb\xbf\x6a\xd3\x1b\xfc\xab\x36\xbd\xc1\xbf\x6a\xd3\x3b\xfa\x57\xed\x7d\xb6\x3b\xb5\x77\xda\xf1\xd4\xde\x6f\xd3\x53\x7b\xd5\xbe\xa7\xf6\x7e\x5b\x9f\xda\xfb\xed\x7e\x6a\xaf\xda\x00\xd5\xde\x6f\x0f\x54\x7b\xbf\x6d\x50\xed\x55\x3b\xa1\xda\x3b\x6e\x86\x6a\xef\xb8\x1f\xaa\xbd\x61\x4b\x54\x9b\x8e\xf6\xaf\xda\x74\xac\x7f\xd5\xa6\x23\xfc\x2b\x69\xb8\x9a\xfc\x2b\x69\x2c\x9a\xfc\x2b\x69\x94\x99\xfc\x2b\x69\x50\x99\xfc\x2b\x69\xb8\x98\xfc\x2b\x69\x20\x98\xfc\x2b\xc9\xee\x4d\xfe\x95\x64\xd1\x26\xff\x4a\xb2\x55\x93\x7f\x25\x9b\xa6\xd9\xbf\x92\xcd\xce\xec\x5f\xc9\x26\x65\xf1\xaf\x88\xaa\x61\xff\x8a\x28\xd8\xee\x5f\x11\xf5\x0e\xf8\x57\x44\xbb\x26\xff\x8a\x68\x75\xc0\xbf\x22\x4a\x1d\xf0\xaf\x88\x4e\x4d\xfe\x15\xd1\xe5\x80\x7f\x45\x54\x39\xe0\x5f\x11\x4d\x9a\xfc\x2b\xaa\xc1\x01\xff\x8a\xaa\xcf\xe4\x5f\xcd\x69\xf6\x09\xe9\x8c\xb8\x76\xa0\x50\x3e\xb7\x45\x9b\xcc\x9a\xd8\xe1\x4f\xe7\xfe\x20\x1a\x4d\x41\xa1\x9c\xf5\x6e\x8a\x12\x3e\x1d\xca\xce\xef\x75\x28\xcf\x28\x8a\x31\x3a\xf5\xac\x38\xe1\x45\xc9\x79\x13\x18\xb1\xec\x8b\xf8\xe5\x47\xf2\xef\x45\xa0\x6a\x82\x77\xeb\xac\xef\x0b\xf9\x21\x74\x67\x41\x4e\x4f\xd2\x2a\x8a\x0b\xc5\x17\xd3\x31\x44\x15\x50\x40\xdb\x17\x9d\x27\x34\xa7\xe2\x80\x90\x30\x39\x49\x12\xe1\x2e\x41\xd9\xca\x98\x52\x54\xd7\xa2\x50\x1c\xa0\x36\x86\x0a\xcf\x60\xa1\x44\x1a\x0f\x5d\x26\xb8\xa6\x4a\x4a\xcc\x1b\x26\x31\x6b\xaa\x5d\xde\x9c\xdd\xe2\xe8\x36\x2f\x25\xfa\xa1\x88\xe3\x87\x8b\x76\xfa\x50\x3c\x1b\xec\x85\x0f\x1c\x13\x49\x29\xd3\xe3\x91\xd2\xd9\xf0\xf3\x8a\x56\x54\xeb\x1e\x17\x4b\x5c\xe7\xc8\x3f\x7f\xea\xfb\xdb\x95\x9c\x75\xfe\xde\xef\x37\x71\x74\x3c\x2a\xb8\x20\x51\x76\x55\xb1\x56\x72\xd6\x4b\x64\xe5\x31\x5a\xeb\x68\x2f\xd0\xa2\x32\x90\x5b\x32\x49\xe8\x6c\x6e\x8f\x87\x78\x4c\x53\xa1\xdb\x36\xa0\xf3\x20\x89\x2e\xf9\x9f\xa3\x16\x08\x24\x84\x32\x08\x63\xbc\x8a\x37\xf1\x5e\xc3\x08\x49\x57\xa8\x8c\x81\xb2\x33\x54\x06\xca\x78\xbf\xd8\xaf\xf7\x7b\x50\x50\x7d\x3e\x43\x93\x94\x0f\x9b\xc3\xfe\x70\x1c\xd3\xd8\x24\x67\x0d\xec\x3c\x48\x86\xa5\x54\x74\xe4\x9f\xa2\x94\x79\x09\x88\x6b\x81\x56\x87\xbd\x82\x0b\x94\x30\xaf\x8a\xb5\x92\xb3\x5e\x02\xca\x76\x73\x8c\xfd\x2d\x82\xc5\xc3\xf2\x42\x9a\x24\xbb\xf7\xe3\xa3\x49\x2d\x62\x53\xa3\x5c\x65\xa0\xf3\x20\x89\x24\x3f\x16\x8e\xf0\x2c\x20\xa6\x3f\x41\x14\x08\x85\x48\x42\x01\x49\x92\x96\xc7\xf2\xcf\xb3\xf2\x13\x16\xe0\xea\x70\x8c\x23\x50\x0a\x24\x8d\xa6\x49\x7a\xd1\x3e\x8e\x51\x38\xd0\xce\x24\x3a\x11\xe2\x3c\x88\x9c\xe5\xef\x74\xe4\x9f\x02\xee\xae\x04\xc2\x75\x3c\x22\xb4\x8f\x14\x5c\x90\x0c\xbb\xaa\x58\x2b\x39\xeb\x25\xa0\x30\x8f\xc7\xf8\xb8\x86\xad\x91\x27\x21\x35\xc9\xf3\x78\x44\x9b\xc8\x1f\x6e\x6a\x12\xa9\x02\x74\x1e\x24\x41\x73\xa0\x3a\xd2\x2f\x01\x39\x2f\x00\x11\x85\x07\x61\x80\x53\x48\x48\xa2\xbc\x26\x56\x0b\xce\x5a\x01\x28\x4e\x14\x6f\x57\x86\xc1\xcd\x32\xb8\x1a\xa5\xe9\xef\xbd\xfd\x7a\xb0\xa5\x49\x98\x32\xcc\x79\x90\x00\xc9\x03\xea\x88\x3f\x04\xcc\xec\x37\x88\x25\x3e\xc6\x47\x24\x61\x81\xe4\xc8\x2a\x62\xe5\xf7\x59\xfd\x0d\xdb\xe4\xfe\x78\x38\x1e\x40\x51\xd0\xac\xb7\x26\x19\xa2\x03\x3a\x1c\x57\x43\x0d\x4d\x22\x94\x40\xce\x83\xe8\xe3\xa8\xfa\xea\x08\xcf\x92\x25\xe2\x9f\xe0\x8b\x66\x75\xd8\x1c\x22\x11\x05\x6c\x85\xb8\x3c\x96\x7f\x9e\x95\x9f\xa0\xe8\xb6\xe1\x76\xbb\x3d\x18\xac\xa8\xfa\x6a\x7e\xb3\x6c\xf7\xfb\x3d\x1a\x68\x67\xb6\xbd\x1e\xe2\x3c\x88\x3c\x3a\x34\xc9\x37\xe4\x48\xbf\x04\xcc\xbc\xe0\x7c\x19\xed\xbb\x4a\xcc\xd0\xf6\xa6\x8e\x4e\x46\x61\xea\xb3\x0c\x33\x9e\xd9\xd9\x9c\x68\x8e\x2b\xf1\x62\x4d\x3a\xc2\xb2\x39\xcb\x2a\x5e\x86\xcb\x38\x0c\x15\x74\x7c\x3c\x71\x7c\xcb\x6d\xe8\x85\x6b\x00\x25\xda\xa2\x03\x3a\x2a\x28\xe5\xb0\x0a\xb3\x36\x86\xaf\xd7\x9b\xad\x55\xea\x0a\x81\x34\x44\x90\x1e\x00\x73\x6d\x1c\x15\x84\xa1\xc3\xff\x27\x46\x53\x02\x6a\x5b\x60\x05\x4a\x04\x40\x8c\x55\xde\x7d\x93\xe9\x97\x5d\xd7\xe1\x7c\x4b\x37\x15\x53\x52\x15\xaa\xcb\x22\xaf\x93\x6f\x38\xa2\x36\xe7\xa3\xeb\xd2\xb4\xb4\x74\x89\xbe\xcb\xe9\xc6\xcb\x69\xf2\x16\xfc\x3a\x6d\x8a\xa7\xc3\xf9\x15\x42\xff\x93\x51\xba\x00\xa7\xeb\xd5\xda\xc8\x69\x16\xbf\x29\xa7\x59\x3c\x89\xd3\xed\xd6\x37\x72\x9a\x9e\xde\x94\xd3\xf4\x34\x89\x53\xdf\xdf\x6e\x8d\xac\xb6\xe9\x9b\xb2\xda\xa6\x16\x56\x35\xf0\xb7\x64\xc5\xcc\xc7\xfc\x58\x54\x99\x7b\x28\xf2\xa6\x2a\x2c\xd2\x60\x09\x8c\x0e\x51\x7a\xf8\xc1\x9f\x87\x28\x9b\xfd\x38\xa3\xeb\x69\xb3\x1f\x67\x41\xd9\x3e\x3c\xf6\xcb\x52\xb4\x98\xad\xb6\x5d\x97\xcb\xdb\x38\x97\xaa\x53\x41\x9a\x94\xbb\x3e\xd3\x6c\x0b\x64\xe1\x3a\xa0\x78\x19\x47\x70\x16\x2e\x92\xbf\x8c\x66\xb0\x10\x67\xc9\xd9\xdc\x0f\xeb\x19\x8a\x6a\x1c\x83\xb8\xc5\x53\xe3\x90\xec\x9c\xe7\x28\x2e\x9e\xb5\xba\xce\xe6\xe8\x27\xde\xda\xad\x50\xfc\x74\x40\xb1\x9b\x15\x2c\x35\x06\xfe\xf9\x70\x91\x05\x2d\x50\x26\x59\xdc\x64\x3d\xec\x48\x4a\x1b\xd4\x96\x51\x1e\x5f\x6c\x19\x95\x1f\x61\x3d\x0a\x19\x4a\xab\x24\x3f\x5d\xf4\x76\x34\x73\x3d\xe9\x11\xf9\xa2\xe2\xcd\x98\xcc\x15\x44\x34\x35\xee\x28\xad\xc8\x01\xa3\xb7\x8f\x8f\xc7\x2e\x81\x2e\x7e\xa1\xb6\x32\x3d\x9a\x16\x8c\xbd\xb2\xfd\x60\x41\xe7\xf0\x00\xbf\x1c\x14\x51\x74\x49\x37\xcb\xa7\xc6\x2d\xd3\xe8\x80\xce\x24\x25\xf6\x45\x4e\x26\x5c\x94\xd1\x21\x69\x5e\x48\xda\x33\x45\x96\xc5\xef\x57\xb4\xc3\x2a\xb8\x81\xe6\x0d\x8d\xa7\x37\xe1\x19\x71\x1d\xa9\xf8\xb7\x0a\x45\x71\x91\xa7\x2f\x5f\x2e\x46\xaf\xa4\xc7\x48\x33\x03\x03\xea\xa7\xbd\xf9\x16\xa5\x4f\x68\x8c\x25\xc8\xac\x91\xfc\xa2\x32\x5b\x2e\xb9\x30\xc4\x38\xd7\xd0\x6f\x80\xa4\x01\xcd\xf2\x2c\x26\x97\x26\x13\x10\x9f\x62\x7e\x9c\xf9\xc2\xcc\xc3\xd7\xde\x21\x10\x25\x77\xfb\x40\xae\x65\x7f\x1e\xaa\x4c\xe0\xb7\x9a\xce\xc7\x20\x17\x22\x80\x9e\xc1\x73\x88\x66\x9d\x01\x34\x83\x41\xa2\x01\x4c\x75\xbe\x59\xc3\x54\x25\xdd\x94\x69\x94\xe4\x78\x76\x30\xbf\x0b\xd4\x99\xde\xb3\x88\xd7\x07\x28\x3e\x0e\xac\x97\x03\xf3\x1b\x9d\xcb\xf5\x0a\xfe\x92\x2f\xdb\x99\x67\xea\x88\x5c\x9c\x9e\x9c\x51\x70\x82\xec\x4d\x29\xba\x54\x78\xf0\x1d\x09\xbe\x22\xa9\x86\xd4\x4c\xc6\x06\x05\x81\x09\x10\xd5\x4e\x81\xc4\x7d\x88\x36\x21\x0d\xa7\xe2\x1c\xa2\x4c\x3e\x38\x01\xd3\xc4\x6f\xd9\x53\xda\x24\x65\x8a\xbe\x38\x50\x2d\x26\xf1\x45\x4a\x7c\xcc\x53\x4c\xcb\xef\x44\x39\x09\x26\xae\x21\x59\xd4\x81\x2c\xa2\xac\x1a\x30\x53\x21\x27\x2c\x4f\x2f\x4a\x40\xdf\x24\x5d\x9c\x96\x2d\x8e\x64\xb4\xe2\xf4\x58\x06\xb8\xee\xa7\x2d\xff\x9b\x96\x1c\xab\xc7\x44\x92\x86\x03\x99\xb6\xe4\x7e\xcb\x99\xb5\xa4\xae\x13\x04\xf4\x65\xd4\xa3\x89\xf6\x75\x91\x3e\x35\x48\x12\xd8\x42\xbd\xac\xc2\x35\x62\xea\xde\x38\xff\x29\x56\x91\x69\xcb\xd1\x80\x7f\xe3\xc0\x5f\x74\xe8\x8b\x92\xcf\x55\xab\x57\xaf\xdd\x90\xb1\x93\x0c\xb9\xa2\x62\x59\xb6\x54\x55\xbf\x42\x71\xaf\x66\xfa\x01\xf7\x80\xf2\x06\x55\x8f\xe4\x07\xc9\x8c\x5b\xf3\x22\x25\xf3\xae\x9c\x1f\x77\x0d\x08\x86\x5c\x88\x62\x91\x7a\xdd\x44\x4d\x72\x78\x84\xee\x7b\x60\x58\x17\x7e\x00\x5c\x19\x32\xff\x16\xa5\x49\xec\x1e\x11\x8a\xf1\x6c\x29\x65\xc5\x7e\xd4\x3f\x8f\xf7\xd6\x0f\xe7\xce\xa5\x57\x60\x71\xac\x4d\x51\xe0\xd1\x0b\xd8\x06\xf9\x1e\x8e\xf1\xfe\xee\x92\xbb\x72\x76\xe1\xa3\x44\xd9\x90\xb1\x5d\x9a\xde\x44\x96\xfc\x71\xf3\xdd\x60\xd4\xbf\xf4\x1c\x7f\xb5\x76\x56\x5b\x67\xbe\x7d\x00\x3d\xfb\xd7\x79\x42\x3c\x96\x24\xfe\x4f\x45\x76\x8e\x56\xc3\xfa\xef\xcc\x9f\x23\x56\x13\x35\x28\x9e\xed\x0c\xcd\x6d\x50\x5c\x94\xf2\x9d\x4c\xd2\x2c\xd7\x91\x57\x31\xc9\x8e\x17\x29\x57\x96\x6e\xd8\xcd\x65\xf2\xcc\x01\x44\x64\x0f\xa2\xd8\x92\x2c\x3a\xa1\xdd\x53\x95\xfe\xf0\x2e\x8e\x9a\x68\x47\x7e\x7f\xaa\xbf\x9d\x7e\x6c\xb3\xd4\xf9\xb0\x38\xd4\xdf\x4e\xb3\x36\x4b\xf3\xfa\xf3\xc7\x73\xd3\x94\xbb\x4f\x9f\x9e\x9f\x9f\xe7\xcf\x8b\x79\x51\x9d\x3e\x05\x9e\xe7\x61\xe0\x8f\x33\xa2\xe7\xcf\x1f\x37\x1f\x67\x54\x53\xe4\xf1\x5b\x82\x9e\xff\xa5\x68\x3f\x7f\x24\xdb\xc4\x66\x9b\x8f\x1f\x16\xe8\xc3\xe2\x50\x46\xcd\x79\x76\x4c\xd2\xf4\xf3\xc7\x0f\xc1\x82\xb2\xfd\x71\x16\x7f\xfe\xf8\x4b\x30\x5f\xcc\x56\xf3\xf5\xe2\xe7\xf9\x6a\xb6\x9c\x87\x8b\x83\x3b\x5f\xba\xfe\xdc\x5b\xce\x97\x2b\xd7\x9f\x2f\x67\xfe\xdc\x77\xe7\x9b\xd4\x9f\xfb\x33\xfc\x73\x31\x5f\xba\x8b\xf9\xe6\x30\x5f\xb9\xf3\xd5\x62\xe6\xe3\xbf\xc1\x7a\xe6\xcf\x83\xf9\x3a\x75\x97\xb3\xe5\x7c\x85\x51\x2c\xe6\xa1\x3b\xdf\x10\x54\xfe\xdc\xff\xfd\xe3\x27\xca\x07\xe6\xfc\xc3\x02\xbd\x93\x04\x52\xa1\x12\x45\xcd\x2e\x2f\xd8\x93\x58\xd7\xcf\xb2\x64\x6d\xaf\xf3\x24\xa9\x70\x7d\x6a\xaf\x0f\x33\x36\x3d\x08\x0d\x89\x3d\x53\x70\x0e\xbd\xe0\xc0\x50\xa9\xc1\x26\xa8\xbf\x3d\xc2\x32\x58\x5c\x06\xda\x87\x2d\xd2\x12\x46\x0e\x89\xb4\x64\x42\xe0\x6b\x99\x12\x74\xc0\xba\x8e\xf1\xcb\x34\xa3\xec\xe4\xdc\x14\xa5\x51\xca\x56\x1d\xbc\xce\x0f\x4f\x75\x53\x64\x2e\x73\x38\x8c\xa3\x4a\x02\x9b\x38\xac\xb8\xd6\x02\x3e\x2d\x8b\x9d\xb8\xdf\x98\x5a\xf6\x63\x2a\x54\xc6\xd4\x72\x16\x82\x63\x8a\xae\x06\xb3\x31\x35\xf3\x7e\xf6\x66\xc1\x79\xf9\x7b\xe6\xcd\xc2\x9f\xbd\xd9\xe2\xbc\xd4\x87\xc0\xac\x33\x78\x26\x58\xb6\x7a\x43\x6d\xf9\xd3\xa6\x6c\x67\xbe\x57\xb6\xce\xff\xcf\x67\x8a\x19\x7e\xed\x08\xb2\xa2\xe2\x61\x22\x63\x17\x3b\x7c\x9a\x34\xd0\x61\x3b\x35\x8c\x74\xc0\x5a\xef\x35\xd4\x55\xaf\x44\x78\x0f\xea\x1e\x1c\x38\x01\x09\xde\x9f\xa1\xe1\x45\x71\x30\x2c\x34\xd5\xd7\xea\x20\xa8\xe1\x35\x6d\xe4\xcd\xfe\xde\x1e\x68\x66\x78\x91\x33\xf5\xf0\xb0\x4b\x65\x54\xa9\x86\x65\x09\xe1\xd8\x59\x10\xa8\x32\xbd\x82\x07\x7e\x25\xc2\xcd\xbc\x08\x77\x68\xea\xd6\x38\xc0\xda\x8e\x08\x1b\xdd\x8f\xc5\x71\x08\x15\x56\x17\xcb\x03\x02\x17\xae\x68\xc5\x50\x1f\xc8\x58\xbc\x5f\x0f\x46\xa0\xbb\x4c\x19\xe3\x23\x98\xa7\xd7\x7e\x30\xd1\x3d\xdc\xb9\x2b\x53\x90\xdb\x6d\x88\xdc\x4a\x64\xb0\x6d\x52\x67\x1d\x5c\x7d\xeb\x9d\xa9\xe9\x64\xf2\x8a\xb2\xa6\x33\x61\x44\x70\xfb\xdc\x9e\xe4\xf7\x0d\x10\xe9\x8d\xc7\x3d\xde\x7f\xbc\x10\x31\x08\x3c\x27\x5c\x0c\x86\x88\xac\x83\xff\xa9\x49\xd0\x01\x6b\x4d\xa1\xa2\x05\xcd\x10\xe4\xc8\x90\x91\x81\xdb\x43\x03\x06\xa4\xd8\x13\xbb\xbe\xfa\x6f\x1a\x36\xfa\x41\xef\x0d\xe2\x67\xea\xf2\x61\x0b\xf9\x38\xab\x9b\xaa\xf8\x8a\x88\x03\x48\x59\x55\xbc\x45\x3f\x98\xf9\x01\xf3\x17\x0f\x49\x75\x48\xd1\xec\xd0\x7e\xfe\xb8\xfa\x38\x3b\xbc\x90\x3f\xd5\xe7\x8f\xcb\x79\xc8\x5d\x39\xe2\x52\x52\x9c\x2e\x36\x9b\xbf\x16\x49\xfe\xf9\x23\xe9\x15\xf5\x2c\xc3\xf9\x66\xb6\x98\xaf\xce\xf3\xe5\xcf\xab\xd9\x6a\x1e\x76\x4e\xa0\x8e\x7c\x33\x0f\x08\xfa\xf9\xea\x63\xef\xa5\x72\x26\x39\xdf\xa4\x17\xff\x80\x11\x27\xb3\x96\x31\x31\xa7\x04\x0a\x9b\x97\x6d\xba\x12\x06\xe3\xe8\xa8\x93\x1b\xbc\x31\xee\xe4\xc6\xfe\x77\x10\x79\x9a\x06\xa7\xec\xcd\x4f\x1d\x9d\xff\x1d\x7d\xfe\xf7\x84\x33\x7e\xc2\xf9\x0e\x81\xab\x7d\xc2\x00\x8d\xfd\x5e\x33\x06\x14\x1d\x76\xaf\xd2\xe9\xe1\xab\xb1\xe9\x45\x71\x80\xac\x74\xf5\x97\xfd\x28\xf0\xd1\x81\xec\x68\xaf\x62\x44\xd3\x89\x01\x6d\xd7\xfe\xfa\x90\xd6\x8a\x42\x95\xf3\x55\x7c\x4c\x0b\x53\x46\xa1\x02\x2d\x75\x90\xbd\x7b\x86\xb6\x93\x50\xaa\x3b\xe1\x97\x2b\x6f\x15\x43\x3b\x50\x49\xc5\x70\x3f\xee\x16\xde\x4e\x40\x78\x99\x32\x0f\x8c\xea\xc0\xfd\x43\xdc\xab\xd1\xdb\xed\x49\x8e\x32\x55\x03\x9d\x14\x61\x9a\x1b\x5f\xc1\xc2\x2d\xa1\xee\x10\x8a\x3b\xbd\x0b\x80\x2f\xbf\xf6\x4f\xfa\xe4\x22\xeb\xaa\x78\x9e\xf5\x9f\xf5\xe5\xa2\xb1\x5f\x85\x25\x06\xc4\xa9\x57\x38\xa7\x6c\xb9\x36\x42\x6c\x2c\x5f\x62\x3f\xdc\x87\xa1\xef\xd5\x7d\x1a\x99\xe8\xf0\x95\x17\xfe\xf5\xa9\x6e\x92\xe3\x8b\xcb\xaf\x43\x65\xc5\xf0\x67\x75\xa9\x57\x74\xf7\xc5\x68\xf6\x4c\x59\x4e\xee\xaf\x82\x31\xcc\xab\x3b\x5a\xc5\x5b\x54\xc5\xc4\x2b\xca\x69\xee\x2c\x89\xe3\x14\x59\x10\x02\x1b\xa4\x94\x0b\x5a\xc5\x96\x92\x53\xe4\xc8\x75\x74\x7b\x20\x15\x72\xcf\x90\xd9\xbc\xfe\x36\x76\x22\x88\x0a\xdc\xff\x34\xbc\xe9\xa1\xdb\xb1\xd2\x91\xcd\xd1\x89\x94\xf0\x34\x48\xf5\xb9\x4a\xf2\xaf\xfd\x5e\x08\x68\x63\x04\xb8\x2d\x02\x92\x35\x57\xfc\x5b\x48\xc3\x4a\xd1\xb0\x5f\xe5\x75\xbe\x6f\x72\xd8\x0c\xd5\xcd\xd0\xf2\xbe\x38\xb2\x45\x57\x62\x1e\xb4\x55\xe5\x42\xf5\x6e\x73\xf8\x53\x8d\x2a\xee\x8d\x93\xa5\x38\xb2\x0d\x16\x28\xad\xf5\x42\xad\x60\xcc\x1e\xbd\x7e\xcf\xb5\x58\x39\x6e\x5b\xf8\xd0\x96\x37\x75\xb7\xb6\x69\x9b\xb6\xc2\x26\xb4\x91\xfb\xad\xb7\x79\x63\x6d\xeb\xbb\xbb\xf7\x4d\x0e\x26\x0b\x20\x4a\xee\xef\xeb\x67\x57\x7a\xef\x9b\x7c\xce\xa2\x2c\xdc\x90\x06\x51\xd7\xed\xa5\xc6\xa8\xfa\xdd\xc1\x18\x1b\xff\x75\xe1\x5b\x7f\xe7\xab\xf0\x35\x92\x01\x8f\x09\x4a\xe3\x1a\xf5\xdb\xbc\x66\x04\xe0\xc2\x8c\xcc\x45\xdf\x50\xde\xd4\x3d\xb7\xfc\x98\xbe\xfd\x0c\x93\xe7\xad\xf7\xda\x4e\x71\x5a\x28\x61\x19\x71\xfa\xe7\xbd\xe7\xad\xb6\xf1\x56\xc3\xb5\x0a\x0e\x07\x09\x97\x20\xc7\x0e\xbd\xb4\xa9\x7d\x32\x7a\xab\xf8\x17\x1b\xc7\x5f\x32\xf9\x33\xf1\x77\x9c\x48\x6a\xe8\x98\xe9\xd4\x71\x1f\xd1\x11\xe7\x94\xe3\x7c\x20\x3f\x3b\xc2\x0f\x73\x7e\x10\x70\x74\x8b\x1d\x6f\x51\x9f\x8b\xe7\x9f\xe4\xee\x54\x45\x19\x17\xcf\x78\xba\x3e\x9d\x52\x34\x28\x4f\x2a\x3a\x99\xff\xf0\xb0\xbf\x82\xff\x1d\xa0\xd3\x31\xbd\xe0\xed\x06\xfb\xd2\x2d\x5b\x4c\x53\x74\x97\x43\xc1\x2e\x0b\xb6\x97\x5f\x96\x45\x77\x9f\xbd\x88\x67\xcc\x40\x08\xa3\x55\xb0\xda\x28\xd8\xc2\x65\xb8\x5f\x05\x0a\x36\x71\x28\xf4\x24\x46\x0c\x06\x0b\x09\xeb\x60\xf0\x17\x9e\xe3\x13\x41\x41\x42\x52\xc6\x43\xcf\xd1\xb8\x11\x31\x41\x8a\xe3\xc7\xc4\xc8\x36\xc0\xa8\x10\xba\x35\x65\x5c\x74\x52\x94\xce\x68\xa2\x30\x0c\xf7\xd7\xf4\x62\x07\xaa\xf8\xba\xb1\x61\xec\xd1\x88\xd1\x01\x6b\x9e\xa6\xc2\xb0\x0b\xa4\xfb\xfa\x09\x7d\x9d\x15\xb0\x8c\x19\x1a\x81\xbf\xd9\x2c\x54\xbb\xf5\xd1\x1a\x2d\x96\x12\x2e\x69\x60\x30\xf4\x23\x86\x85\x05\xbd\x75\x58\xac\x03\xc7\xdf\x78\xce\x76\xad\x89\x46\x1d\x12\x8c\x97\x71\x03\x62\xb4\xe4\x26\x0c\x87\x31\x2d\xa0\xc1\xc0\xbb\x33\x65\x28\x74\x92\x93\xc4\x79\x58\x2f\x17\xde\x74\xfe\x77\x80\x4a\xaf\x1c\x06\x70\x5f\x46\x0c\x02\x40\xcf\x49\x7e\x2c\x06\xa4\xb0\x8e\x82\xbd\x66\x54\xa4\xb0\x47\x31\xc6\xf8\xfd\xc5\x66\xb9\x5d\xa9\x88\xfc\x75\xb4\xd9\xf7\x88\x44\xcb\x27\x88\x47\x98\xbd\x05\xb1\xd5\xec\xc3\x8d\xe3\xaf\x57\x8e\xbf\x0d\x65\x79\x28\x46\x4f\xd8\x18\x67\xf1\xe3\x64\x35\xde\xdc\x87\xc1\x01\x5b\xa7\x5d\x98\x64\xe8\x5c\x56\x12\xdb\xde\xda\x5b\x1f\x27\xb2\xbd\x53\xd5\x77\x9d\x89\x43\x5d\x18\x61\xdf\x90\x42\x59\x82\x99\xa1\x94\x68\xef\x8f\xc7\x83\xef\xad\x1f\xd5\xc4\x38\xb8\x50\x42\x34\x2e\xc3\xda\x7b\xe4\x45\x1b\x4f\xcd\xda\x10\x2f\xb6\xc8\xf3\x24\x74\xa2\xbd\x73\x0a\x92\xc9\x5f\x43\x61\x60\xf5\x32\x70\xfc\x35\x8e\xca\x34\x21\x29\x86\xcf\xf9\x51\x6d\xff\x76\x19\x8e\x1f\x01\xa3\x5a\x00\x83\xa0\xeb\x11\x3c\x0e\x8c\x5d\xe8\x04\x28\x76\xe1\xb0\xda\x86\x8a\xde\x26\x8f\x86\x29\x1d\xd1\x07\x84\xa1\x3b\x23\xc6\x04\xa4\x6e\x9a\x27\xc8\x3e\x27\x74\xeb\xe0\xd0\x5a\x7d\x8f\x64\xcc\xc4\x7f\xd8\x04\x8b\xc5\x42\x41\xb5\x8f\x03\x9f\xbf\x47\x29\x2a\x71\x28\x30\xe4\x23\x26\x7f\x0b\xf2\x81\x61\x10\x3a\x9b\x85\xf4\x2e\x64\x6c\xc8\x83\x80\x71\x32\x6e\xfe\x1f\x2b\xb4\xf1\xf6\x3f\xa6\x01\x60\xfe\xbc\x2b\x53\xde\x02\x9d\xd0\x24\x49\x06\xfe\x31\x88\x27\x33\xbf\xd3\x75\x79\x9d\xe1\xc3\x1d\x19\x65\xf7\x9a\x7e\x49\x7e\x9c\xe1\x49\x6c\x73\xdc\x1e\x23\x75\x12\x23\x85\x02\x9a\xb1\xaf\x81\x00\xad\x90\x8a\x2c\x8e\x90\x87\x42\x01\x99\x68\xf9\x14\xfb\xc8\x57\x80\x19\xbb\xdd\xf6\xfd\x95\x13\xf8\x6b\x27\xf0\xb7\x8a\x74\x14\xe3\xa7\xcc\x8c\x9f\xff\xc7\x89\x6e\xbc\xf5\x8f\x80\x07\x8c\x9f\xf5\x64\xea\xcc\xcf\xe5\x26\xbf\x4f\xe3\x6d\x7c\x9c\xca\xfc\x4e\xd3\xe7\x75\xc6\x0f\x76\x64\x8c\xed\x43\xfa\x1d\x9b\xd1\xe9\x51\xdd\x9f\x4e\xd3\x3c\x71\x14\xa3\xc2\xdc\x45\xb0\x0e\x34\x7f\x32\x0e\xfc\x60\xd9\x23\x92\xa7\xfb\xea\xeb\xa8\x00\xd7\x8c\xd8\x6a\xf0\x9b\xc0\xd9\x6c\x9c\xed\x42\x96\x86\x36\xd3\x57\x5f\x47\xce\xf3\xe3\x24\x35\x65\x96\x1f\x02\x07\xe7\x78\xdc\x85\x49\x7e\x3e\x97\x94\x1c\x9e\xf8\x91\x1f\x4f\x64\x7b\xa7\x2a\xef\xda\xd9\x5d\xef\xc2\x08\xfb\xd6\xd5\xc9\x3e\x45\xa8\xab\xfe\x83\x8b\xd4\x4a\xbb\x71\xeb\xfc\xd3\x70\x8a\x76\xae\x92\x1b\xee\xaa\xf0\xf5\xc4\xd0\x57\xc5\x8a\x55\x12\xaa\x41\x73\xf6\x2d\xdf\xcf\x60\xd1\x8c\xb7\xe6\x49\x2d\x01\xc3\xd6\x3a\x38\x6d\x5d\x7f\xa2\xca\xa7\x9a\xfb\x35\xbd\xd3\x2d\x7f\xa0\x8f\xb7\x59\x86\xb6\xec\x3f\xb8\x34\xad\xb5\x1c\x33\x12\xa6\x63\x85\xc6\x82\xba\xf0\x6f\x5d\xc5\xf5\x36\x8e\xef\xaf\x1d\x3f\xb0\xf5\xda\x30\x22\xcc\xeb\xf9\xbc\x23\xa3\xc7\xc4\x35\x2b\xf9\x13\xdb\x5a\xc6\xc5\x95\x2b\xfb\x57\x18\xc1\xb5\x63\xe3\xd6\x95\xfe\xc1\x9e\xde\x6c\x2b\xf2\xca\xff\xe0\x4a\xb5\xd2\x6e\x94\x13\x34\x11\x27\x38\x36\xa4\xb5\xff\x91\x07\xb0\x0c\x7d\x35\x8d\x0a\xc3\x92\x3e\x67\x7f\xfc\x98\x98\xbc\x98\x3f\xa9\xa5\x6d\x3c\x5c\xb3\xb8\x3f\x59\xe5\x57\x8f\x85\x9b\x16\xfb\x07\xfa\x78\x9b\x65\x88\x8b\xff\x83\x4b\xd7\x62\xa3\x51\xcb\xfd\x53\x10\x42\xc6\x2f\x2c\xff\x5b\x83\x9d\x85\xe3\xaf\x02\xc7\xdf\x2c\xe1\x0e\x1a\xec\x1e\x5c\xd5\xe7\x3c\x8f\x36\xfa\x89\xeb\xf9\xe3\x9b\x59\xcc\xfd\x8a\xf5\xfd\x49\xba\xbd\xd6\xd0\x6f\x58\xef\xb7\x75\xed\x46\x13\x50\xd6\xff\x07\x97\xa8\x95\x76\x23\xd7\x79\xa6\xa2\x85\xec\x5d\x5e\xfe\xb7\xf6\x37\x0c\x1d\x7f\xbb\x70\xd6\xc6\xee\x1a\x8c\xde\xb4\xa2\xcf\xd9\x1f\x6d\xf7\xd3\x17\xf2\x27\xb5\xb4\x58\xff\xb5\x0b\xfb\x93\x15\x7f\xed\x30\xb8\x6d\xa1\x7f\xa0\x9b\xb7\x19\x87\xbc\xee\x3f\xb8\x58\x2d\x37\x1b\x33\xe7\x4f\x44\x09\x8d\x02\x69\xe5\x7f\xe4\x2e\x7c\xb8\x9f\x86\x31\x60\x58\xd0\xe7\xac\x8f\x1e\x02\x93\x97\xf2\xa7\x34\xb4\x0c\x80\x6b\x96\xf6\xa7\xaa\xfa\x5a\xdb\xbf\x69\xa9\xdf\xde\xc1\xdb\x2c\x42\x5a\xfa\x1f\x5c\xa6\x96\x5a\x8d\x7d\x03\x4c\x42\x0a\x59\xbe\xb8\xf2\x6f\xed\xe6\x72\xe3\x04\xcb\xad\x13\x84\x9e\xa1\xa3\x06\xcb\x87\x57\xf3\x39\xe3\xa3\x0d\x7f\xea\x22\xfe\x84\x76\x16\xb3\xbf\x6e\x51\x7f\xa2\xaa\xaf\xb5\xfb\x5b\x16\xf9\xad\x1d\xbc\xd5\x1c\xc4\x45\xff\xc1\x45\x6b\xb1\xd1\x98\xd9\x7e\x12\x42\x78\xae\xef\x96\xfd\xad\xdb\x3a\x02\x27\xdc\x38\xab\x25\xdc\x3d\xe3\x34\x0f\xac\xe6\x73\x8e\x27\x4c\xf2\x93\xd6\xf1\xc7\x37\xb3\x4e\xf0\x53\xd7\xf5\xa7\x69\xf6\xfa\xc9\xfd\xea\x75\x7e\x5b\xd7\xae\x32\x80\x34\xc9\xbf\x5e\x0c\xa7\x55\xd8\x0a\xb0\xf1\x20\x03\x69\x2c\xdb\xb7\xe7\x85\xab\xfd\x42\x6b\xf2\x94\xc7\xa8\xc2\x8c\xf7\xed\xe4\x4f\xb5\x39\x37\x5f\x63\x4b\xf1\xb3\x94\xcc\x81\xf6\xa9\x35\xff\x6a\x5a\x98\x34\x1e\x72\x20\x67\xb5\xc8\x05\x14\xfb\x26\x67\x78\x4e\x97\xbb\x65\x56\x16\x68\xd4\x99\x40\xa3\x4f\x45\x7d\x97\xcc\xd1\x18\x25\x39\x7f\x64\xc9\xc0\xde\xc1\xfc\x28\x80\x8b\x79\x7a\x48\x06\x1d\x9a\xdc\xb7\x79\x29\xd1\xe7\xfd\x53\xd3\x14\xf9\x97\x1e\xda\x11\x2a\x2b\x54\xa3\xc6\x50\x57\x3f\xed\xb3\x44\xac\x14\x8f\x51\xce\x8f\x51\x8c\xc4\xd3\x34\xec\xc8\x0a\x3d\x9d\x83\x7b\x1b\x55\xe3\xef\x5f\x50\x70\xf1\x7b\x17\xa2\x18\xd1\x81\x85\x07\xd0\x43\x77\x2a\xc6\x23\xe9\xd8\xd3\xa8\xac\xa5\x6a\x31\xbb\x51\x07\x81\x23\x5e\xfd\xbc\x1b\x53\x84\xd7\xdd\xdf\xb1\x3b\x27\x71\x8c\x72\xf1\x20\x13\x85\x99\xcd\x17\xec\xb4\xd1\xe8\xde\x08\x94\xf5\x3e\xf1\x31\xef\x90\xa7\x14\x1d\x1b\xfa\x54\xd1\x1b\xc8\xf0\xe3\x53\xa9\x73\xfc\xaa\xcd\x83\xcf\xe7\xa4\x41\x6e\x5d\x46\x07\x2c\x84\xe7\x2a\x2a\x35\x98\xdd\x2e\x3a\x36\xa8\x82\x4f\xb8\x89\xe7\xf5\xe6\x41\x18\xea\xd7\xef\xb2\x52\x7e\xce\xee\xdd\x3b\xf1\x32\xde\xf9\x02\x65\xf4\x4c\x59\x67\xca\x2c\x4d\x32\x2f\x87\x72\xc2\x77\x69\xe8\xd9\x6f\x4a\x1d\x6c\xa2\xf7\x06\x65\x65\xf3\xc2\xfb\xa4\x1c\x37\xec\x60\x33\x94\x3f\xd9\x92\x61\xb1\x84\xd1\x3c\x27\x96\xef\x79\x9e\x9c\x16\xeb\x98\x16\x51\xb3\xc3\x60\x8f\xfd\x11\x61\xdf\xc3\x63\x58\x9e\x4e\xf8\x31\xc8\xdd\x9c\xe6\x80\xc1\x73\xb5\x7a\x78\xce\x78\x6c\x90\xe0\x4f\x93\xba\x71\xeb\xe6\x25\x45\x86\xa3\x7c\xd3\xef\x54\x11\xef\xd9\xf2\x43\x53\x7a\x2d\x49\x5a\x44\x86\x97\xaa\x4b\xf0\xfe\x08\xca\x94\xaa\xf7\xc2\x13\xee\x13\x18\x72\x28\xd6\x7c\x9e\x5a\x6e\x5f\x67\x23\x09\xd5\x99\x91\x16\x40\x6c\xbd\xda\x00\xc4\xb2\x78\x24\xb1\x2c\x9e\x42\x6c\xbb\x0d\x00\x62\xe9\x69\x24\xb1\xf4\x34\x85\x98\x1f\x78\x1e\x40\xad\x4d\x47\x52\x6b\x53\x33\x35\x36\xcf\xcc\x94\x71\x83\x47\x09\xc1\xc8\x13\xfa\x2b\x89\xe9\xd4\xeb\x24\x98\xe5\x03\xe8\xde\x7e\x02\xf2\xae\x98\x77\xf4\x49\x6b\x70\x02\x82\x7a\x35\x34\x11\xb1\x3c\x4a\xba\x6c\xbd\x47\x55\x6b\x46\x09\x53\xc6\x44\xf9\xaa\x58\xbf\xe7\x1c\x0f\xc9\x94\x9b\x95\x49\xc4\x50\x1b\x45\xdc\xd6\x8e\x4d\x95\xb2\x2c\x0f\xa5\x9f\xac\x0d\x6e\x6f\x53\x4c\xff\x8a\x20\x2a\xb2\x1c\x7a\x17\x35\xa3\x60\x7d\x1b\xc5\x4c\x20\x45\x1d\x20\x0b\x38\xcb\x80\x62\x63\xad\x3b\xdb\xff\x16\x46\xa3\x8f\x43\xab\xf5\xd8\xfa\x32\x64\x25\x76\x09\xc0\x56\xd2\xd9\xc6\x6f\x2d\xbd\x23\x2a\x43\x79\xf3\x7f\x3f\x53\x1e\xbf\x38\x36\x18\x4c\xce\x0e\x41\x24\x60\x07\x69\x8a\xf2\x8b\x38\xbf\x33\xe1\xd0\x1c\x14\x5d\xbb\x38\xf9\x96\xc4\xa8\xba\x74\xbe\x2c\x77\x47\x98\x77\xa2\xba\xb6\x82\x8e\x84\x3b\xd8\xe8\x5d\x53\x02\xda\xa4\x41\xd9\x88\x0b\x86\xa8\xcb\xe3\xd3\x70\xe7\x90\xa2\xa8\xda\xed\x8b\xe6\x3c\x3e\x71\x02\xbf\xdd\x49\xf7\x63\x1f\x47\x5e\xab\x26\xb1\xcc\x43\x6d\xb9\x50\x8a\x6d\xfd\x95\xbf\xf1\xf7\x60\x38\x6c\x5c\x30\x53\xa8\x74\x6b\x1c\x32\x19\x5a\x2a\x2e\x4d\x8c\x24\xc2\xb7\x63\xc9\x44\xfa\x78\x58\x26\x33\x3e\x28\x1e\x58\xd8\x91\x6c\x8f\x44\x4f\x6a\xf6\xb1\x0e\xe2\x8c\xa2\x58\x98\x5a\xa4\x2b\x6d\x78\x5c\x2d\xe5\x8a\x05\x6e\x9a\xe2\x31\xb0\xcc\xb6\x35\x80\xc1\xfd\x85\x2e\x11\x32\xd8\x9f\x68\x64\x42\xb0\xee\x08\x71\x3b\x1f\xea\x96\xfb\x7a\x46\x5e\x52\x63\xc8\x4b\xa3\x93\x12\x16\x0a\x48\xc5\x4f\x2c\x51\x83\x29\xfb\xca\xce\x9f\xf9\x42\x9a\x1e\xfe\xcb\x88\x9a\x9a\xb7\x4a\x80\x19\x7d\x17\xe1\x18\x9b\x4b\x0b\x76\x10\xfa\xa1\x7a\x61\x05\xa8\x27\xaf\x63\x35\x20\x13\x70\xa8\xcc\x36\x45\x91\xee\xa3\xea\x1e\xd7\x42\xc9\x29\x64\xea\x26\xaa\x1a\x2d\x83\x0c\xcd\x74\x83\xab\x24\xf2\xe6\x3c\x40\x72\x1f\xe8\x23\x5d\x10\x3c\x26\x55\xdd\xb8\x87\x73\x92\xc6\x0f\x5a\x5f\x35\x88\x8b\x7c\xa3\x53\xd9\xda\x50\xa7\x51\xd7\x0e\x34\x2c\x0d\x8a\xad\x49\xca\xaf\xbe\x87\x4b\xff\x1e\xa0\x6f\x63\x1e\x1c\x2a\x9e\x9c\x52\x39\xb6\xd3\x16\xd6\xa4\x9e\x0b\x5c\xe0\xde\x9b\x98\x90\xea\xb4\x25\x01\xb7\x2e\xd3\xa4\x51\xf2\xcc\xce\xc3\x15\x5d\xd8\x93\x92\x22\xf1\x52\x03\x0e\xe6\x41\x38\x16\xcf\x12\x00\x04\x82\x03\x09\x6a\xb4\x43\xc2\x5b\x31\xb7\x44\xf2\xc1\x3c\x60\xf5\xf1\x47\x18\x01\x5f\x94\xfc\x71\x9c\xa0\x68\xde\x1f\x45\x4e\x0b\x76\x7b\x96\xb6\xaa\x6a\xa5\x99\x9e\x46\xd2\x84\x48\x6a\x14\xbb\xb9\xba\x1b\xbd\x71\x52\xa1\x03\xcf\x2f\xf4\x94\xe5\x8f\x70\xa9\x92\x59\x8a\x0e\x77\x31\xb1\x54\x3f\xd4\xa7\x25\x97\x1a\x9e\xdc\xe5\x0a\x69\xd2\xe8\x17\x6e\x8d\x90\xd6\xd9\x43\x99\x76\x8d\xd3\x08\x76\xed\x94\x59\xc4\x4c\xcb\x32\x9d\x40\xf4\x46\xcf\x2b\xe0\xec\x61\x1f\xd5\x13\x25\x33\x86\xdd\x49\x73\x8d\x3e\x15\x8a\x3c\xd1\xfe\x69\x44\x85\x62\xf1\xed\xae\xe5\xbf\x03\x5b\xcc\x84\x65\x76\x92\x99\x6d\x5f\xb4\x5f\x9c\x61\x58\xcc\x5f\xf1\x65\x98\x8d\x29\xf8\x0d\xcd\x28\x29\x60\x29\x95\x2c\x42\xe2\x71\xc7\xd6\x19\xbd\x07\xf8\xb3\x8c\xf8\xf2\xb4\xfb\x5c\xb7\xbe\xda\xf9\x60\xaf\x50\x73\x38\x4b\xc3\x9d\x97\x89\xe3\x50\xe0\xeb\x27\x31\x09\xa6\x03\xd6\xf0\xfc\x80\x52\x9d\x98\x75\xd0\x52\x25\x24\x24\x1c\x70\xfa\xbc\x0f\x8f\xe2\x73\xbf\x08\xe8\xe9\x09\x15\x4d\xfc\xff\x38\xdc\x19\x09\x0c\xec\x99\x04\x68\xe9\xa6\x84\xe3\xc7\xb1\x62\x1c\x45\x9b\x83\x8e\x14\xb2\x8d\x38\x08\x37\xa8\xd1\x1f\xa7\xeb\x77\x2c\x13\x40\x8b\x41\x76\xc4\x36\x52\xfe\x4c\xdd\x79\x34\xa9\x12\xca\x06\x6b\x4e\x24\x6b\x56\x0a\xf7\xf8\x8d\xbc\x2a\xee\xfc\xe2\x0a\x96\xba\xc6\xcb\x57\x1b\x27\xea\x4b\xc9\xc6\x94\x0a\x7b\xb5\x0b\x3c\xc4\x8f\xfc\xfe\x1e\x60\xe8\x0e\xee\xb0\x49\xb8\xf7\x4b\x48\x6a\xa6\xa1\x49\x75\x36\xd6\x96\xc6\xb6\xe6\x4e\xf4\x3d\xb5\xd5\xd3\x16\xc5\x3f\x33\x27\x43\xbe\x5e\x23\x6e\x54\x96\x28\x8f\x25\x19\xb8\x65\x85\x70\xe1\xa0\x82\x20\x4c\x33\xea\x82\x00\xe8\x66\x86\x85\x05\x3e\x94\x02\x23\x3a\x60\x44\x4b\x48\xad\x03\x5a\x44\xf4\xa3\xce\x9c\x54\x2b\x56\xe0\xb9\x0c\x06\x55\xa1\x2c\x58\x75\x50\x3b\x09\xb1\x57\x3f\xda\x25\x39\x1a\xd5\x08\x76\x2d\xb0\x6a\x89\x7d\x42\xef\x4c\x47\xbe\xe8\x5a\x03\xa3\xf2\x19\xc0\x25\xad\xac\xdd\x9e\xb5\xd8\x90\x49\x76\xe0\xee\x79\x75\xa9\xd8\x70\x93\xd4\x72\x1b\x7a\xe1\x1a\xc8\xb9\x3b\x66\xe5\x98\xad\x6f\xeb\x1f\xca\xdf\x1f\x50\xbc\x8c\x23\xd3\xfd\x53\x8a\xac\x0c\xfe\xb4\x0d\x8c\xf9\xcf\xe2\x27\x24\x19\x2f\x0e\xab\xcd\x3e\x00\xa9\xd6\x5e\x1a\xfc\xca\x9d\x87\xa1\x4b\xe5\x6f\x22\xa5\xd7\xea\x06\xf6\x93\x6e\xea\x26\x40\xfb\x60\x52\x5b\x31\x33\x1f\x81\xbf\x83\xd4\x2c\xfb\x7e\xfb\xbe\x44\xd4\x75\x66\x95\x22\xae\x9e\xa8\x30\xca\x1f\xa4\xb1\x89\xb4\xf4\xda\x51\x1a\x33\x01\xda\x35\xa6\xb6\x32\x6b\xcc\x08\x69\xd6\xd8\x1d\x76\xd1\x4d\xb0\x7c\x4d\xcc\xca\x72\x95\xcf\xd7\xa6\x24\x5f\x42\x17\x99\xe0\xc9\x4c\x59\x33\x71\x26\xe1\x55\x85\x36\xe4\x03\x03\xe8\x0c\x2b\x3f\xd3\x9b\x59\x2d\x64\xa4\x79\x8c\xb5\x8d\xbb\xf8\x7f\x63\xc6\xc3\x15\x83\x01\xec\x83\xe8\x5b\x1a\xd6\xa4\xa6\x22\x81\x75\x3f\x11\xa3\x69\x25\xed\x9a\x86\x36\x2d\x4d\xf0\x97\x95\x9b\x01\xcc\xeb\x44\x6c\x73\x42\x92\x0b\xc3\x5f\x5b\x49\xa6\x65\xc0\x5d\x34\xda\x8d\x24\x23\x3e\xf3\x49\x7e\x96\x0f\xe3\x95\x6e\x53\xe8\x56\xc8\x94\x3d\x86\xae\xcf\xd7\x9f\x30\xc3\x1d\xfb\xf4\x2d\x24\xee\x69\x85\xee\xb7\x19\x77\xd9\x90\xb8\x5d\x10\xc8\xf7\x61\xf9\xf2\x0c\x12\xbd\xf5\x6e\x20\x35\xd3\xbd\x99\xc8\x0d\x37\xf6\x6c\xbc\x7d\x6c\xec\x81\xb2\x3b\x9f\x7e\x7c\x9c\x20\x44\x3d\x27\xdc\x22\x5e\x6b\xd2\xa5\x85\x06\x16\x38\x75\xd3\x4d\x5d\x50\xa3\xdf\x78\xa3\x2f\xf6\xcb\xb9\x78\xb2\x8a\x2b\x08\xf7\xd7\x2b\x5d\xc3\x40\xaf\x0b\x83\xa7\xfd\x0a\xf3\xad\x0f\x6c\x35\x38\x50\xbe\xaa\x37\x45\xf9\x6a\xe7\x00\xde\xe4\xcb\x9d\x3b\x1a\xf7\xd0\x39\x02\xdc\xcd\x22\x8e\x45\x32\x91\x00\xbb\x28\xc4\x1d\x4e\xf0\xde\x5c\x1a\x56\xbc\x8f\xe2\x7d\xb8\x8f\x59\x68\x41\xa2\x2d\x98\x75\xba\xa0\x71\x67\xce\x41\x2e\xfb\x3b\x4d\x67\xa1\xf7\xe1\x53\xe8\x7d\xc0\x7f\x7b\xbe\x58\x10\x33\x1b\x35\xe2\x94\xe8\x68\x08\xc7\xa8\x99\x8b\xad\xed\xbc\xd9\xbd\xb6\x9b\xfe\x96\xc9\x8d\x72\x8b\xe4\x66\xb6\x01\x2f\xcf\x3c\x1e\x8f\xf4\x5a\xc8\xd5\x3c\x5c\x2d\xe7\xeb\x30\x75\x17\xf3\x70\x3b\x5b\xcc\x57\x7e\x80\x55\xb2\xd8\xe0\x7f\xc3\x9f\xbd\xd9\x72\x1e\xac\xd2\x60\xbe\x5d\x2f\x67\xc1\x7c\xbb\xfd\x79\x33\x0b\xe6\xfe\x76\xa1\x5f\xaf\x39\x56\x5a\xf8\x55\xd1\xa0\x2a\x4b\xf2\xa8\x41\x93\xe6\xc1\xd1\x53\xfc\x1d\x18\x78\x6b\xa5\x09\x37\x9e\x2e\xb5\x1b\x4f\x97\x1f\xb5\x5b\x3d\x65\xb5\x91\xcb\x4e\xaf\xd6\x00\x9f\xf9\xc6\xde\xef\x07\x5e\x6e\xad\xe4\xa2\x9a\x48\x79\x92\x11\x4c\xa0\x4f\xd6\x22\x26\x0d\x75\x71\xae\x80\x5b\xff\x5d\x0c\x72\xf5\x2e\xd9\xce\x62\xdc\xe5\xcc\x5d\x0a\x03\x9d\xdd\xe7\x5a\x7d\xfe\xb8\xf8\x28\x0f\x78\xa3\xb9\xd8\xba\xfd\x86\xb6\x52\x3f\x27\xcd\xe1\x7c\x91\xfc\xda\x40\x99\x79\x29\xcc\x80\x42\xe9\x8b\x84\x35\xe5\xef\x0e\xbe\xff\x42\x7e\xd9\x45\x69\xaa\x86\xd2\x13\xe8\x51\x25\xe3\x57\x18\xbd\xae\x36\x10\x56\x35\xe8\xeb\x8c\x94\x73\x56\x78\x0d\xe5\x88\xae\x87\xe0\x62\x77\xb6\xc4\xc5\xd2\x3a\x89\x50\xae\xcf\x6f\xf4\xa5\x0b\x31\x2e\x1e\xe3\x7a\xc3\x8b\xa7\x1c\x7e\xa1\x17\x21\x77\x2c\xaa\x4c\x03\x11\x39\x31\x43\xbd\xe5\xed\x58\x7f\x7b\x0e\x46\x88\x69\xfc\xd9\xba\x09\x06\xa9\x9d\xbd\xb3\x37\xbe\x6e\x42\x13\x9c\x41\xad\x9b\x54\xde\x69\xd4\xa0\xff\xfd\x03\xbf\xf0\xdc\x56\x39\x8e\xc3\xb7\x9c\x7b\xe8\x1a\x98\xe5\x62\x46\xb2\x09\x1c\x5c\xca\x5c\x8b\x63\x5b\xfd\x20\xc0\xe6\x9d\x99\xfd\xaa\xb9\x69\x1f\x04\xe0\x2b\xf7\x04\x0f\x98\x5c\xbb\xfd\x5f\xf2\x32\xf6\x69\x9f\x33\x3a\xc3\x8c\xca\x12\x45\x55\x94\x1f\x90\x70\xed\xa0\x5a\xa8\xfc\x7e\x85\x76\x00\x80\x91\xf8\xe3\x75\xb7\xe0\x01\xe8\x77\x64\x89\xe6\x5b\x94\x3e\x75\x41\x39\xd3\x39\x38\xf0\x14\x1c\xbf\x65\x4f\x69\x93\x94\x29\xfa\xe2\x28\x15\xd8\xd2\xbe\x90\xa5\x01\xf2\xf8\xf9\x9d\xff\xee\x4b\xb7\x30\x2f\xdd\xa3\x29\x6d\x56\xd4\xdc\x17\x48\x2e\x63\x33\x69\xaa\x61\x32\x6b\x4e\x7a\x8c\xda\x32\x12\xbe\x0d\x43\x64\x88\xca\x88\x90\xaa\x3e\x97\x97\x78\x3c\x82\x7c\x26\x93\x64\xcf\x64\xa7\x60\x72\xeb\x6c\xf0\x93\x44\x27\x0e\x21\x3c\xed\x4f\x9f\xd2\x03\x3b\xe0\x56\x5b\x70\xf1\x5e\x65\x20\x3d\x0d\x7d\xc4\x92\xe9\x83\xe4\x81\x35\x40\xf0\x6b\x8f\x74\xab\xb2\x65\x99\xf1\xfa\x29\x4f\xdb\xa7\xa5\xed\x6f\xb1\x7d\xa0\xbf\x86\xd6\xce\x03\x56\x0f\x47\xec\xf0\x81\x47\xef\x15\x63\x56\xa0\xa5\xad\x39\x89\x9b\x40\x34\x70\x60\x95\x49\x64\x6f\x68\xcc\x08\x74\xd3\x28\x3f\xfd\x80\xf2\x87\xff\x34\x6f\x1f\xe9\x96\x47\xfe\xa5\x2a\x9e\x6b\xf4\x0e\x40\x03\xb4\xfe\x0d\xbf\x33\xdc\x3d\x69\xf2\x45\x45\x15\x35\x4d\xf5\x83\x00\xa0\x88\x44\x59\xf2\x92\x56\x79\xf8\x49\x43\x4f\x3b\x89\x7e\xc5\x6b\x55\x7c\x8d\x8e\x7e\x6f\x5a\x57\xb2\xc6\x7e\x20\x37\x4a\x7b\xa8\xd7\xdd\x7a\x5f\xb7\xa5\x44\x59\xe4\x32\x4b\xc1\xd8\x7f\x7b\x7f\x55\xfd\x0f\x6d\x12\xa0\xd3\x08\x3f\x9e\x26\x77\x1f\x8f\x0a\x4a\x99\x1d\xf9\x17\xa2\xc5\xfc\x84\x2e\xfa\x28\xf6\xe7\x4b\x31\x75\x80\x3d\xe5\xce\x7d\xde\xd4\x84\x15\xf5\x66\x5a\xa8\x76\xb7\xe3\xf4\xea\x34\x21\x1f\x6f\xce\x4f\xd9\x5e\x5f\xd9\xc7\x46\x81\x8d\xc4\x19\x3b\x31\xc8\x34\x30\xef\xa4\xe4\xad\xf0\xd7\x77\x46\x2c\xbc\x65\x71\x70\xd2\x6d\x41\xd3\xa4\x08\xcb\xcf\xb0\x4c\x2b\x6e\xd2\x67\xaf\xcd\x81\xa4\xed\xc2\x19\x77\x6a\x7e\xbe\xe8\xcd\x7d\x9f\x18\xf7\x3b\x85\xd2\x46\xcb\x57\x8d\x7c\x62\xa0\x68\xd3\x13\x20\x48\x42\xd2\x18\x38\x5a\x90\xf1\x23\xa0\xa6\x0f\x47\x03\x38\xaa\xa7\x3c\xc7\x2f\x44\xcc\x8b\x94\x67\x87\x5b\x90\x78\xce\x51\x9c\x30\x94\x1b\xc6\x81\x84\x87\x08\x05\x48\xb9\x12\x10\x3a\x97\xdd\x1b\x18\x38\x18\xc4\xf1\x6b\xb0\xef\xeb\x8c\x19\x23\xff\x2f\x66\xc9\x63\xe6\xea\x2b\xcd\x58\x55\x85\x2a\xbe\x71\xf6\xab\x60\x99\x6c\xbb\x42\xfb\xbf\x47\x73\xad\x47\xcf\xc3\x6a\x52\x87\x40\xa8\x67\xb9\x19\x6e\xb0\xec\xfa\xbf\x98\x61\xdf\xcb\x86\xeb\xce\x78\xeb\x2b\x6c\xb7\xbe\xd6\x68\xeb\xbb\x5b\x2b\x60\x98\xc6\x0a\x4a\x55\x5e\x56\x17\x58\x3b\x26\x69\xea\xa6\xc5\x33\xb8\xb8\x28\x0f\x8a\x01\xdb\x27\x98\x9e\xca\xb2\x3f\x7d\xca\x76\xb0\x84\x65\xfb\x78\x3d\xee\x6e\xd5\xd1\xe0\x33\x1a\x56\xe7\xc7\x62\x51\x5e\x80\x4c\xf2\x31\x3a\x46\x4f\x69\x63\x46\xa2\xb9\x96\x93\xd9\x50\xe7\xb2\xd1\x94\xeb\xb1\x24\xed\xbb\x2f\x80\x38\x99\xad\xc2\x7e\x9f\x51\x3d\x75\x14\xdf\xa1\x37\x6c\x60\xe7\xd1\xb7\x7b\x1c\xf0\x97\x16\x7f\xb4\xe3\x72\x6a\x8e\x35\x42\x96\x26\xcd\xb4\xa6\xb4\x20\x03\x80\x83\xf2\x43\x12\xdd\x6f\x9a\x61\x01\xce\xab\xc9\x81\xe6\x13\xb2\x75\xa8\x46\x87\x71\x34\xd1\xbe\x96\xcf\xd4\x8a\xc1\x39\x1d\xb6\x3d\xe4\x8c\x3c\x91\xdc\x2d\xb2\x04\xe8\xde\x7f\x19\x8c\x74\x5f\x0b\xf8\x81\x49\x4b\xdd\xcc\xc7\xc3\x16\xc3\x86\x4c\xbe\x38\xa0\x53\x13\x25\xa8\xd4\x50\x59\xca\x4b\x52\x34\x1e\x9f\x75\x7f\xe1\xde\xda\xa4\x7c\xd5\x5c\x0d\x89\x93\x64\x47\xe9\xe9\x41\x5d\x98\xcb\xe9\x5f\x46\x2c\xb3\xc8\x93\xef\xac\xfb\x4b\x16\xb3\x7b\x02\x72\x9e\x2a\xe5\x90\xf7\xe3\x35\xa7\x9a\x31\xee\x32\x49\x53\xdd\x10\x20\x1d\x2a\x90\x5d\x66\x0f\xa1\x8e\x66\xb6\xed\x70\x8d\xb8\x90\x8c\xa2\xc6\x6f\x29\xc1\x66\x07\x32\xa0\xe8\x87\x3f\x28\x16\x7a\x5a\x3f\x41\x31\x80\xca\xa5\x13\x5a\x85\x62\xba\x10\xec\xd1\x89\x63\x1f\xd5\x09\x16\x52\x0f\x46\x16\xac\xbe\xa1\x9d\x4f\x01\x4e\x55\xf1\xbc\xf3\x21\x8a\x4d\xb4\xe7\x79\x01\x7e\x22\x3f\xca\x48\xd8\x55\x4a\x47\xbf\x04\xc3\xec\x42\x49\xad\x93\x47\xdf\xf6\x51\xf5\x5d\x8e\x44\x9b\x4f\xec\xc8\xc9\x0f\x58\xd6\x03\x2d\xfb\x01\x39\x5b\xe3\xee\x51\xf3\x8c\x50\x6e\x9a\x22\x49\xa6\x14\xdc\x22\x4a\x72\x54\x39\x7a\x91\x7b\x4c\x9f\x92\x18\xaa\x48\x4f\x50\x69\x06\xc2\xd6\x19\x54\xda\xa6\x97\xbf\x73\xb1\x71\x19\xb9\xfb\x4a\xfc\xb8\x23\x7d\x68\x90\xbe\x73\x2c\x7c\xf8\x4b\x0b\x2f\xd7\xf6\x25\x0f\x9c\x6d\x31\x27\xda\x92\x59\x13\xe6\xe8\xbe\x6c\xe0\x4d\x87\x01\xa7\xbd\xc7\x47\x26\xf2\xb8\xe2\xad\xce\x78\x11\x66\x36\xf9\x6b\x9e\xa7\x20\x95\x1b\x19\x12\xc8\xd6\x4d\xd4\x24\x07\x96\x14\x56\x22\x25\x1d\xa1\x33\x6b\xd3\xf8\xd5\xaa\x43\xc4\x53\x19\x1b\x67\x2e\x12\xaa\x08\x93\x17\xf9\x3d\x34\x7f\x8d\x3f\xe0\xcb\xfb\x43\x8e\xa4\x54\xda\x49\x1c\xed\x4b\x3d\x74\x7c\x6a\x54\xca\x36\xab\xab\xa1\xbf\x7e\x04\xa6\x14\xd3\xe4\xa5\x23\x8c\x93\x81\xba\xc9\xa1\xc8\xad\xfb\x1a\xe6\xa1\xb8\x37\x1f\x48\x7a\xc8\xf6\x17\x0c\x6d\xb6\xa5\x52\x65\x7f\x44\xb1\x74\xaa\x9c\x91\x34\x14\x5d\xbe\xd7\xa8\xed\xd2\xe6\x86\xf3\x2d\x4d\x66\xcb\x98\xa7\xdf\x83\xe9\x21\x25\x75\x7e\x05\x2b\xe5\x99\x16\x06\xe9\xe7\x5c\xb8\x3e\x1b\x68\xdf\xcf\xc3\x70\x7d\x9b\x0e\x8d\x3b\x4b\xca\x60\x15\x71\x3f\x26\x48\x26\xc3\xaa\x78\x9e\xb1\x93\x9e\x60\xe1\xd4\x64\x5f\x2a\xb9\x99\x38\xa7\x01\x53\x56\x55\x3c\x3f\xea\x45\x76\x3c\xb3\xa1\xdc\xd4\x43\xcd\x0d\xd3\xd9\xdc\xf0\xe1\xfd\xf5\x1f\xdd\x7a\xe4\xd7\xb5\xa8\x6e\xa1\xc0\x22\xb4\x6e\x3e\x05\x5e\x4a\xff\x94\x64\x65\x51\x35\x51\xde\x48\xaf\x27\xa1\xd8\x34\x09\x77\x4e\x29\x9b\x84\x69\x22\x38\x23\x13\x7c\x36\x95\xbc\x43\x60\xd0\xaf\x57\x6b\x78\xd0\x67\xb1\x45\x6d\x52\x25\xac\x36\x19\x44\x57\x9b\x5c\x9f\x0d\xb4\xd7\xd5\x26\xd7\x5f\x35\xe8\x79\xea\x6e\x15\xf1\x77\x1d\xf4\x59\x7c\x9f\x41\x2f\xe3\x99\x3c\xe8\xd5\xe6\x37\x0e\xfa\x7f\x38\xeb\xb9\x6a\xd0\x0b\x42\xfb\xdb\x0d\x7a\x81\x89\xb1\x83\x7e\xbb\xf5\xe1\x41\x4f\x4e\x2b\x9b\xd4\x26\x55\xc2\x6a\x93\x41\x74\xb5\xc9\xf5\xd9\x40\x7b\x5d\x6d\x72\xfd\x55\x83\x9e\xa7\xd0\x57\x11\x7f\xd7\x41\x9f\x9e\xee\x33\xe8\x65\x3c\x93\x07\xbd\xda\xfc\xc6\x41\xff\x0f\x67\x3d\x57\x0d\x7a\x41\x68\x7f\xbb\x41\x2f\x30\x31\x76\xd0\xfb\xfe\x76\x0b\x8f\xfa\x36\xb5\xe8\x4d\xaa\x84\xf5\x26\x83\xe8\x7a\x93\xeb\xb3\x81\xf6\xba\xde\xe4\xfa\xab\x46\x7d\x77\x97\x85\x8a\xf9\xbb\x0e\xfb\x36\xbd\xcf\xb0\x97\xf1\x4c\x1e\xf6\x6a\xf3\x1b\x87\xfd\x3f\x9c\xf9\x5c\x35\xec\x05\xa1\xfd\xed\x86\xbd\xc0\x04\x3c\xec\x65\xf8\xef\x69\xdc\x66\x1b\x18\x32\x00\xab\xf6\xad\xaa\xb7\xea\x7d\xda\x9c\x21\xb7\xbd\xcb\x30\xbd\x65\x8c\xde\x73\x80\xfe\xbd\x6b\xe6\x9a\xe1\xf8\xb7\x1f\x8b\xf6\x81\xc8\x2b\x53\x7a\x88\x46\x5a\x81\x97\x4e\x41\x91\x8b\xb2\xb6\x0f\xb6\x06\xca\xe2\x23\x04\x21\x5e\x33\x31\x0a\xb1\x6c\x56\x7a\xc3\x70\x54\x43\x2b\x67\x32\xa4\x89\xc3\xf5\x28\x42\xea\x57\x56\x11\xc3\xc2\x8a\x81\x7e\x02\xfb\x49\xfe\x7e\x3a\x44\x4d\xf8\xce\x38\x04\x5a\x9f\x8b\x67\x1b\x20\xf8\x7d\x72\x94\x8a\xb8\x5d\x41\xca\x91\x3f\xe3\x4a\x77\xae\x0d\xa0\xa3\x0b\xd1\x6f\x77\x20\x7a\xe1\xf5\xc7\xd4\xf0\xb3\x74\x4e\x6d\x81\xff\x1f\x3a\x44\xcf\x7a\x30\x63\xff\x3f\x0f\x1f\x3e\xb2\x3a\x2c\x36\x74\x88\xca\xcf\x1f\x09\xbb\x5d\x71\x96\x34\xa8\x4a\x93\x2c\x69\x3e\x7f\xf4\xbd\xae\x98\x71\x11\xd0\x33\x6f\xcb\xd9\xfa\x1c\x04\xbf\x2c\x67\x7e\x48\xff\x06\x8b\x73\x10\x00\x27\xae\x61\x89\xa1\xb6\x99\x32\x34\x48\x02\xbe\x68\x92\x8a\x49\x0b\xeb\x28\x62\x20\x23\x06\x78\x1c\x55\x5f\xc1\x99\xa6\xfb\xaa\x0f\x43\x29\xe4\x01\x00\xf5\x02\x62\x18\x99\x71\x4a\x09\xc2\xd0\xe1\xff\x13\xa5\x67\x6e\x6d\x63\x69\x60\x5a\x91\x88\xad\x47\x51\x03\x27\x17\x09\x4f\x60\xc5\x63\x9c\x62\x2c\x24\x95\x19\xc6\x02\x29\x4d\x30\x3a\x9c\x71\xff\x03\xcc\x30\x30\xa9\x28\xea\x01\xa6\x16\x09\xc2\x7f\xb0\x62\xfe\x3b\x9e\x5f\x30\xfb\xb3\xfe\x9f\xbf\xd9\x2c\x23\x8b\x4d\x99\x64\x46\x0d\x16\x69\xa6\xb1\x28\x1b\x9a\x5e\x20\x08\x7d\x80\x1f\xa2\x2a\xbe\x69\x87\xc8\xc8\xcf\xec\x62\xa2\xf9\xe7\xa2\x8a\xa9\xcb\xb7\xaf\x50\xf4\xd5\xc5\xbf\x47\x5e\x49\xda\x6d\x53\x1b\xba\x91\x34\x30\x5e\x49\x8a\x7b\xfc\xd3\x59\xd9\xb3\x2a\xdf\x86\xe8\x31\xa8\x39\xf9\xfa\x4f\x2f\x87\x10\x92\xd2\xcd\x84\x72\x76\x25\x58\x5f\x79\xb9\x6d\x47\x9b\x46\xb7\x4f\x86\xa8\x93\xed\xeb\x6c\x77\x62\xc8\x94\x81\xec\x78\x22\x6d\x77\x5f\xc4\x2f\x83\xbb\xa4\xc4\xec\x78\x65\xdb\xed\xd7\xf1\x25\x4c\x4d\xd2\xa4\x48\xd9\x24\xc8\xb3\x5c\x12\x80\xfa\x69\x2f\xc1\xd0\x03\x4b\x0b\x30\xa5\x2f\xc7\x89\xda\x46\xec\x36\x0c\x35\xb8\x75\xb2\x83\xfa\xb1\x7f\x94\x12\x19\xcb\x3d\x61\x57\x93\x75\x1b\x16\x78\xaa\x81\x00\x4c\x3e\x6c\xca\x83\x40\x0c\xd3\x5b\x3c\x3c\x9a\xf6\x5a\xaa\x06\x2c\x51\x87\x6c\x8c\xe9\x4f\x4c\x86\xe2\xce\xfc\xb2\x7d\x98\x01\x45\xde\xcc\x93\x10\xfe\x28\x98\xd3\x58\x8b\xee\x24\x7c\x2c\x8a\xc6\x2c\x91\xb1\x12\x90\x6f\x08\x34\x74\x9f\x92\x02\x6c\xbd\x3b\x29\xe9\xcd\x46\x49\x40\xea\x3d\xdd\xf5\x2a\x67\xb8\x9e\xaf\x20\x85\xba\x72\x8e\x69\x9a\xec\x86\x83\x2a\xb7\x4d\xcb\x24\xc8\xce\x45\x3b\x0d\x09\x1b\x6b\x9d\x64\x27\x17\xdb\x6e\x1a\xbd\x8c\x3f\xe4\xca\xb6\x2f\xc1\x23\x31\xc9\x4e\x4e\x8f\x9b\xb6\x11\x0a\x9a\xa2\xec\x63\xf3\x1c\x9d\xc8\x2b\x80\x6f\x63\xac\xcf\x15\x1e\x4d\x9e\x74\xb3\x09\x80\x15\x23\x31\x4c\x7d\xba\x2a\x8c\xd3\xa0\x51\x6b\x50\x17\x6c\x93\x9e\x99\x26\x30\x01\x1a\xa9\xc6\xe8\xf0\x75\x46\xdf\x91\xb2\x51\xf8\x61\xd9\x5a\x76\x97\x74\x8d\x27\xec\x57\xeb\x16\xe9\x80\x75\x3b\x52\xa4\xa4\x63\x0f\xcb\x56\xb6\x22\xc2\x93\xc6\xb7\x30\x95\x7b\xc2\xed\x2f\xe4\x59\x3f\xb6\xa1\xce\x65\xd2\x9c\x88\xf1\x33\x02\xfc\xae\x83\xeb\x24\x43\xaf\xea\xb9\x9f\x68\x00\xa6\x46\x74\x5b\x19\xb4\x42\xeb\x1f\xa5\x8e\xb1\xa1\x25\x9e\xd9\x06\xda\xdc\xef\xee\x8f\x41\xcc\x33\x71\x96\x71\x46\xc3\x03\xa3\xf4\x5a\xd2\x74\x4e\x9e\x46\x7a\x78\xcc\x1a\x19\xb8\xc3\x05\x26\xc3\x88\x47\x49\x15\x68\x30\x30\xf9\x4d\xa1\x6d\x17\xab\x81\x36\x28\x57\x99\x03\xc6\x02\x75\xc5\x6b\x70\x42\x63\x8e\xd9\xc0\xc0\x65\x18\xba\x03\xcd\xf4\xb7\x7b\x28\x9e\xf2\x66\xb7\xa0\x07\x52\x95\x32\x15\x44\x6e\x79\x8a\xca\x6e\x9f\xa7\xd8\x5a\x2c\x07\x8a\x8a\xaa\x3c\x47\x79\xbd\x23\xf9\x8e\x8b\xe7\x7a\xe7\x83\x5d\x1c\xc8\xb5\xf2\xfa\x3a\x8f\x0e\x87\xa2\x8a\x93\x22\x67\xd3\x86\x72\x95\xb1\x06\x20\x18\x78\x71\x74\x9b\x97\x52\xbd\x8e\x6e\x60\x74\xdb\x8d\x14\x24\x46\xd5\xae\x52\x9b\x74\x18\x43\xc1\xfb\x93\xe4\xd5\x2a\xde\xd4\x23\x74\xaa\x07\xc7\x69\xf1\xa1\x7a\xca\xf6\x77\x3c\x48\xd5\x79\x8d\xba\xcf\xe5\xd3\x7d\xbf\xd2\xd6\xeb\xc1\x6c\x1a\x4a\x34\xd3\xf3\x4c\x3c\xda\x1f\xd5\x82\x0b\xf4\x3d\x65\xa8\x91\xfd\x46\x73\xe8\xab\x8d\x7c\x5a\xa8\xdb\xd4\xfb\xe9\xdd\x08\x62\x24\x84\xe9\x48\xaa\xa1\xcc\x53\x1e\xa3\x0a\x53\xbf\x19\x13\x0d\x8a\x94\x36\xca\x61\x23\x9e\x08\xba\x8c\x4e\x49\x4e\x9a\x0d\x9b\x82\xb2\xb7\x5e\x53\x28\xa8\xb7\x32\x3a\x21\xf6\x11\x6c\x20\x47\xbb\x7c\x4c\x04\x72\xd3\x71\x70\x2a\xe7\x6c\x09\xba\xa4\x2d\xc6\x6c\xb6\x70\x92\x1a\x7e\x32\xac\x63\x4f\xb9\xfd\x38\xe8\xf1\x86\xab\xfd\x62\xec\xbd\xdc\xb2\x09\x4b\xa7\xb5\x44\x5a\xca\xe5\x48\x57\x66\x40\x23\xf8\xd4\xf0\x6e\x26\x48\x1c\xf4\x78\xec\x2b\x19\x96\xf5\x84\x9e\x9c\xb8\x88\xd1\x53\xb3\xaf\x85\x3c\x0e\x2e\x69\x08\x14\x98\xb1\x8a\xd8\x7b\x61\x8d\x38\x2b\x06\x26\xb3\x17\xf1\xf3\xa5\x63\x91\xc2\xe8\xe3\x96\xf4\x6e\xfd\xd1\xc7\xf4\xc4\x41\x46\x36\x00\x09\x43\x42\x0d\xb8\xc3\x51\x57\xce\xc0\x18\x6d\x96\x60\xd2\xfd\x62\x40\xf5\x0b\xae\x17\x98\xda\x44\x43\x58\x0c\xd9\x81\x46\xae\xce\x40\x71\x4d\xba\xec\x05\x46\x78\x8d\xb4\x82\xa1\x81\x62\x64\xff\xaa\x61\x33\x38\x6a\xe8\x3b\x2e\x8a\x4f\xf6\x17\x18\x96\x17\x16\xd7\x52\x92\xd6\x3a\xfc\x20\x25\x00\x5b\xab\x09\xc0\xc6\xdd\x9c\xa5\x9c\x76\xd9\x47\x35\xc2\x58\xe0\x84\x92\xc2\x61\x6e\xd3\x89\xf3\xbf\x83\x13\xeb\x54\xa2\xda\xc1\xf3\x88\x56\xb0\x75\x79\xfe\xcb\xb6\x2e\x49\x21\x50\x56\x36\x2f\xca\x36\x83\x7d\x93\xcf\x18\x1d\xfd\xb5\x28\x5c\xc7\x8c\x21\xc8\xc2\x93\xba\x85\x64\xa5\xed\x20\x59\xf5\xd6\xc2\xb3\x31\x78\xbd\x81\xb8\x65\x95\x64\x51\xf5\x32\xea\xa4\x6d\x24\xb7\x91\x7b\xdc\x95\xaa\x5f\x1f\x40\x7c\xab\xe0\x70\x50\xf1\xcd\x61\x7c\x4a\xe2\xb1\xb1\xaf\x42\xfc\x26\xa4\x88\x6a\x74\x28\xf2\x78\xb0\x93\xcc\xf5\x89\xd4\x56\x4a\x37\xfb\xf2\x11\x1d\x0d\x97\xe1\x7e\x15\xe8\x38\xe7\x26\x9c\x53\x3a\xeb\x7b\x1b\xc7\xf7\xd7\x8e\x1f\xc8\xdd\x7d\x3a\x1c\x50\x5d\xdb\x19\x0b\x36\xd1\x7a\x19\xf6\x8c\xd1\x36\x6a\x57\x59\xe9\x88\x8e\xfa\x68\x8d\x16\x4b\x15\xdf\x1c\xc6\x37\xa5\x93\x4b\xcf\xf1\x57\x6b\x67\xb5\x15\xbb\x98\xe4\xc7\x62\x80\x9f\x75\x14\xec\x37\x1d\x3f\xb8\x81\xd2\x39\x52\x34\xa6\x67\xfe\x3a\xda\xec\x25\x4c\x73\x00\xd3\x94\x3e\x05\x0b\xc7\x5f\x05\x8e\xbf\x59\x8a\x9d\x7a\x8e\xaa\xbc\xcf\xc1\xfa\x3e\xf0\x83\x30\xd8\x82\xfe\xc4\xc1\xf7\xd6\x1d\x43\xac\x99\xd2\x3b\x5e\x2a\x75\xd0\x88\x32\x5e\x6c\x91\xe7\xa9\x28\xe7\x30\xca\x49\x3d\x0d\x43\xc7\xdf\x2e\x9c\xb5\xd8\xd1\x38\xca\x4f\x43\x52\x8f\x0f\x8b\x50\xb0\x4f\xda\x44\xe9\x23\x2b\x1c\xa1\xc3\x7d\x1c\xf8\x0b\x4f\xc1\x36\x07\xb1\x4d\xea\x5d\xe0\x39\xe1\x42\xb1\x4d\xb2\xdf\x63\x58\x89\x9b\xe3\xf6\x18\x75\x1c\x91\x46\x4a\xf7\x68\xd9\x48\x05\x46\xc8\x43\xa1\x8c\x6e\x0e\xa1\x9b\xd4\xbd\xe5\xc6\x09\x96\x5b\x27\x08\x3d\x59\x7d\xd5\x40\x62\x06\x9a\xa7\x5a\x10\x77\xf5\x55\x53\x5d\xf5\x75\xd4\xe0\x8b\x03\x3f\x58\x4a\x98\xe6\x00\xa6\x29\xbd\x0a\x03\x27\xdc\x38\x2b\x36\xf4\xfe\xfa\x94\xed\x8b\xa6\x2a\xf2\xce\x89\x0c\x0c\x8b\x15\x86\xcc\x5d\xf0\xe2\xc4\xc2\xbe\xca\xa6\x53\x5d\x62\xaa\xc4\x71\x13\x78\xa2\x9b\x38\x07\xb6\xba\xaa\x19\x45\x5f\xe7\x51\x8a\x2a\x28\x39\xf0\xa8\x0f\x9b\x7e\xef\x5d\x4e\x3a\x60\x4d\x88\x92\xc5\xa6\x7e\x0e\x63\x99\x02\x78\x25\x71\x6b\x15\xd7\x92\xd7\xc5\x49\x9d\x25\x75\x9d\xec\x53\xa4\xf4\x77\x29\xa0\x17\xa0\x66\xf3\x43\x5a\xd4\x68\xf0\xd3\x99\xa1\xd3\x20\x83\x8a\x2f\xe4\x79\x4b\x6f\x13\x02\x3a\x3f\x1c\x50\xa8\xdf\xdd\xb5\x89\x23\x1c\x4c\x4a\xa8\x66\x67\xe9\xba\x69\x06\xba\x3d\x1e\x62\x1d\x54\x94\x52\xc7\x41\xb0\x0e\x03\x0e\xa8\xf9\x31\x8b\xcd\x22\x5e\xfa\x90\x51\x06\x68\x81\x42\x35\xea\x5c\xc5\x9b\x78\xaf\x21\x83\x59\x3c\x6c\x0e\xfb\xc3\x51\x07\x06\x98\x0c\xbc\x60\x11\xac\x3a\x50\xd9\xfb\xf0\xc3\x70\x1d\x2c\xa1\x19\x6b\x89\xe2\x3e\x1b\x30\xa7\xba\x40\xab\xc3\x5e\x41\x05\x33\xb8\xf7\xe3\xe3\x5e\x03\x85\x64\xb8\x0f\x90\xbf\xe0\x80\xa2\xe7\xe0\x1d\xc2\xe5\xca\x83\x78\xf3\xd1\xe1\xe8\xab\xfa\x45\x28\x44\x7b\x11\x0f\xcc\x58\xb4\x8f\x63\x14\x4a\x70\x10\x57\xab\xe0\xb0\xe8\xb8\x52\x5e\xfd\x9b\x70\xb5\xf4\x96\xf0\x52\xc2\xe2\x10\x2b\x8c\x1d\x8f\x08\xed\x23\x05\x15\xcc\xdb\xf1\x88\x36\x91\xaf\x82\x02\xec\x85\x8b\xc5\xd1\xeb\xd8\x93\x5f\xd8\xeb\xc0\x3f\x80\x2a\x3d\x6e\xe2\xb5\xa6\xd2\x63\x78\x10\x54\x4a\x31\x19\x98\xf3\xf7\xde\x7e\xad\x40\x02\xbc\x2d\xb7\x7e\xe0\xaf\xfb\x49\x45\x78\xdd\x6e\xfc\x8d\xbf\x09\x20\xd6\x10\xfe\x4f\x65\x2d\x3e\xc6\x47\x24\x21\x82\x39\x43\x07\x74\x38\xae\x64\x40\x80\xb1\xd5\x06\xff\xd7\x77\xa0\x7f\x4d\xfa\x7b\x1f\x05\xd0\x40\x25\x63\x72\xab\x8e\x82\xd5\x61\x73\x88\x44\x3c\x86\x21\xb0\xdd\xef\xf7\x48\x82\x83\x2c\x6d\xe9\x85\x5e\xf8\xfa\xcf\xfc\x93\xcc\x57\xf4\x72\xac\xa2\x0c\xd5\xb3\xb2\x2a\x4e\x15\xaa\x6b\x77\x1f\x55\x6e\xdd\x54\x49\x89\xea\xcb\xb1\x2a\x32\x71\xbf\x5f\x37\xbf\xfa\x34\x3f\x75\x53\x80\xb5\xde\xcc\x7b\x7d\xfd\xe7\x37\xc4\x3d\xe7\x18\x87\xd7\xa3\xc5\xe4\x9c\xca\x97\x1e\x71\x55\x68\x1d\x4e\x79\xa3\xf3\xa5\x48\xa1\x5f\xf7\xcf\x52\x23\x9f\xd8\x61\x8b\x2b\xea\x91\x1d\x56\xac\xf6\x4c\xf0\xa3\xae\xbc\xda\x9c\xad\x91\x0a\x0b\x1c\xc4\x6b\x99\xcd\x57\x74\xbd\x64\xf4\x42\x89\x24\x24\x3d\x51\x1f\x60\x1b\xb1\xbe\xc5\x14\xbb\x72\x51\xe5\x9e\xb0\x02\x50\xde\xfc\xb0\x0c\x63\x74\x72\x80\x0d\xac\xe1\xc3\x2c\x08\x3f\x38\x82\x8b\xa2\xfd\x0e\xbd\x0f\x86\x96\xe6\x9a\xb5\x82\x43\xf9\xfd\xa0\xe7\x62\xe9\xb3\x58\x49\x3d\x8c\xf2\x24\x8b\x1a\x14\x77\x9f\x53\x69\x01\x16\x08\x34\x4a\x66\x7e\x3d\xa3\x7d\x9f\x25\xf9\x31\xc9\x93\x06\x3d\x4e\x6e\x71\x95\xaa\x6c\x9c\xd2\xa4\xc3\xd2\xcf\xd7\xd7\x39\x21\x32\xf9\xde\x7f\x7a\x12\x4d\x4c\x1d\x24\x9e\x42\x23\x38\xd5\xfd\x89\x6c\x63\xc7\xab\xb0\x81\xed\x7b\xe7\x88\x7a\x55\x37\xcf\xb9\x11\x69\x2d\xe6\x7f\x95\x33\xf6\x09\xc3\xb0\x73\x34\x61\x1c\x7c\x4f\xaf\xa1\x56\xfe\x04\xe5\x43\x64\x86\x3f\x41\xb1\x88\xd3\x44\x43\xfe\x0c\x68\x0c\x34\xf9\xcd\x19\x0a\x96\xf1\x1f\xf1\x06\xb6\x10\xc2\x9f\xe5\xf4\x3d\x83\x6f\xb7\x35\xf7\x8d\x77\xdf\x2a\xe8\xbb\x2f\x4e\x9a\xf2\x77\x13\xf2\x7d\x1a\xae\x31\x52\x69\x31\x1d\x6b\xdf\x32\xaf\xf8\x64\xa6\xa0\xfe\x51\x33\x08\x41\xcc\x6c\x43\xf8\x70\x23\xce\xa0\x25\x33\x25\x1b\x6c\x65\x2b\x61\x3b\x17\x55\xf2\x7b\x91\x37\x51\x3a\xe5\x74\x27\x88\x60\xd4\x1e\x59\xa3\x7e\x6d\xdb\x33\x46\x92\xd3\xed\x6d\xdc\x97\x53\x65\x93\xc9\x38\x6a\x80\xc4\x47\xb7\x1d\xa1\x73\x41\x79\x84\x3d\xc8\x12\xa6\x50\x50\xd8\xed\xbf\xfe\xeb\x44\x7c\xeb\xfe\x44\x90\xbe\x94\x1f\xec\x4a\xf3\x21\x9f\xf6\xbe\xab\x05\x81\x14\xdf\xd2\x88\x20\x82\x13\xec\x08\x6a\x7e\x77\x53\x1a\x45\x64\xa2\x35\x59\x32\x4e\xc1\x3c\x88\x99\xa7\xae\x35\xa7\x2c\xfe\xde\xe6\x94\xc5\xdf\xd9\x9c\x00\x82\x53\xcc\x09\x68\x7e\x7f\x73\x1a\x43\xe4\x76\x73\xe2\xb9\x8c\x60\x1e\xc4\x9c\x46\xd7\x9a\x53\x7a\xfa\xde\xe6\x04\x51\x7c\x53\x73\x02\x08\x4e\x31\x27\xa0\xf9\xfd\xcd\x69\x0c\x91\xdb\xcd\xa9\x4b\x92\x03\x33\xd1\xde\xee\x2c\x91\x04\x26\xdf\xd7\x9e\xda\xef\xec\x32\x41\x04\xa7\xd8\x53\xfb\x1d\x1c\xa7\x51\x44\xa6\xda\x93\xd8\xfe\x98\x3e\xd5\xe7\x99\x89\x6d\x2a\x68\x7e\xec\x13\x60\x5b\xff\x44\x35\x88\xdb\x14\xd0\x41\x82\x20\x18\xae\x3b\x41\x69\x0a\x4d\x46\x7f\x0a\xe2\x5f\x7d\x0c\xed\x27\xc6\xfb\x03\xad\xa4\x0f\xa6\x46\x96\xf8\xd7\xa5\x69\xc8\x95\x7d\xc0\xa6\x90\x90\xd2\x54\x42\x42\x5c\xa8\xd3\x1b\xff\xb9\x8a\x7f\x99\x32\x62\x98\x28\xc6\xc1\x76\x92\x20\x8d\x6c\xf1\x6f\x60\x53\xd1\x8f\x12\x25\xa7\x2a\x89\x92\x16\x02\x14\x47\x7e\x54\xe3\xdf\xcf\x0c\xed\xa7\x8a\xd1\xde\x4a\x12\xa2\x91\x25\xfe\x9d\x6e\x1a\xf2\x51\x22\xe4\x34\x25\x11\xd2\x42\x9d\xde\xa8\x0f\x7f\xfc\x1b\x1f\xd4\x78\xa2\xf0\x6c\x4d\xe4\x71\x6c\x62\x86\x7f\x48\x9c\x80\x79\xdc\x20\x66\x04\xe5\x41\x4c\x0a\x75\x62\xe3\xbf\x4c\xd2\x8f\x90\x86\xf6\x13\x85\x37\xd0\x4a\x92\x9f\x8d\x25\xf2\xb1\x73\x1a\xf2\x51\x22\xe4\x34\xe5\x7b\x8f\x49\xa1\x4e\x6f\xec\xd7\x53\xf6\xa1\x14\x6e\x3e\x51\x80\xf6\x46\x92\xfc\xcc\x0c\xb1\xef\xb1\x93\x70\x8f\x12\x1f\x27\x29\x89\x8f\x16\xea\xe4\x46\x7e\xe0\x65\xdf\x72\xc1\xd6\x13\x85\x67\x6d\x23\xdb\x9e\x89\x1d\xfe\xc5\x78\x0a\xea\x71\x96\xc7\x28\xca\x96\x47\x0a\x21\x4d\x8d\xf8\x04\xcd\xbf\x36\x43\x8d\x27\x5b\x9d\xb9\x89\xfc\xb6\x30\x31\xc3\x3f\x69\x4f\xc0\x3c\xee\x55\xc1\x08\xca\xaf\x0a\x52\xf8\xca\x36\x12\xd1\x2b\x39\x88\x2f\x2b\x9d\xb4\xd0\x6e\xc4\xd6\x37\xc4\x77\x8e\x90\xa7\x5c\x97\xef\x97\xed\xcc\x23\xd7\x30\x75\x37\xab\xcf\x43\x46\x50\xf5\xe6\xbc\x47\x43\x12\x0b\x02\x4c\xce\x08\xf2\x2f\x02\x0f\xe4\xe7\xbc\xff\xc9\xf4\x31\x06\x94\x52\xed\xb8\x59\x87\xaf\xfb\xa7\xa6\x29\x72\xbe\x9d\x6a\xdc\xbd\xd2\xdd\x45\x95\x37\x5d\x30\x1d\x51\xa2\x7d\xc2\x26\xe0\xdb\xc6\xeb\xbc\x29\xa2\xba\xb9\xf4\x89\x6f\x17\xa1\x57\xb6\xb6\x0f\xfb\x9b\xb5\x25\x3d\x85\xf4\x9d\x77\x13\x3e\x68\xb9\x67\xfa\xbb\x55\x86\x92\xcf\x3c\xc8\xfb\x0c\xa5\x0b\x4e\x54\x48\x2e\x27\x4c\x2d\xae\x8a\xd2\x3d\x26\x69\x83\xaa\xdd\x3e\x7d\xaa\x7e\xf0\x3d\x92\xb8\xc0\x5c\xd5\x5d\xcb\x6f\xd8\x95\x40\x44\xa4\x1f\x4d\x87\x93\xb2\x10\x60\x92\x68\x0a\xbf\xd8\x39\x6a\x5f\xac\x50\xee\xb2\xd3\x60\xce\x49\xac\x5d\x54\x85\x2b\xf8\x61\xd3\xa9\x5f\x84\xcd\x17\x24\xc1\xb7\xc7\x0c\xdc\xcd\x76\xad\xa2\xed\xb9\x5a\xbc\xf0\x81\x77\x93\x7c\x9d\x96\x3f\x69\xbe\xce\xb3\x22\x8e\x52\xb7\x28\x51\xae\x9f\x2f\xee\xeb\x66\xf4\xb9\x03\x71\x5b\x6e\xc1\x5d\xc9\x0b\xcb\x4f\x49\x01\xbb\x2f\xab\xc7\xa4\x45\x31\xdb\xdd\xa8\xde\xf5\xef\x85\xde\xa3\xa8\x8f\x47\xe0\xe2\x78\xfc\xac\x8e\x1a\xe1\x3a\x77\xca\x62\x9c\x44\x69\x71\x02\x3e\xe7\x52\x84\x34\x2d\x10\x31\x2b\x9e\xae\x14\x1a\xb2\x04\xd7\xfc\x18\xc5\x68\x26\xe3\x15\x36\x83\x48\xb7\x65\x1f\x8b\x2a\x9b\xcd\x17\xec\x5c\x8e\x72\x07\xed\xb5\x10\xce\x00\x09\xad\x9a\xe2\x49\xa3\x06\xfd\xe0\x39\x6e\x48\x86\x9e\xad\x72\xf4\x2e\x8b\x31\xf2\xe0\x5b\x2a\x08\x28\xbd\x24\x50\x02\xd5\xb9\xed\x2f\xb8\xed\x7e\xf2\xf6\xb4\x25\xbd\x6c\x6a\x10\x4f\x7d\x88\x52\xf4\x83\x3f\xf7\x02\xb1\xbb\x42\xa9\x6c\x1c\x6e\x7d\xa8\x8a\x34\xc5\x33\xf6\xf0\x40\xc7\xd3\x36\x33\x40\x92\x7e\x85\x5c\x58\xe4\x92\x6d\x32\x66\xb4\x9c\x63\xb6\xe3\xe9\x02\x20\xf9\x76\xe6\x58\x1e\x0d\x83\xcd\x88\x95\xa7\x5f\x18\x00\x63\x53\xd9\x60\xc6\x9c\x41\x7a\x64\xb2\x80\x07\x37\x6f\x44\x27\x3b\x14\xdf\x71\xe2\x14\x72\x77\x0d\x09\x9e\x53\xd7\x4e\xbd\xd3\xd9\xdf\x2c\xf9\xfe\xee\x2a\x03\x46\xa3\xdd\xbc\xe5\xc6\x38\x61\xbe\x9b\xc8\x96\xc5\xee\x84\xc1\x35\x16\x5b\x27\x4e\xce\x9f\x88\x83\x93\xf8\x0e\x39\xf9\x84\x57\x81\x32\x59\xdb\x0e\x2c\x5f\xeb\x15\x05\x0f\xd0\x59\x08\xfd\x45\xc3\xbd\x9d\x91\xef\xb7\xa5\x90\x99\xea\xdb\xb3\xa0\xe3\x6f\x67\x70\x9d\x52\xa3\x43\x66\xdf\xce\xdb\xd1\xab\x89\xdb\x23\x3a\xe9\xd2\x2c\x70\xcf\x2d\x6e\x37\xdd\x95\xd9\x6d\x32\x7c\x1c\xb8\xca\xf7\xd1\x9a\xa5\x6b\x31\x3a\x49\xd7\x42\xcc\x96\x25\xca\x64\xa6\x44\x0c\xbe\x72\x6e\x66\xe7\x92\x12\xf1\x5f\x71\xe6\x83\xd2\x06\x7a\xfa\x11\x6d\x61\x0a\xd5\x87\xca\x50\xfe\x42\x91\xb5\x57\x69\xf6\xff\x1b\x5e\xf2\x89\xf2\x18\xce\xc2\x8f\x2b\x64\x9f\x12\x4e\xa0\xa7\x28\xd8\x9c\x16\x6d\x31\x21\x2b\x1a\xa0\x66\x2a\xa9\x9f\xfe\x70\xe1\xfe\x5e\x20\xfa\xb9\x74\x9e\xdb\x47\x95\x9b\xa1\xa8\x7e\xaa\x4c\x27\x71\xdc\xed\x76\xbb\x2d\x5b\x36\x7a\x49\xe8\xc6\xf4\x2b\x87\x71\x14\x9f\x65\xcb\x8e\xe4\xc4\x08\xb7\x1c\x7a\x5e\x97\xd0\x6c\xe7\xb3\xe0\x0b\x78\xc5\x0a\x2f\x1f\x83\x4f\xb2\x20\x0e\xed\xed\x5e\x09\x8c\xa7\x7b\xc5\x1b\x5e\xcc\xf6\x56\xdd\xbb\x64\x04\xc1\x3a\x13\x83\x65\x2c\x1e\xdb\x5e\x03\xda\x26\x3d\x71\x7f\xa8\x4d\x85\xd6\x1b\x53\xeb\xee\xd3\x32\xd0\xc8\xf7\x97\xa4\xd5\xbc\x29\x8a\xb4\x49\x4a\xc0\x30\xfa\x79\x7d\xed\x29\x1b\x45\x99\x26\x3d\x1a\xd0\x1f\xa3\x2c\x49\x5f\x76\x6e\x54\x96\x29\x72\xeb\x97\xba\x41\x99\xf3\x2f\x69\x92\x7f\xfd\x25\x3a\xfc\x4a\x7e\xfe\x5b\x91\x37\xce\xbb\x5f\xd1\xa9\x40\xb3\xff\xf8\xf7\x77\xce\x5f\x8a\x7d\xd1\x14\xce\xbb\xff\x89\xd2\x6f\xa8\x49\x0e\xd1\xec\xcf\xe8\x09\xbd\x73\xfe\x58\x25\x51\xea\xbc\xfb\x73\xd1\x14\xb3\x5f\xa3\xbc\x7e\xe7\xd4\x51\x5e\xbb\x35\xaa\x92\xa3\xf3\xee\x8f\x98\xc0\xec\x4f\x24\x79\xc0\xbf\x66\xc5\x5f\x93\x77\x3d\x4e\xbd\xe0\xd7\x97\x6c\x5f\xa4\xef\x18\x36\xb1\x15\x5b\x87\x60\xd9\x6f\xaa\x2c\x4a\xa5\xb5\xa3\xa5\xa7\xcd\x71\xe2\x36\x61\x3c\x2e\xc5\xdf\xf4\x7d\x01\x6e\xf0\x15\x17\x9a\xfa\x02\x25\x38\x48\x51\x83\x5f\xf7\xf8\x55\x82\x67\x15\xc6\x10\x49\xcc\x4b\x72\xf2\x4a\x25\x2a\x94\x74\x64\x80\x14\xd1\x14\x12\xa4\x21\x9d\x6d\xb5\x35\x17\x30\xe7\xaf\xf0\xd2\x65\x26\xa1\xbc\x6d\xb7\x5d\xc5\x6c\x1e\x55\x55\xf1\x0c\x98\x8c\x6c\x25\xd4\xd2\xe6\x9b\xaa\xbf\x64\x75\xbe\x64\xab\x1b\x22\xa2\x6e\xdc\xe8\x08\xc5\x8b\x57\x0d\xf7\xb7\xf3\x0a\xaa\x4e\x32\xfb\xbe\xce\xf7\xb5\xcb\x68\xb8\x58\x08\xbf\xb5\x6e\x99\x46\x07\x94\xa1\xbc\xf9\xbf\x9f\x9b\xa2\xfc\xe2\x88\x20\x0d\xf6\x70\xf8\xa4\xbe\xa4\xc7\x5c\x86\x51\x30\xee\x55\x4c\x5c\x3a\xfd\x96\xf4\xb1\x98\xb8\x1c\x0c\x18\xfb\x94\x51\xc4\xfb\x62\xdd\x66\x42\x26\x5c\x33\xde\x1f\xf5\x53\x48\xc4\xd7\xb2\xf2\x41\x5e\x4d\xb2\x58\x48\x51\xbf\xd8\x38\x63\xca\x1b\x81\x06\x12\x4d\xc5\x4e\x64\x11\xe1\x30\xd7\x51\x60\xbe\xb3\x90\xcd\x44\x22\xa0\xd4\x44\x62\x9d\xdc\xfa\x44\xad\x06\xc9\x29\xf2\xa3\x6f\xeb\xd1\x12\xa4\x0a\x97\x45\xc8\x12\x02\x4e\x33\x2e\x86\x08\x12\x22\xad\xe2\x52\x64\x7b\x5a\x26\x20\x03\x85\x25\x21\xed\xa4\xa5\xe6\xd0\x63\xbb\x30\x44\x51\x29\x0e\xcb\x68\x49\x61\xed\xcb\x72\xc2\x25\x13\x2d\x8d\x20\x81\x64\x84\x2b\xb8\x84\xb8\xca\xaf\x34\x34\x91\x06\x28\x3a\x81\x56\x27\x38\xf9\xc4\xb7\x68\x65\xa0\xf0\x88\x9b\x27\x8a\x8e\xe3\x4e\xf2\x1c\x55\xc2\x2b\x9b\xbc\xcd\x1f\xc1\x34\x4a\xd6\x63\x65\x60\xe4\x65\x3a\x39\x57\x94\xe4\xd3\x83\xe9\xc0\xb6\x16\xf2\xad\x74\xd7\xa0\xe3\x17\xfb\x84\xff\xed\x21\xfc\x97\xf1\x10\xbe\xcb\x1a\x44\x67\x82\xd3\x9c\x0c\x5f\x1c\xd8\x42\xc2\x04\x3c\x97\x40\x68\x77\xbb\xe8\x48\xd6\x17\xd5\x62\xa3\x27\x22\x53\xbd\xc1\x2f\x61\x14\x6d\x7e\x09\x07\xc1\x7e\x89\xf2\x79\x28\xe4\x93\x96\x1d\xcd\x4f\xc2\xbc\x28\x60\xfb\x49\xf6\x4d\x48\x80\xe2\xce\xa5\x5c\xdb\x63\x51\x4b\xd3\xa1\x4e\x62\xe0\x35\x32\xef\x27\x2f\xc8\x5f\x91\xec\x24\x9c\xc4\x15\xd3\xab\x81\x29\x52\x7b\xe9\x97\x63\x26\x71\x45\xcf\x6b\x59\x59\x11\xbc\x28\x0e\x44\xbd\x28\x71\x43\xe7\x18\x25\x52\x44\x90\x1a\x49\xcd\x4f\xa2\x1f\xa5\xab\xf1\x51\xe8\x8f\x74\xd2\x9a\xaf\x12\x2c\x7a\x3f\x64\x24\x0f\xa0\xbe\x45\x5e\xec\xef\x3f\x41\xb2\x8a\x7c\x45\x2f\x6b\x9a\xde\x15\xfe\x74\xcd\xcb\xec\x51\xdd\xd3\xf4\xe9\x36\xcd\x5b\xf8\x1b\x61\x01\xa2\x17\xc8\xa1\x98\x17\x28\xec\x44\x1e\x63\x02\x0c\x13\x64\x03\xb4\xea\x27\xc1\x0d\x9c\x3c\x94\x65\xec\xa0\x76\x25\x2a\xb6\xe8\xc3\x9b\x69\xf2\x03\x5d\xc3\x69\xea\x55\x39\xd4\xf5\xab\x30\x48\x15\xcc\xd6\xe1\xae\xe3\x70\xbc\x82\x67\xfc\xd5\xc1\xef\x04\x31\x4b\xd0\x04\x6a\xf7\xb2\x42\xef\x83\xf9\x55\x27\x5f\x59\xc1\x7c\x40\xf5\x95\xa4\xaf\x3a\x1f\xd7\xf8\xbf\x81\x0e\xf6\xde\x39\x87\x21\xde\xb9\x74\x41\xc1\x18\xfb\x25\x78\x20\xeb\xc5\x15\x3f\x49\x0e\xfa\x5b\xcd\x60\x22\x0b\xa0\x82\x04\x56\x06\xc2\xc4\x90\x39\xf0\xba\x01\x09\x0e\xfc\x34\x03\x97\xb9\xd3\xcd\x5b\x62\x8e\x1a\x77\xd5\xdd\xf3\x73\x05\x77\xd4\xb8\x65\x5b\xbc\xd8\x12\x37\x77\xef\x6e\x61\xab\x95\xe1\x40\x38\x31\x2c\xb3\xe1\xa1\x3d\xfe\xef\x4d\x3e\x77\x28\x63\x0b\x4a\xd8\xd9\x0f\x47\x71\x47\x08\xb0\x57\x85\x9e\xa8\x27\xf9\xeb\x8b\xa7\x1a\xa5\xfa\xe7\x8c\xbe\x6e\x2e\x7d\xa7\x23\x1f\x4b\x9b\xe2\xe9\x70\xe6\x7b\xe9\xca\x28\x77\x5f\x1e\xf5\xa2\x1e\x03\x8b\xf0\x4c\xdb\x38\xa0\xbd\x20\x6a\x63\x6e\x17\x8a\x77\x9a\xa2\xa8\xda\xed\x8b\xe6\x2c\x7d\xfd\xed\x9b\xda\x93\x01\x90\x98\x83\xee\xb4\x23\x91\x8c\xc0\x8e\x72\xef\x09\x2e\x12\x37\x4d\x1d\xa3\x03\x72\xbf\x25\x75\xb2\x4f\xd2\xa4\x79\xe1\x1b\x58\x2c\x55\xf6\xbd\x26\x2b\x29\x07\xac\x61\x33\xc9\x15\x40\xce\x20\xad\xd1\xbb\x46\x64\xa1\xea\x5b\x45\xa4\x7a\x37\x47\x6d\xe3\x28\x65\x65\x85\xbe\x29\x65\x7c\xbb\xa4\xa4\xd6\x57\x56\xaa\x34\x27\xba\x70\x00\x3a\x74\x53\xa1\x5c\x8e\xf5\xf9\x70\xb1\xec\xa9\xf9\xdf\xe4\x53\x07\xb8\xa3\x86\x55\x19\xd8\xc0\x98\xa1\x9e\x41\x5c\x10\x96\x07\xd8\x70\x2d\x7c\xb8\x8c\x91\x0e\x2b\xdd\xb4\x23\xab\xa2\xdf\x8e\xd7\x2b\xc5\x2d\xab\xa2\x44\x55\xf3\xb2\x63\xb5\x8f\x23\x37\xec\xd8\x28\x11\x61\xdb\xa5\x01\xb5\xc2\xc2\x19\x50\x25\xd0\x4c\x4d\x0f\xe1\x8b\xfb\xfe\x94\x86\x63\xf4\x64\x81\xa4\x81\x0b\x27\xe4\x3d\x42\x02\xe5\x72\x9c\x79\x35\x1e\x41\xd3\x47\xcd\x1d\x39\xb5\x8c\x3d\x3c\x0b\x56\x45\xaa\x0e\x3f\x5e\x8c\x55\x61\x74\xc6\xba\x97\x60\x2f\xf2\xfb\x6d\x00\x9a\xb2\x65\x86\x4d\xc3\xe1\x07\xfb\x72\x5f\xbf\x3d\x02\xd2\x53\x97\x57\x7b\xba\xaa\xc6\x08\x71\xa4\x0e\xba\xbd\xd0\x60\x25\xd9\xfd\x6c\xa0\x60\x6c\x49\x2a\xb5\x64\xa5\xe0\x9a\x5b\x9f\x82\x54\xfc\xb8\x05\x77\x48\xb8\x62\x4a\xe7\x94\xbb\x8a\x86\x6a\x72\x2d\xab\x81\x55\x7a\x65\xab\xe5\x52\x9c\x40\xf8\x08\x4f\x9e\x7b\xa7\x6b\x97\x17\x6e\x85\x4a\x14\x91\x9c\x59\x9f\xc8\xb7\xe9\xee\x2e\x38\x13\xa9\x37\xb8\x1d\xf6\x98\xa4\xe9\xe7\x8f\x1f\x82\xc5\xf1\x78\xec\xee\x8a\xdd\xf4\x57\xc5\x6e\x94\x9b\x62\x37\xb3\x8d\x78\x4f\x6c\xfc\xf9\xe3\x2f\xe1\x3c\x08\x67\x5e\xea\x2e\x67\xf4\x3f\x7f\x1e\xba\xfe\x3c\xfc\x79\x89\xcb\x97\x69\x30\x0f\xdd\x60\x1e\xfe\x4c\xc1\x7e\x07\xae\x77\x35\x0b\xfe\xef\xb4\xc7\xc1\x7c\x4d\x7a\xec\xcf\x43\xdc\xdb\x9f\x17\xf8\xf7\x32\xc5\xdd\x9c\xe1\xae\x92\xfa\x4d\xba\x74\xc9\x7f\xd6\x2e\x27\x79\x9c\x1c\xa2\xa6\xa8\x6a\x60\xf2\x32\xdd\x4a\xd8\xcd\x62\xe1\x84\x69\x6c\xc4\xec\x04\xe7\xe5\xe2\xd7\xd9\x7d\x50\x6e\xaf\xfb\xa0\x5e\x40\x03\x76\x6b\x96\x26\x17\xb2\x7b\x3f\xf9\x1d\x7b\xec\x8c\x22\x59\x5a\xee\x76\x15\x79\xd2\xae\xa2\xee\x17\xdf\x58\xd1\x0f\xa2\x45\x7f\xa5\x5e\xa5\x16\x10\xae\xf0\x6f\x32\x5f\x60\x01\xe5\x0d\xd9\x14\x53\xb6\xfc\xde\x10\xe6\xed\x5f\xbd\x0a\x4e\xf7\x07\x79\xb6\x64\xbd\x3c\x6c\x82\x81\x06\xe6\xf5\xd5\xb5\xd3\xba\x2c\x6e\x9b\x03\xdb\x03\x72\x2f\x04\xf2\x3d\x0e\x51\x49\x32\xae\x99\x4c\x12\x2b\x9f\x67\x6b\xc6\xea\xe9\x2c\xa2\xff\xa8\xd4\x19\x13\x96\x59\x20\x7c\xf5\x72\xc5\x86\xb6\xb7\x20\x94\x49\xb3\x2e\x49\xc8\xe4\x52\x69\x5f\x9a\x02\x70\x40\xab\xa2\x89\x1a\xf4\xc3\x62\xe5\xc5\xe8\x24\x7a\x9f\x72\x85\x94\x45\xf3\xae\x78\xe7\x0a\x36\xdb\x1b\x42\x58\x1a\x21\xcf\xca\x6d\x1e\x44\x28\x54\x60\xfc\x4b\x0c\xbd\x4b\x84\xda\xd6\xe1\xa9\xc2\x76\x45\xbe\x7a\x41\x6b\x9d\xe6\x6c\xd2\xa1\x10\xf5\xf5\xd9\x06\x65\xc6\x71\x60\x6d\x4b\x91\x38\x02\x5a\x95\x85\x5b\x67\x17\xfd\x6b\x8f\xb8\xcd\x92\x2d\x86\x04\x28\xb3\xe9\xff\x54\x15\xcf\x17\xef\x83\x71\x8f\xbd\xa7\x6f\xb0\xf7\x1e\x5e\x43\xef\x83\x60\xee\x90\xfe\xef\x82\x77\x2e\x61\xbb\x97\xf6\xd5\x39\x0b\xd4\x7d\xaf\xdc\xde\xd3\x37\xab\x19\xf3\x37\x56\xc9\x46\x58\xb9\xbb\x66\x05\xbf\xce\xa9\xef\xcc\x2f\xa8\xb9\x18\x2e\xae\xf9\xa7\x24\x2b\x8b\xaa\x89\xf2\x86\xb7\x68\x8a\x52\x05\x6e\x8a\x52\x87\xcb\x92\x38\x4e\x35\xbc\xb4\x54\x87\x66\x0b\xfa\x2a\x17\xa4\x14\xe0\xa1\x57\xc5\xc5\xac\x25\x43\x3b\xa8\x03\xac\x5c\x6c\xb1\x3f\x75\xd9\x30\x4c\x49\x02\x05\xf0\x48\x80\xef\x93\xfe\x9f\xe4\xdb\x61\x1c\x76\xe6\x50\x03\xd5\xcb\xa9\xdf\x6d\xba\x42\x46\xe1\xb3\x4f\x39\x61\xba\xde\x45\xe5\x14\xb8\xe5\xe5\xa4\x5e\xf1\x22\x70\xa5\x82\x43\x35\x26\x8e\xe9\x5d\x30\x2a\xc7\x2c\xb3\x83\xe9\x86\x16\x8d\x5f\xf5\xa2\x96\x93\x7c\x4b\x8b\xc8\x91\x04\xaa\x97\x9b\xf8\xa4\x57\xb9\x28\x7c\x92\xf4\x09\xa6\x6b\x56\x54\x26\xe5\xdb\x56\x4e\xc2\x55\x2b\x02\x1b\x02\x90\x52\x68\x64\x8c\xdc\xc4\xa2\x30\xc6\x13\x14\x98\xee\x49\x51\x79\xd3\xae\x4b\x39\xc9\x77\xa5\x08\xcc\xc8\xa0\x7a\xb9\x89\x4f\x7a\x9b\x8a\xc2\x27\xcb\x02\x60\xba\xe9\x44\x65\x53\xbd\xf0\xe4\x24\xdd\x76\x22\x30\x23\x01\x6a\xc5\x26\x16\xe9\x85\x28\x0a\x8b\xf4\xa4\xbd\x29\x73\xac\xca\xa1\x72\x65\xc9\x49\xbc\xaf\x44\x60\x44\x04\x53\x4b\x8d\x02\x24\xb7\x99\x68\x02\xac\xbe\x5e\x4c\x77\x8d\xe8\xe2\x13\xaf\x1c\x39\x09\xf7\x8d\x48\x32\xea\x80\x94\x42\xa3\x05\x92\xeb\x48\x54\x0b\x3c\x27\x0d\x02\xed\xef\xa8\x40\x0a\x7e\xcf\xc5\x76\xbc\x5a\x6a\x46\x9d\x35\xd3\x4d\x95\x3a\xa8\x72\x4b\xf1\xa8\x26\x74\x95\x4b\xfc\x31\xaa\x19\x74\x27\xf1\xa8\x86\xe4\xab\x9f\x78\xd3\xf6\x98\x46\x1e\x17\x82\x07\x77\xba\x03\xa0\x09\xb9\x4c\xdd\xec\xc1\x58\x1c\x6b\xec\x58\x0f\xc9\x23\x5d\x43\x57\x7a\x40\x1a\xa2\x02\x60\xdd\x1b\x14\xc8\xa6\x0b\x80\x0b\x2f\x32\xa9\x81\xf6\x12\xeb\x1a\xf0\xf7\x88\x04\xae\xbd\x43\x38\x38\x9d\xce\xe5\x6c\x08\xea\x54\xce\x61\xbb\x19\x56\xb9\x75\x42\x99\x5d\x39\x38\x9f\xe8\xe4\xbb\x47\xd4\x49\xae\x13\x9f\x68\x79\xa6\xf9\xa6\x47\x5d\x7d\x55\x60\xb5\xe1\xdf\x71\x4d\x47\xa5\xc2\xb3\x24\x6b\x32\xfa\x50\x8c\x7d\x43\x75\xcb\x62\x85\x32\x00\xf2\x02\xed\x6c\x84\x30\x5a\x2e\x0a\x57\x1b\x0d\xe4\xa3\x86\xb0\x4b\xa3\x75\xb0\xdd\xf0\x05\xab\x10\x8d\xe1\x6b\xdc\x07\xe8\xd8\xfa\xdc\x93\x11\x27\x82\xb1\x92\x9a\x46\xe0\x74\x01\xb6\x02\x42\x90\x87\xa4\x3a\xa4\xe8\xa2\x85\x2c\x10\x2c\xb9\x79\x51\x85\x34\xe0\xf5\xd4\xab\xb7\x45\x20\xf2\x39\xf5\x98\xb4\x93\x3e\xb6\xc6\x6e\x5e\xe4\x72\xfe\x05\x11\x67\xec\xd2\x98\x4e\x09\xf1\x20\x10\x1a\xf6\x81\xb1\xa0\x0c\x2e\xc3\x01\x00\x8d\x74\x1c\x9c\xfc\x02\x00\x5c\x31\xf2\xec\x4a\x20\xc0\x03\x4a\x53\x05\x12\x17\xc9\xa0\xc7\x14\xb5\xd0\x49\x3e\xc1\x66\xc4\x45\x47\x50\x02\x1a\x0e\xa1\x1c\x46\x25\x00\x08\x18\xcd\x07\xd7\xf0\x04\x33\xa4\xb1\x3a\x1b\xa3\xb4\x0e\x6a\xb4\xde\xea\x6c\x58\x75\x75\x36\xac\x3d\x0e\x33\x46\x81\x1d\xec\x28\x1d\xd6\xd9\x6d\x6a\xec\x65\x72\x2f\x4d\x5a\xf2\x3c\xc7\x6e\x36\x38\xf8\xb2\x51\xe3\x2f\x9b\x3c\x04\xb3\x11\xa3\x30\x1b\x31\x10\xb3\x09\x63\x31\x9b\x34\x1c\xb3\x1b\x47\x64\x16\x7f\x07\x55\xf2\x73\x8f\xf8\xd5\x30\xa4\xca\xf4\x34\x46\x95\x1d\xd4\x68\x55\xa6\xa7\x61\x55\xa6\xa7\x61\x55\x72\x98\x31\xaa\xec\x60\x47\xa9\x32\x3d\xdd\xa6\xca\x5e\x26\x6f\xa8\xca\xee\x10\x6a\xec\xb6\xe9\x90\x2e\xdb\x74\x8c\x2e\x3b\xa8\xd1\xba\x6c\xd3\x61\x5d\xb6\xe9\xb0\x2e\x39\xcc\x18\x5d\x76\xb0\xa3\x74\xd9\xa6\xb7\xe9\xb2\x97\xc9\xbd\x75\x59\x56\x49\xde\x60\xf5\x91\x87\x21\x0d\x52\xa0\x11\x4a\x14\x01\x47\xeb\x91\x36\x1a\x54\x25\x05\x1b\xd4\xa6\x00\x36\x46\xa1\x22\xf8\x28\x9d\xd2\x06\x37\xa9\x55\x92\xd2\xdd\x34\x3b\x47\xd9\x1e\x87\x28\xa8\x2e\x8b\xbc\x4e\xbe\xa1\xc1\x9b\x81\xc4\xf4\x24\x5d\xf2\x39\x6d\x6f\xa2\x8a\xd6\x90\xab\x46\x74\x93\xd5\x26\x33\xad\x84\x6c\x98\x71\x74\x40\x52\x00\x94\x27\xe4\xb3\x0c\x50\x51\xec\xff\x8a\x0e\x0d\x50\xf1\x2d\x89\x51\x31\xbc\xff\x47\x3a\xb3\xaa\x25\xed\xe2\xcb\x1e\x7a\x97\xdc\xc0\xdf\xbf\x6c\xfb\x4d\xdf\xc2\xb7\xcd\x65\x30\xdf\x84\x6b\x7f\xb9\xf8\x00\x34\xf3\x57\xa6\x66\xe1\x6a\x1e\x84\x50\x93\xe5\xfe\x65\x01\xb6\x58\x83\xe0\xfe\xfe\xc5\x07\xc1\xe9\x6e\x12\xf2\xe9\x1f\x0f\x0c\x38\x03\xbd\x60\x6f\xb6\x4a\x86\x87\xe6\xb9\x31\xe7\x14\x32\x63\x53\xeb\x7b\xc6\xdc\x0a\x7d\x43\x55\x8d\x0c\x0c\xf2\x6a\x2b\xa3\x3a\x90\xc4\xb0\x8d\x84\x0c\x31\xd4\x01\x23\xa1\xe7\x2a\x2a\x2f\x7a\x26\x13\x15\x1f\x50\xc1\x10\xd0\xbb\x02\x15\x14\xb4\x10\x44\xa2\x56\x09\x7c\xe8\xdd\xed\xc8\x9a\xfa\x69\x01\x60\x88\x8f\x38\x10\xd7\xd2\xc0\x28\x78\x80\x72\xd6\x9c\x7c\x1a\xf4\x7a\x8e\xe8\x40\xfd\x86\xc4\x20\xfd\xb1\x83\x94\x43\xf7\x1e\x81\x0f\x20\xf0\x41\x04\xbe\x86\x80\x66\x11\x13\x79\xe8\xf3\x8c\xa9\x28\x78\xc6\x31\x13\x12\x1f\x40\xe2\x1b\x90\x48\x9c\x28\xfb\x6e\x68\x9a\xa2\x8b\xbc\x45\x87\x94\x09\xc8\xc0\x14\x36\x0a\x90\x8e\x19\xe5\xf1\x45\x4b\x87\x33\x84\x55\x02\xd1\x71\xd2\x6d\x19\x17\x68\x47\x91\x05\xb3\x0a\xa0\xe3\x65\xa9\x97\x2e\x60\xba\x26\x0b\x66\x29\x71\x93\x8d\x40\x44\x96\x88\x14\xfc\x71\x52\x37\x55\xb2\x7f\x6a\xd0\x20\x09\xda\x5e\xff\xb8\x4b\xb6\x81\xaa\x4a\x14\x8e\x0b\x0b\x88\xe1\x0c\x55\x26\x94\x92\xf6\x28\x42\x59\x7d\x1a\x3a\x64\xe6\x4f\x55\x9b\xb8\xc7\xc6\x80\x52\xd7\x99\x88\xb0\xfb\x82\xaf\xa0\xd4\xbf\xe0\x4b\x48\xcd\x1f\xf8\xb9\x1c\x2b\xd4\x1c\xce\xba\x24\x49\xb1\x01\xa9\x56\xcb\x71\x1a\x86\x18\xf1\xa5\xc0\x71\x26\x35\xb3\xea\x08\x1c\x63\x3d\x62\x48\x53\xb6\x61\x26\x23\x55\xb5\xd5\xe3\x35\x68\xcc\x3c\xce\x64\xc4\xda\x28\xeb\x31\xeb\x43\x4d\x46\x6d\x1c\x68\x32\x05\x75\x98\xf5\x04\xc0\xb1\x06\xd1\x30\x8d\xb4\x5e\x9b\x8a\x8d\x88\xfa\x84\xed\xa4\x23\x60\xb2\x94\x1a\xa5\x47\x72\xb2\xab\xc7\x4a\xb3\x5e\x13\xf3\x53\x5e\x73\x7d\x93\x9d\xfa\xa2\x13\xb0\x29\x46\x27\xa0\x83\xad\x8e\xe0\xb3\x99\x1c\xc1\x2a\xd9\x9b\x80\x13\x32\xb8\x1e\x23\x68\x6d\x04\x9f\x6a\x6a\x02\x4a\x83\xad\x11\xac\x26\x43\x23\x38\xf5\xb9\x41\xc0\x6a\x9c\x20\x08\x5e\xf3\xfc\xc0\x64\xaa\xa8\x5e\x92\x2a\xac\x7b\x82\x57\x57\xbc\x79\x09\x99\xea\x20\xbb\x9b\xc7\x5c\x67\xf7\x77\x9a\x29\x7b\x6f\xea\x37\x77\x6c\x7f\x07\xd7\xb9\xce\x6e\xf7\x9e\xc9\xba\xff\x5d\x1c\x68\xc6\xcd\x9b\xf8\xd0\x75\x76\xab\x1b\x5d\x67\xb7\x7b\xd2\x1c\xc7\x4d\xce\x74\x76\x27\x7f\x3a\xbb\xbf\x4b\x9d\xbd\xa1\x57\x5d\x67\x6f\xe2\x58\xe3\xe1\xf6\x46\xbe\x75\x9d\xbd\xbd\x7b\x5d\x67\x6f\xed\x61\x67\x6f\xe1\x64\xab\xca\xbc\xd5\xcf\x06\xb4\x78\xb3\xab\x8d\xd5\xf7\x46\xde\x76\xf6\x56\x0e\x77\xf6\x96\x3e\xb7\xaa\xb4\x7b\xb9\xdd\x80\xf2\xee\xe6\x79\x43\x63\xf0\xee\xce\x37\x30\x08\xdf\xc2\xff\xce\xde\xce\x05\xc7\x3d\xb8\xaf\x17\x9e\xbd\x91\x23\xae\x1a\xe1\x1d\x7c\x71\xc0\xfe\xee\xe1\x8e\x83\xf3\xc7\xdd\x3c\xf2\xec\x9e\x4e\xb9\x65\x37\x00\xc1\x9c\xc5\x77\xf3\xca\xb3\xf8\xfe\x5e\x39\x65\xef\x4d\xbd\xf2\x8e\xed\xef\xe0\x95\x67\xf1\xed\x5e\x39\xd9\xc2\x71\x17\xaf\x9c\x71\xf3\x26\x5e\x79\x16\xdf\xea\x95\x67\xf1\xed\x5e\x39\xc7\x71\x8b\x57\x9e\xc5\xf7\xf1\xca\x7b\x3c\x77\xf3\xca\x31\xca\x37\xf3\xca\xb3\xf8\x4d\xbc\x72\x3c\xdc\xde\xc8\x2b\xcf\xe2\xb7\xf7\xca\xb3\xf8\x8d\xbd\x72\x5d\xa7\xf7\xf0\xca\x55\x65\xde\xea\x95\x03\x5a\xbc\xd9\x2b\xc7\xea\x7b\x1b\xaf\x9c\xc8\xf4\x2d\xbc\x72\x5d\x59\xf7\xf4\xca\x55\xa5\xdd\xcb\x2b\x07\x94\x77\x37\xaf\x1c\x1a\x83\x77\xf7\xca\x81\x41\xf8\x06\x5e\x39\x64\x35\xf7\xf2\xca\x71\x0f\xee\xea\x95\xeb\x96\x78\x27\xaf\x5c\x35\xc2\x3b\x78\xe5\x80\xfd\xdd\xc3\x2b\x07\xe7\x8f\x7b\x79\xe5\x90\x31\xdc\xd5\x2b\xe7\x1b\x3b\xa9\x99\x9d\xee\xe6\x95\xa7\xa7\xfb\x7b\xe5\x94\xbd\x37\xf5\xca\x3b\xb6\xbf\x83\x57\x9e\x9e\x6e\xf7\xca\xc9\x6e\xdc\xbb\x78\xe5\x8c\x9b\x37\xf1\xca\xd3\xd3\xad\x5e\x79\x7a\xba\xdd\x2b\xe7\x38\x6e\xf1\xca\xd3\xd3\x7d\xbc\xf2\x1e\xcf\xdd\xbc\x72\x8c\xf2\xcd\xbc\xf2\xf4\xf4\x26\x5e\x79\x7a\x7a\x33\xaf\x3c\x3d\xbd\xbd\x57\x9e\x9e\xde\xd8\x2b\xd7\x75\x7a\x0f\xaf\x5c\x55\xe6\xad\x5e\x39\xa0\xc5\x9b\xbd\x72\xac\xbe\xb7\xf1\xca\x89\x4c\xdf\xc2\x2b\xd7\x95\x75\x4f\xaf\x5c\x55\xda\xbd\xbc\x72\x40\x79\x77\xf3\xca\xa1\x31\x78\x77\xaf\x1c\x18\x84\x6f\xe0\x95\x43\x56\x73\x2f\xaf\x1c\xf7\xe0\xae\x5e\xb9\x6e\x89\x77\xf2\xca\x55\x23\xbc\x83\x57\x0e\xd8\xdf\x3d\xbc\x72\x70\xfe\xb8\x97\x57\x0e\x19\xc3\x5d\xbd\xf2\xee\x8c\x0e\x41\xdd\xa6\x77\x73\xcb\xdb\xf4\xfe\x6e\x39\x65\xef\x4d\xdd\xf2\x8e\xed\xef\xe0\x96\xb7\xe9\xed\x6e\x39\x39\x58\x75\x17\xb7\x9c\x71\xf3\x26\x6e\x79\x9b\xde\xea\x96\xb7\xe9\xed\x6e\x39\xc7\x71\x8b\x5b\xde\xa6\xf7\x71\xcb\x7b\x3c\x77\x73\xcb\xdb\xf4\x0d\xdd\xf2\x36\x7d\x13\xb7\x1c\x0f\xb7\x37\x72\xcb\xdb\xf4\xed\xdd\xf2\x36\x7d\x63\xb7\x5c\xd7\xe9\x3d\xdc\x72\x55\x99\xb7\xba\xe5\x80\x16\x6f\x76\xcb\xb1\xfa\xde\xc6\x2d\x27\x32\x7d\x0b\xb7\x5c\x57\xd6\x3d\xdd\x72\x55\x69\xf7\x72\xcb\x01\xe5\xdd\xcd\x2d\x87\xc6\xe0\xdd\xdd\x72\x60\x10\xbe\x81\x5b\x0e\x59\xcd\xbd\xdc\x72\xdc\x83\xbb\xba\xe5\xba\x25\xde\xc9\x2d\x57\x8d\xf0\x0e\x6e\x39\x60\x7f\xf7\x70\xcb\xc1\xf9\xe3\x5e\x6e\x39\x64\x0c\x37\xb8\xe5\x73\x72\xe3\x06\x4d\xb6\xd3\x5f\xbe\x21\xfb\x0c\x18\x80\x26\x16\xa2\x10\xe4\x59\x07\x21\x67\xb4\x29\x84\x72\x42\xdb\xb6\x7b\x1d\xb7\xac\xb3\x61\x06\xea\x6c\x0c\x0f\x3c\x9d\x0a\xc8\x86\x75\xbf\x0e\x6e\x9d\xc5\xc3\x7c\x64\xf1\x18\x3e\x78\x2e\x90\xb1\x7c\xf4\x5f\x28\x88\x36\x4e\xc3\x7c\xa4\xa7\x31\x7c\xf0\x44\x16\x63\xf9\x10\x62\x32\xdc\xbc\x4d\x87\x19\xc1\x91\xd1\x30\x23\x3c\x0b\x03\xcc\xc8\x9c\x1f\xea\xa6\x93\x51\x77\xc4\x5b\x9d\x67\x3a\x30\x7a\xf6\xfb\xa2\x9c\x05\x17\x41\xf9\xc1\x6a\x3c\xa7\x34\xc9\xa1\x3f\x68\x4d\x7f\x83\xa0\xfc\x18\xba\x7e\x30\x1d\x04\xe7\x47\xb6\xf5\x43\xdc\x20\xf8\x31\x69\x51\xdc\xc3\x92\x9f\x06\x8e\x93\xc3\xd7\x97\x1e\x92\xa7\xc3\xa5\xe5\xc2\xd0\x16\xfa\x24\xd7\xbc\xce\x09\x76\x7a\xc5\xb4\x44\x90\x1d\x2f\xe7\x79\xda\xb5\x9b\x55\x17\x1e\x6f\xcb\x2f\x11\x96\x9b\x0f\x26\x78\xc7\x18\xfe\xb9\x7e\x2a\x31\x27\xf5\xec\x87\x1f\x0c\xbd\x78\x98\x15\xd5\xec\x07\xa5\x03\x0f\x0f\x97\x39\x7d\x92\x39\x97\x9b\xaa\xdd\x66\x5d\xea\x39\x08\xbc\xd7\xd7\x79\x5d\xb9\x45\x9e\xbe\x00\x07\xec\x99\xa5\xf7\x39\xda\x7d\xe1\x82\x5b\x9e\x43\x7e\xe7\xe2\x52\xc5\xbe\x1e\x49\x96\x75\x1c\x62\xb3\xeb\xb6\xbc\x07\xe5\xa6\x53\x1c\x8a\x0a\x87\xf2\x19\x13\x2e\xc9\x24\x19\xed\x53\xb4\xa3\x29\xcc\x1d\xa0\x86\x3c\xa9\x66\xca\x98\x25\xc9\xe5\x19\xb7\xe4\xb9\x63\x8c\xdc\x6d\x94\x22\xca\x19\xcd\x41\xaf\x5d\xbd\xfa\x3a\xa7\x57\xbd\xd2\x6c\x76\x2d\xbf\xf8\xd5\x9b\xcd\x7d\x76\x9d\x2f\xfd\x23\xde\x23\xe6\xad\xc3\x07\xd1\x9e\x68\x1b\xa5\x39\x69\xe5\xab\x4d\x7d\xa8\x25\xcd\xb6\x26\x34\x26\xcd\x16\x5a\x5b\x90\x2c\x9d\x3a\x84\xe6\x6a\x06\x90\x67\x37\x08\x59\x12\xe7\x20\xfc\x20\xd7\x84\x1e\xab\x51\x12\xb6\x3d\xbb\x6b\xde\x66\xad\xb6\xf1\x3d\xde\xc8\xf7\xd4\x56\x64\x86\xea\xd5\x22\x56\x9e\x31\x1b\x3c\x3b\xb6\x8c\xf3\x8c\xf9\x60\x55\x0a\x23\x67\xcc\x08\xab\x5a\xab\xad\x30\x27\x42\xc2\x07\xb9\x92\xb0\x22\x98\x85\x58\x9b\xd1\x5e\xf4\x17\x23\xab\xcd\xb3\x73\x07\x60\x20\x80\x5f\x0b\xdf\x18\x9a\xfe\x0d\xe1\x79\xdf\x9e\x35\xa8\x73\x07\xd5\xe3\xfa\x26\xf9\x9c\xdf\x14\xa9\xca\x48\xbe\xa9\x3d\x95\x1b\x33\xc7\x05\xc5\xd8\xef\xfd\xca\x33\xe2\x99\x72\x67\x0c\xce\x51\x8f\xd2\x25\x6a\x35\x1d\x37\xe2\x4d\x86\x6a\x02\x56\xc1\x44\xbd\x87\xd7\x79\xe6\x7a\x17\x7e\xd3\xaf\x24\x8a\xc6\xf5\x9c\x79\xf6\xd2\x55\xeb\xe9\x46\xb3\x8a\x80\xb4\x3d\x08\x90\x6a\x34\xdb\xab\x78\xa0\x2c\xa3\x59\xaa\xa2\xd2\x53\x8c\x66\xae\xcf\x39\xd5\x13\x21\x66\x8d\xeb\x13\x32\xbe\x74\x49\xa8\x0e\x57\x11\xb8\xb6\x87\x63\xd7\x31\xea\x90\x7b\x15\x23\xbf\x41\x58\x07\x4d\x55\xa4\xf4\x96\x5a\x1d\xd0\x0d\xba\x2e\x00\x3d\x08\x08\xbd\x40\xbb\xe6\x54\xe9\x40\x40\x68\x05\xc0\x7d\x92\x0a\xff\x0a\x3e\xf1\x06\x64\x85\x7d\x05\x65\x7f\xc9\xae\xcc\xfd\x82\x73\xef\xeb\xcc\x2f\x08\xb1\x85\xc8\xbc\xaf\xf3\xbe\x20\x84\x16\x32\xef\xbe\xce\xba\x82\x8d\x27\xdd\xd5\x39\x57\x10\xd2\x34\xbb\x1a\xe3\xcb\x8e\x71\x48\xee\x4b\x42\x6c\x29\xb1\x0e\x09\x7e\x49\x68\x2d\x15\xe6\x21\xc9\x2b\x18\x39\xfb\x90\xe8\x15\xa4\xb4\x03\x80\xec\x43\xde\x85\x85\xde\x81\x90\x90\x0b\xc5\x0e\x2c\x74\xf6\x43\x42\x29\x94\xd9\x5f\xe8\xcc\x2b\xd8\x18\xf3\x0b\x9d\x75\x05\x21\xbd\x03\x46\x01\x2b\x5d\xaf\xbb\x30\x52\x1a\xce\x25\x99\x60\xca\x97\xbe\x5e\x9f\x61\x4a\x32\xc3\x94\xad\x00\x03\x4c\x31\xe5\x5e\xc3\x04\xcd\x31\x65\xaa\x21\xd3\x27\x99\xd2\xf5\xfb\xfb\x2d\xb5\xf1\x5b\x92\x59\xa6\x7c\xe9\x81\x0c\xd3\x4c\x49\xa6\x99\xb2\x15\x00\x4d\xf3\x4c\xb9\xd7\x70\x1a\x27\x9a\x32\xd5\xd0\x1a\x66\x9a\xd2\x0d\xe4\x7b\x3a\x95\x6e\x04\x84\x64\x20\x77\x03\xe8\x45\x40\xc8\x05\x6a\x2f\x80\x4e\xa8\x18\x4d\xb3\x4d\x99\x6a\x48\xe1\xe9\xa6\x74\x17\x5d\x17\x7c\xbd\x07\x0b\x42\x6f\x21\x67\x5d\xd2\x3b\xb0\x20\xb4\x16\x4a\x07\x7c\x9d\x7f\x15\x9f\x61\xca\x29\x53\x0d\x25\x38\xe7\x94\xee\xb2\xe7\x1e\xd2\xc0\x92\xd0\x5b\xca\xfc\x43\x2a\x58\x12\x72\x4b\xb5\x07\x90\x0e\x54\x9c\xc6\x79\xa7\x4c\x35\xb4\x86\x89\xa7\x74\xc3\xae\x1f\x0b\xbd\x17\x21\xa1\x18\x4a\xbd\x58\xe8\x7d\x08\x09\xb1\x50\xe9\xc3\x42\xef\x81\x8a\xcf\x30\xf9\x94\xa9\x86\x12\x9c\x7d\x32\x37\xef\x9c\x06\x17\xf4\x1a\x72\xfa\x92\xcf\x25\xbf\xc1\x05\x1d\x87\x9c\xbe\xe4\x73\xc5\x75\x70\x41\xdf\x41\xc3\xcb\x7a\xe2\x82\xee\x83\x86\x9a\xdd\x4b\x0d\x78\x10\x79\xd0\x77\x08\xea\x0f\x7d\xe9\xe7\x81\xdc\x1f\xa8\x3b\xf4\xa5\x9f\x07\x6a\x77\xa0\xde\xa8\x58\xbb\xde\x40\x9d\x51\x11\x0b\x97\x6c\x2b\x7d\xe9\x1c\x0a\x17\xf0\x28\x72\xea\x04\xe4\x92\x4f\xe1\x02\x4e\x45\x4e\x9d\x80\x5c\x71\x2b\x5c\xc0\xaf\xd0\x70\xf2\x8e\x00\xae\x85\x86\x96\xf6\x43\xf7\x2e\xf2\x65\xdf\x0d\x50\x27\xd4\x1d\xc8\x97\x72\x47\x40\xa5\x50\x77\x20\x5f\xaa\x5d\x01\xb5\xa2\xe2\xed\x3a\x03\xaa\x45\x45\xcd\xba\x03\xe9\xa5\x73\x36\x5c\xc0\xdb\xc8\xa9\x83\x90\x4b\xfe\x86\x0b\x38\x1c\x39\x75\x10\x72\xc5\xe5\x70\x01\x9f\x43\xc3\xc9\xbb\x02\xb8\x1d\x1a\x5a\xda\x11\x7d\xec\x93\x98\x92\x75\x44\x8b\x29\x1b\x52\x4d\xa8\x0a\x70\xa4\x2f\x1a\x6c\xc5\x61\x5b\x09\xb6\x82\xa3\xd5\x3d\x8c\x99\xf5\x48\x03\x4f\x61\xe4\xa4\x53\x0a\xb0\x79\xf1\x39\x73\x6b\x4b\x24\x87\xeb\x08\x3f\x02\x10\x18\xcf\x31\xc0\x56\x02\x84\xa3\x3a\x10\xa7\x21\xb6\x03\xd1\x42\x11\x5e\x3d\x14\xe4\x61\x00\x4e\x75\x38\xd4\x63\xd0\xad\x04\x6d\x09\xf8\x40\xec\xb6\xb0\x0f\x24\x60\x0c\xfe\xea\x81\xf8\x0f\xd7\x73\xf2\x83\x51\x20\x03\x6e\x25\x60\x73\x2c\x08\xe2\xb6\x44\x84\x20\x7a\x53\x5c\x58\xdb\x43\x43\x5c\xcd\x69\x0f\x05\x88\x0c\xb6\x95\x60\x8d\x61\x22\x88\xd9\x1c\x2c\x82\xc8\x0d\x21\x63\x3d\x14\x35\x62\x00\x4e\x7b\x38\x76\x64\xd0\xad\x04\x6d\x89\x20\x41\xec\xb6\x38\x12\x24\x60\x8c\x26\x6b\x7b\x40\x89\xab\x39\xf5\xa1\xb0\x92\xc1\xb6\x12\xac\x31\xb8\x04\x31\x9b\x43\x4c\x10\xb9\x21\xd0\x24\x93\x8b\x29\xd6\xa4\x53\x50\xf9\x22\x41\x81\x11\x27\x83\x6c\x65\x48\x38\xee\x84\xb1\x1a\xa2\x4f\x18\x31\x14\x83\x92\xd9\xc4\x1a\x86\xd2\x89\xa7\x7c\x91\x40\xcd\xc1\x28\x03\x6f\x65\x70\x4b\x48\x0a\xe3\xb7\x05\xa6\x30\x09\x63\x78\x4a\xa6\x15\x5b\x84\x4a\x27\xa0\xf2\x45\x82\x34\xc6\xa9\x0c\xba\x95\xa1\xcd\xd1\x2a\x8c\xdd\x12\xb3\xc2\x04\x4c\x91\x2b\x99\x5f\x2c\xc1\x2b\x9d\x88\xca\x17\x09\xd0\x14\xc2\x32\xe0\x56\x06\x36\x06\xb2\x30\x6e\x73\x38\x0b\xa3\x37\x04\xb5\x64\x72\xb1\xc6\xb5\x74\x1e\x2a\x5f\x24\x50\x73\x74\xcb\xc0\x5b\x19\xdc\x12\xe3\xc2\xf8\x6d\x91\x2e\x4c\xc2\x18\xef\x92\x99\xc6\x12\xf2\xd2\x29\xa9\x7c\x91\x00\x4d\x81\x2f\x03\x6e\x65\x60\x63\xf8\x0b\xe3\x36\x07\xc1\x30\x7a\x43\x28\x5c\x0f\x47\xc3\x04\x84\x4f\xcf\x63\x62\x62\xde\xa0\x95\x1b\xd8\x22\x63\x03\x0d\x6b\x7c\x6c\x20\x63\x8e\x92\xeb\xc1\x40\x99\x40\x74\x6c\x0c\x87\xcb\x1c\xbe\x95\xe1\x2d\x41\xb3\x81\x82\x2d\x74\x36\x10\x31\x06\xd0\xf5\x50\x0c\x4d\x00\x3a\x1e\x06\x23\x69\x0e\xde\xca\xe0\xe6\x78\xda\x80\xdf\x12\x55\x1b\x48\x98\x62\xeb\x7a\x38\xbc\x26\x20\x1d\x0f\x23\x82\x6c\xde\xa0\x95\x1b\xd8\x42\x6d\x03\x0d\x6b\xc0\x6d\x20\x63\x0e\xbb\xeb\xa1\xc8\x9b\x00\x74\x5c\x0c\xc6\xdf\x1c\xbc\x95\xc1\xcd\x51\xb8\x01\xbf\x25\x16\x37\x90\x30\x45\xe4\xf5\x60\x50\xce\x20\x38\x13\x23\x42\xf3\xbe\x45\xab\xb6\x30\x06\xe8\x16\x2a\xe6\x30\xdd\x42\x08\x0a\xd6\x2d\x5b\xb4\x32\x37\x8b\xcd\xd1\x3a\xae\x23\x9c\x09\x40\x60\xb4\xce\x00\x5b\x09\x10\x8e\xd6\x41\x9c\x86\x68\x1d\x44\x0b\x45\xeb\x59\x3c\x10\xad\x63\x00\x4e\x75\x38\x5a\x67\xd0\xad\x04\x6d\x89\xd6\x41\xec\xb6\x68\x1d\x24\x60\x8c\xd6\xb3\xd8\x1e\xad\xe3\x7a\x4e\x7e\x30\x5a\x67\xc0\xad\x04\x6c\x8e\xd6\x41\xdc\x96\x68\x1d\x44\x6f\x8a\xd6\xb3\xd8\x1a\xad\xe3\x6a\x4e\x7b\x28\x5a\x67\xb0\xad\x04\x6b\x8c\xd6\x41\xcc\xe6\x68\x1d\x44\x6e\x88\xd6\xb3\x78\x20\x5a\xc7\x00\x9c\xf6\x70\xb4\xce\xa0\x5b\x09\xda\x12\xad\x83\xd8\x6d\xd1\x3a\x48\xc0\x18\xad\x67\xb1\x35\x5a\xc7\xd5\x9c\xfa\x50\xb4\xce\x60\x5b\x09\xd6\x18\xad\x83\x98\xcd\xd1\x3a\x88\xdc\x10\xad\x93\xc9\xc5\x14\xad\xd3\x29\xa8\x7c\x91\xa0\xc0\x68\x9d\x41\xb6\x32\x24\x1c\xad\xc3\x58\x0d\xd1\x3a\x8c\x18\x8a\xd6\xc9\x6c\x62\x8d\xd6\xe9\xc4\x53\xbe\x48\xa0\xe6\x68\x9d\x81\xb7\x32\xb8\x25\x5a\x87\xf1\xdb\xa2\x75\x98\x84\x31\x5a\x27\xd3\x8a\x2d\x5a\xa7\x13\x50\xf9\x22\x41\x1a\xa3\x75\x06\xdd\xca\xd0\xe6\x68\x1d\xc6\x6e\x89\xd6\x61\x02\xa6\x68\x9d\xcc\x2f\x96\x68\x9d\x4e\x44\xe5\x8b\x04\x68\x8a\xd6\x19\x70\x2b\x03\x1b\xa3\x75\x18\xb7\x39\x5a\x87\xd1\x1b\xa2\x75\x32\xb9\x58\xa3\x75\x3a\x0f\x95\x2f\x12\xa8\x39\x5a\x67\xe0\xad\x0c\x6e\x89\xd6\x61\xfc\xb6\x68\x1d\x26\x61\x8c\xd6\xc9\x4c\x63\x89\xd6\xe9\x94\x54\xbe\x48\x80\xa6\x68\x9d\x01\xb7\x32\xb0\x31\x5a\x87\x71\x9b\xa3\x75\x18\xbd\x21\x5a\xcf\xe2\xc1\x68\x9d\x80\xf0\xe9\x79\x4c\xb4\xce\x1b\xb4\x72\x03\x5b\xb4\x6e\xa0\x61\x8d\xd6\x0d\x64\xcc\xd1\x3a\x06\xb3\x47\xeb\x04\xa2\x63\x63\x38\x5a\xe7\xf0\xad\x0c\x6f\x89\xd6\x0d\x14\x6c\xd1\xba\x81\x88\x31\x5a\xc7\x50\xd6\x68\x9d\x00\x74\x3c\x0c\x46\xeb\x1c\xbc\x95\xc1\xcd\xd1\xba\x01\xbf\x25\x5a\x37\x90\x30\x45\xeb\x18\x68\x20\x5a\x27\x20\x1d\x0f\x23\xa2\x75\xde\xa0\x95\x1b\xd8\xa2\x75\x03\x0d\x6b\xb4\x6e\x20\x63\x8e\xd6\x31\x98\x35\x5a\x27\x00\x1d\x17\x83\xd1\x3a\x07\x6f\x65\x70\x73\xb4\x6e\xc0\x6f\x89\xd6\x0d\x24\x4c\xd1\x3a\xcf\x3e\x67\x8e\xd6\x19\x04\x67\x62\x44\xb4\xde\xb7\x68\xd5\x16\xc6\x68\xdd\x42\xc5\x1c\xad\x5b\x08\x8d\x8c\xd6\xf9\x41\xa6\xcc\x4d\x4f\xe6\x68\x1d\xd7\x11\xce\x04\x20\x30\x5a\x67\x80\xad\x04\x08\x47\xeb\x20\x4e\x43\xb4\x0e\xa2\x85\xa2\xf5\xf4\x34\x10\xad\x63\x00\x4e\x75\x38\x5a\x67\xd0\xad\x04\x6d\x89\xd6\x41\xec\xb6\x68\x1d\x24\x60\x8c\xd6\xd3\x93\x3d\x5a\xc7\xf5\x9c\xfc\x60\xb4\xce\x80\x5b\x09\xd8\x1c\xad\x83\xb8\x2d\xd1\x3a\x88\xde\x14\xad\xa7\x27\x6b\xb4\x8e\xab\x39\xed\xa1\x68\x9d\xc1\xb6\x12\xac\x31\x5a\x07\x31\x9b\xa3\x75\x10\xb9\x21\x5a\x4f\x4f\x03\xd1\x3a\x06\xe0\xb4\x87\xa3\x75\x06\xdd\x4a\xd0\x96\x68\x1d\xc4\x6e\x8b\xd6\x41\x02\xc6\x68\x3d\x3d\x59\xa3\x75\x5c\xcd\xa9\x0f\x45\xeb\x0c\xb6\x95\x60\x8d\xd1\x3a\x88\xd9\x1c\xad\x83\xc8\x0d\xd1\x3a\x99\x5c\x4c\xd1\x3a\x9d\x82\xca\x17\x09\x0a\x8c\xd6\x19\x64\x2b\x43\xc2\xd1\x3a\x8c\xd5\x10\xad\xc3\x88\xa1\x68\x9d\xcc\x26\xd6\x68\x9d\x4e\x3c\xe5\x8b\x04\x6a\x8e\xd6\x19\x78\x2b\x83\x5b\xa2\x75\x18\xbf\x2d\x5a\x87\x49\x18\xa3\x75\x32\xad\xd8\xa2\x75\x3a\x01\x95\x2f\x12\xa4\x31\x5a\x67\xd0\xad\x0c\x6d\x8e\xd6\x61\xec\x96\x68\x1d\x26\x60\x8a\xd6\xc9\xfc\x62\x89\xd6\xe9\x44\x54\xbe\x48\x80\xa6\x68\x9d\x01\xb7\x32\xb0\x31\x5a\x87\x71\x9b\xa3\x75\x18\xbd\x21\x5a\x27\x93\x8b\x35\x5a\xa7\xf3\x50\xf9\x22\x81\x9a\xa3\x75\x06\xde\xca\xe0\x96\x68\x1d\xc6\x6f\x8b\xd6\x61\x12\xc6\x68\x9d\xcc\x34\x96\x68\x9d\x4e\x49\xe5\x8b\x04\x68\x8a\xd6\x19\x70\x2b\x03\x1b\xa3\x75\x18\xb7\x39\x5a\x87\xd1\x1b\xa2\xf5\xf4\x34\x18\xad\x13\x10\x3e\x3d\x8f\x89\xd6\x79\x83\x56\x6e\x60\x8b\xd6\x0d\x34\xac\xd1\xba\x81\x8c\x39\x5a\xc7\x60\xf6\x68\x9d\x40\x74\x6c\x0c\x47\xeb\x1c\xbe\x95\xe1\x2d\xd1\xba\x81\x82\x2d\x5a\x37\x10\x31\x46\xeb\x18\xca\x1a\xad\x13\x80\x8e\x87\xc1\x68\x9d\x83\xb7\x32\xb8\x39\x5a\x37\xe0\xb7\x44\xeb\x06\x12\xa6\x68\x1d\x03\x0d\x44\xeb\x04\xa4\xe3\x61\x44\xb4\xce\x1b\xb4\x72\x03\x5b\xb4\x6e\xa0\x61\x8d\xd6\x0d\x64\xcc\xd1\x3a\x06\xb3\x46\xeb\x04\xa0\xe3\x62\x30\x5a\xe7\xe0\xad\x0c\x6e\x8e\xd6\x0d\xf8\x2d\xd1\xba\x81\x84\x29\x5a\xe7\x59\x29\xcd\xd1\x3a\x83\xe0\x4c\x8c\x88\xd6\xfb\x16\xad\xda\xc2\x18\xad\x5b\xa8\x98\xa3\x75\x0b\xa1\x91\xd1\x7a\x97\xee\x23\x73\xdb\xd4\x1c\xae\xb7\x29\x0b\xad\x05\x20\x30\x5c\x6f\xf9\x91\x64\x11\x10\x0e\xd7\x41\x9c\x86\x70\x1d\x44\x0b\x85\xeb\x6d\x3a\x10\xae\xb7\x29\x0b\xa8\x05\x48\x73\xb8\xde\xf2\x33\xca\x22\xb4\x25\x5c\x07\xb1\xdb\xc2\x75\x90\x80\x31\x5c\x6f\x53\x7b\xb8\xde\xa6\x2c\xa4\x16\x00\x8d\xe1\x7a\xcb\x0f\x30\x8b\xc0\xe6\x70\x1d\xc4\x6d\x09\xd7\x41\xf4\xa6\x70\xbd\x4d\xad\xe1\x7a\x9b\xb2\xa0\x5a\x80\x33\x85\xeb\x2d\x3f\xdd\x2c\xc2\x1a\xc3\x75\x10\xb3\x39\x5c\x07\x91\x1b\xc2\xf5\x36\x1d\x08\xd7\xdb\x94\x05\xd4\x02\xa4\x39\x5c\x6f\xf9\xa1\x67\x11\xda\x12\xae\x83\xd8\x6d\xe1\x3a\x48\xc0\x18\xae\xb7\xa9\x35\x5c\x6f\x53\x16\x54\x0b\x70\xa6\x70\xbd\xe5\x67\xa2\x45\x58\x63\xb8\x0e\x62\x36\x87\xeb\x20\x72\x43\xb8\x4e\x26\x17\x53\xb8\x4e\xa7\xa0\xf2\x45\x82\x02\xc3\xf5\x96\x1f\x99\x96\x20\xe1\x70\x1d\xc6\x6a\x08\xd7\x61\xc4\x50\xb8\x4e\x66\x13\x6b\xb8\x4e\x27\x9e\xf2\x45\x02\x35\x87\xeb\x2d\x3f\x43\x2d\x81\x5b\xc2\x75\x18\xbf\x2d\x5c\x87\x49\x18\xc3\x75\x32\xad\xd8\xc2\x75\x3a\x01\x95\x2f\x12\xa4\x31\x5c\x6f\xf9\x01\x6b\x09\xda\x1c\xae\xc3\xd8\x2d\xe1\x3a\x4c\xc0\x14\xae\x93\xf9\xc5\x12\xae\xd3\x89\xa8\x7c\x91\x00\x4d\xe1\x7a\xcb\x4f\x5f\x4b\xc0\xc6\x70\x1d\xc6\x6d\x0e\xd7\x61\xf4\x86\x70\x9d\x4c\x2e\xd6\x70\x9d\xce\x43\xe5\x8b\x04\x6a\x0e\xd7\x5b\x7e\x28\x5b\x02\xb7\x84\xeb\x30\x7e\x5b\xb8\x0e\x93\x30\x86\xeb\x64\xa6\xb1\x84\xeb\x74\x4a\x2a\x5f\x24\x40\x53\xb8\xde\xf2\x33\xdb\x12\xb0\x31\x5c\x87\x71\x9b\xc3\x75\x18\xbd\x21\x5c\x6f\xd3\xc1\x70\xbd\x4d\x79\x28\x2d\x02\x5b\xc2\xf5\xb6\x3b\xc6\x2d\x35\xb0\x85\xeb\x06\x1a\xd6\x70\xdd\x40\xc6\x1c\xae\x63\x30\x7b\xb8\xde\xa6\x3c\x98\x16\x61\xcd\xe1\x7a\xdb\x9d\xf1\x96\xe0\x2d\xe1\xba\x81\x82\x2d\x5c\x37\x10\x31\x86\xeb\x18\xca\x1a\xae\xb7\x29\x0f\xa7\x45\x50\x63\xb8\xde\x76\x07\xc0\x25\x70\x73\xb8\x6e\xc0\x6f\x09\xd7\x0d\x24\x4c\xe1\x3a\x06\x1a\x08\xd7\xdb\x94\x87\xd2\x22\xb0\x25\x5c\x6f\xbb\x73\xe1\x52\x03\x5b\xb8\x6e\xa0\x61\x0d\xd7\x0d\x64\xcc\xe1\x3a\x06\xb3\x86\xeb\x6d\xca\xc3\x69\x11\xd4\x18\xae\xb7\xdd\xb1\x71\x09\xdc\x1c\xae\x1b\xf0\x5b\xc2\x75\x03\x09\x53\xb8\xce\xb3\xd5\x9a\xc3\xf5\x36\xed\x03\x69\x19\xda\x14\xae\xb7\xc2\x59\x72\xa5\x85\x31\x5c\xb7\x50\x31\x87\xeb\x16\x42\x60\xb8\x3e\x6f\x50\xdb\xb8\x59\x91\x17\x24\x99\xdf\xe5\x58\xe4\x8d\x7b\x8c\xb2\x24\x7d\xd9\xfd\xfa\x6f\xbf\x14\x79\xe1\xfe\x05\x9d\x9e\xd2\xa8\x72\x7e\x41\x79\x5a\x38\xbf\x14\x79\x74\x28\x9c\x3f\x15\x79\x5d\xa4\x51\xed\xbc\xfb\x39\xd9\xa3\x2a\x6a\x92\x22\x9f\x61\xf0\x77\xce\xbb\x3f\x15\x4f\x55\x82\xaa\xd9\x9f\xd1\xf3\x3b\xa7\x43\x2d\x32\x4a\x88\xb2\xf4\xc8\x17\xf2\x83\x26\x96\xd5\x32\x26\x33\x50\x72\x01\x80\x9e\x73\x50\x83\x63\x57\x05\xe8\xe9\x12\x35\xc8\xa6\x7a\xca\x0f\x51\x83\xd4\xec\x9e\x8f\xa4\xb6\x2b\x44\x69\x9a\x94\x75\x52\x03\x19\x18\x19\x22\x92\xba\x54\xe8\x82\x9a\xbf\x94\x54\xd1\xdc\xa5\x02\x94\x96\xc0\x94\xd4\xb1\x3c\xc1\x02\x9c\x96\x02\xd8\x9c\x68\x80\xb4\xe2\x49\x6e\x87\x18\xea\x12\xdd\x0e\xf2\xd4\xdf\xc0\x6d\x63\xcb\x72\xa4\x82\x1a\x58\x3c\x8e\xaf\x2e\xf1\xed\x20\x5f\xfd\x1d\x84\x13\xf9\xe2\x9b\x47\xa8\xf6\x4e\xe3\xf8\xea\x12\xe1\x0e\xf2\xd5\xdf\xc2\x32\x91\xaf\x6e\x99\x8c\xb4\xe3\x49\x71\x87\x18\xeb\x12\xe3\x0e\x32\xd6\xe7\xa1\xb6\x32\xc6\x7a\x51\x3c\xa3\xea\x10\xd5\xe8\xc2\x46\x4b\x94\xd7\xc7\xa2\xca\x76\x5d\x85\x86\xff\xa9\x2c\xe1\x26\x5d\x85\x6e\xef\x51\x99\x34\x51\x9a\xfc\xae\xb5\xe9\x6b\xa4\x7c\xb3\x78\x72\x7a\x26\xe9\x1b\xdd\x94\xa6\x03\xee\x4b\x76\x0b\xcf\xb3\x02\xa3\x4a\x02\x67\x65\xa6\x26\x74\x86\x91\x5a\x2c\xcd\x04\xf6\x45\x1a\x4b\xb0\x6b\x3b\xac\xc2\x0b\x2d\xd2\x1a\x10\x11\x1c\x28\x64\xdd\xbc\xa4\x68\x47\x4b\xf4\xf9\x11\xcf\x4e\x17\x9a\x46\xf2\xfd\xf1\x78\xd4\x00\xca\x2a\xc9\xa2\xea\x85\x83\x78\xde\x7a\x2f\x41\x45\x12\x18\x4d\x13\xeb\x28\x85\x67\x3c\x2b\xf6\x18\xc2\xd5\x7e\xa1\xcf\x16\xe8\x50\xe4\xb1\x40\x69\x75\x58\x87\xeb\x58\xa7\xd4\x01\xca\xb4\xfa\x62\x89\xda\x72\xbb\x3c\x86\x4b\x9d\xda\xd3\xe1\x80\xea\x9a\x43\x05\x9b\x68\xbd\x0c\x01\x5a\x14\x4c\xa1\xc4\x0a\x25\x3a\xfe\x76\xb5\x0d\x74\xf1\x26\xf9\xb1\xe8\x40\xd6\x51\xb0\xdf\xe8\x44\x30\x8c\x4c\x81\x94\xc8\x42\x3b\xae\x56\x6b\xbd\x1b\xcf\x51\x95\x27\xf9\xa9\xd7\xdf\xc1\xf7\xd6\x3a\x05\x06\x26\x13\xe1\x85\x12\x9d\x7d\xb4\xd9\xcb\xf6\x47\x60\xe3\x28\x3f\xf5\x40\xf1\x61\x11\x42\xd2\xa2\x50\x32\x15\x56\x26\x11\x89\xd6\x7e\x1c\x44\xfa\xfc\x47\xc6\x25\xef\xca\xe6\xb8\x3d\x46\x3a\x0d\x02\x24\x93\xa0\x45\x12\x85\xc3\x3e\x5e\xc4\x11\xd0\x8d\xea\x2b\x07\x59\x2c\x17\xd1\xd2\x83\x3a\x51\x7d\x55\xbb\x50\x7d\x55\x94\x1d\xf8\x4b\x7f\xa5\xa1\xdf\x17\x71\x67\xbd\x81\x1f\x84\xc1\x56\x7f\xf7\x3c\x35\x28\x36\x5a\x38\x43\x93\x46\x87\xaf\x6e\xe8\x5d\xb4\xe4\xae\x73\x39\xfb\x70\x3f\x80\x15\xe8\x20\x0c\x1d\xfe\x3f\xa8\xcd\x39\x89\xa9\xbf\xb6\xf3\x3e\x79\xb3\xe8\x91\x36\x25\x73\x68\x19\x55\x28\x6f\xa8\x2f\x23\x64\x33\xd6\x73\xce\x8a\xd0\x7d\x46\x69\x2a\x30\x74\x28\xa8\x5f\x47\x33\x23\x2b\x85\x5a\x7a\x64\xda\xeb\x0a\x45\x5f\x2f\xcf\x45\x15\xd3\xc7\x1d\xf9\xd7\xc5\x05\x42\x4a\xf1\x2e\xc3\x3a\xb9\x59\x09\x02\xe1\x7e\x13\xaa\x11\x37\xa6\x24\x3f\xa3\x2a\x91\xde\x6b\x2c\x2f\xf5\x85\xfc\x4d\xd2\xa4\x79\xe1\xa9\xaa\x45\xa8\x24\x07\xe0\xb4\x5c\xee\xec\x85\x5c\x56\x49\xde\x5c\xfe\xe0\xb0\xf4\xbf\xce\x6e\xb7\x47\xc7\xa2\x62\xdd\x07\x33\x43\x3f\x9a\x73\x46\x47\xbb\xbc\x68\x7e\x98\xef\x9b\xfc\x41\x93\xdf\x53\x1e\xa3\x2a\x4d\x72\xf4\x1a\xed\xf7\xd5\x6f\x4d\xd2\xa4\xe8\x0b\xcf\x3a\xdc\xe5\x09\x9e\xfd\xf0\x6e\x16\x35\x4d\xf5\x03\xa9\x7f\x98\xbd\x7b\x78\xf7\x5a\x56\x48\x72\x71\xcb\x0a\xb9\x8a\x93\xbb\x4f\x8b\xc3\xd7\xff\xf7\x54\x34\xc8\xc1\xd0\x4c\xb5\x7e\xd9\xce\xea\x22\x4d\xe2\xd9\xfb\x28\xde\x87\xfb\xf8\xb1\x8c\x4e\x88\x6a\xca\x4d\xf2\x3a\x89\xd1\x2e\xfa\x56\x24\xf1\x6b\x73\x46\x51\x7c\x89\x93\xba\x4c\xa3\x97\x5d\x13\xed\x53\xe4\xe2\x22\x54\xb9\xd8\x7e\xca\xd7\x24\x3b\x39\x4d\x75\x31\xb5\x3f\x07\xce\x79\xe1\x94\x97\xa2\x2a\xcf\x51\x5e\xef\x16\x8f\xcf\x49\x5c\x3c\xd7\xbb\x05\xad\x12\x1b\x92\x1e\xb3\x76\xff\x8c\xcb\x2f\x75\xf2\x3b\xda\x45\x8b\x57\x32\x14\x15\xcf\x4d\xd4\x2c\x96\x52\x94\xe4\xa8\xb2\x01\xe5\xd1\xb7\x7d\x54\x75\x7d\xc1\x1a\x7a\x9d\xef\xa3\xf8\x04\x89\xc5\xf3\xb0\xf9\xe3\xee\xb2\x4a\x3c\x4e\xd2\xa8\xac\xd1\x8e\x3f\x48\x46\x8a\x21\x67\x4d\xec\xf0\xa7\xf3\x45\x1b\x62\xda\xfb\x98\x08\x93\x62\x47\x71\xdf\x58\x28\x3a\x03\x9c\xc5\x08\x05\x68\xa5\x23\x12\x66\x43\x36\x42\xc4\x9a\x59\x83\x65\xf8\x23\xf9\xd7\x91\xca\x63\xf9\xe7\x59\xf9\x89\x22\x81\x0f\xde\x13\xca\x03\xef\xf6\x9c\x40\xf1\x06\x32\x0f\x8f\x60\xc3\xd7\xff\xf1\xe9\x0f\xef\x67\x75\xf1\x54\x1d\xd0\x2f\x51\x59\x26\xf9\xe9\x3f\xfe\xf2\xf3\xe7\x7d\x51\x34\x75\x53\x45\xe5\x3c\x4b\xf2\xf9\xa1\xae\xe7\x59\x54\xce\xfe\xf0\xe9\xff\x0b\x00\x00\xff\xff\x80\xb3\x48\x4e\x1b\x6f\x02\x00")
 
 // web/css/style.css file
-var cssstyleCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x92\xc1\x6e\xdb\x30\x0c\x86\xef\x7e\x0a\x02\xc1\x6e\x53\xe0\x04\xeb\x80\x29\xc7\x3d\x89\x2c\xd2\x16\x07\x59\x34\x24\x26\x4d\x36\xec\xdd\x07\xcb\x6e\x6b\x17\xc3\x56\xe4\x10\x5b\xfa\xf9\xfd\xfc\x49\x77\x82\x0f\xf8\xd5\x00\x20\x97\x29\xba\x87\x85\x3e\xd2\xfd\xd2\x40\xfd\x37\xc8\x99\xbc\xb2\x24\x0b\x5e\xe2\x75\x4c\xf3\xcd\xc8\xc9\x04\xe2\x21\xa8\x85\x53\xdb\xde\xc2\xa5\xf9\xdd\x34\xe1\x5c\x39\xbd\x24\x35\x45\x1f\x91\x2c\xb0\xba\xc8\x7e\xb9\x25\x87\x94\xab\x42\xe9\xae\xc6\x45\x1e\x66\x28\x25\xa5\x5c\xa1\x2e\x0f\x9c\x2c\x9c\x68\xdc\x16\x84\xd3\x67\x78\x79\x3c\xef\x1b\xe5\x14\x39\xd1\x5e\x0c\xee\x4d\x7e\x02\x67\x83\xdc\x56\x57\x2f\x51\xf2\x5c\x14\x28\xb3\x5e\x5e\xfa\x40\xf2\x92\xdd\x92\x30\xc9\x3b\xdc\x36\x11\xff\x24\x0b\xe7\x2f\x53\x9d\xcd\xe4\x10\x39\x0d\x26\x52\xaf\x16\x8e\x4f\x6b\xcf\xbd\x88\x7e\x20\xa4\x51\x99\xd6\xa0\x3b\x7a\x7b\xfc\x96\x57\xd2\x21\xca\xc0\xe9\xbb\x24\x75\x9c\xfe\x81\x9c\xa5\x23\x95\xe2\x06\xfa\xbf\x78\x33\xbb\xd7\xa8\x87\x1b\x23\x49\xa9\x35\x37\x2e\xdc\x71\x64\x7d\x58\x08\x8c\x48\xa9\x4a\x8e\x55\xb2\xc7\x6f\xd6\xff\x69\x06\x4b\xf7\x83\xbc\x9a\x9e\xd5\x42\xf1\x2e\x92\x41\x79\x4e\xdb\xbd\xb6\xe0\xae\x2a\x9b\xe1\x59\x68\xff\x8a\xaf\xaf\xd5\xe4\x99\x51\xc3\x9b\xc7\x7b\xcf\x4e\x32\x52\x36\xd9\x21\x5f\xcb\x7c\xbc\xec\x66\x39\xb6\xf0\x34\xdd\x01\xe5\xda\x45\x82\x43\x7f\x9e\x7f\x8b\x9d\x97\x68\x46\x34\x5f\xab\xc5\xba\x90\x4e\x54\x65\x7c\xfd\xf8\xfe\x04\x00\x00\xff\xff\x76\xb2\xf7\xcc\x15\x03\x00\x00")
+var cssstyleCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x8c\x92\xc1\x6e\xdb\x30\x0c\x86\xef\x7e\x0a\x02\xc1\x6e\x53\xe0\xa4\xe9\x80\x29\xc7\x3d\x89\x6c\xd1\x16\x37\x99\x34\x24\x26\x4d\x56\xec\xdd\x07\xab\x6e\x62\x17\xc1\x56\xf8\x60\x5b\xfa\xf9\xfd\xfc\x29\x35\xe2\xaf\xf0\x5a\x01\x78\xca\x63\x74\x57\x0b\x5d\xc4\xcb\xb1\x82\xf2\x36\x9e\x12\xb6\x4a\xc2\x16\x5a\x89\xa7\x81\xa7\x9d\x81\xd8\x04\xa4\x3e\xa8\x85\x5d\x5d\x9f\xc3\xb1\xfa\x53\x55\x61\x5f\x38\x9d\xb0\x9a\xac\xd7\x88\x16\x48\x5d\xa4\xf6\x6d\x17\x9d\xc7\x54\x14\x8a\x17\x35\x2e\x52\x3f\x41\x91\x15\x53\x81\xba\xd4\x13\x5b\xd8\xe1\xb0\x2c\x08\xbb\xaf\xf0\xfe\xb9\x5f\x37\x4a\x1c\x89\x71\x2d\x06\x77\x97\xef\xc0\xd9\x20\xe7\xd9\xb5\x95\x28\x69\x2a\x0a\x98\x48\x8f\xef\x7d\x78\x6c\x25\xb9\xb7\x84\x2c\x1f\x70\xcb\x44\xf4\x1b\x2d\xec\x0f\x63\x99\xcd\xe8\xbc\x27\xee\x4d\xc4\x4e\x2d\x6c\x9f\xe7\x9e\x3b\x11\xfd\x44\x48\xa3\x32\xce\x41\x57\xf4\x7a\xfb\x3d\xcd\xa4\x4d\x94\x9e\xf8\x87\xb0\x3a\xe2\x7f\x20\x27\xe9\x80\x39\xbb\x1e\xff\x2f\x5e\xcc\xee\x16\x75\x73\x26\x8f\x92\x4b\xcd\x99\x32\x35\x14\x49\xaf\x16\x02\x79\x8f\x5c\x24\xdb\x22\x59\xe3\x17\xc7\xff\x65\x02\x4b\xf3\x13\x5b\x35\x1d\xa9\x85\xdc\xba\x88\xc6\xcb\x0b\x2f\xcf\xb5\x06\x77\x52\x59\x0c\xcf\x42\xfd\x10\x5f\x7e\x8b\xc9\x0b\x79\x0d\x77\x8f\x8f\x9e\x8d\x24\x8f\xc9\x24\xe7\xe9\x94\xa7\xe5\xf1\x72\x5f\xb6\xf0\x3c\x5e\xc0\xcb\xa9\x89\x08\x9b\x6e\x3f\x3d\x8f\xec\xb6\x79\x44\xf7\x8b\xb8\x5f\xf8\xce\xe0\xf9\xc6\x6c\x9e\x0e\x4f\xee\x30\xf7\xda\x4a\x34\x83\x37\xdf\xe0\xf5\x16\xcd\x34\xa2\x2a\xc3\xed\xe6\xfe\x1d\x00\x58\x41\x71\xe8\x52\x03\x00\x00")
 
 // web/index.html file
-var indexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbc\x57\xd1\x8f\x9b\xb8\x13\x7e\xdf\xbf\x62\xea\x5f\xa5\x1f\x91\x1a\x50\x2b\xdd\xe9\x54\x01\xa7\xbb\x36\x0f\x95\xaa\x6e\x75\xdb\x9e\xd4\xa7\xd6\x98\x49\xf0\xc6\xb1\x39\x7b\xc8\x16\x55\xfb\xbf\x9f\xc0\x10\x4c\xa2\x26\x5b\x55\x7b\x2f\x09\x83\xc7\xdf\xcc\x7c\x33\xfe\x80\xf4\xc9\xeb\xeb\x57\x1f\x3e\xbd\x5f\x41\x45\x3b\x95\x5f\xa5\xfe\x0f\x20\xad\x90\x97\xdd\x05\x40\x4a\x92\x14\xe6\x7b\x29\x2a\x4e\xb0\x04\x0e\x7b\x59\xa2\x81\xde\xe4\x75\x9d\x26\xde\xc1\x3b\x2b\xa9\xb7\x60\x51\x65\xcc\x51\xab\xd0\x55\x88\xc4\xa0\xb2\xb8\xce\x58\x22\x9c\x4b\x0a\x63\xc8\x91\xe5\x75\xbc\x93\x3a\x16\xce\xb1\x87\xee\xec\x6f\x87\x3b\x9c\xb0\xb2\x26\x28\x71\x8d\x16\x9c\x15\x19\x4b\x6e\x5d\x52\xd5\xd6\x38\x5c\x76\x95\xfc\xd2\xc7\xb8\x75\x2c\x4f\x13\xef\x7c\x76\x27\x6f\xbe\x4a\x25\xb9\x6d\x4f\xb6\xa4\x89\xe7\xa3\xbb\x2c\x4c\xd9\x0e\x30\xdd\x4d\xb4\xde\xe8\xcc\xe7\x79\xca\xc7\x8c\x19\x08\xc5\x9d\xcb\x58\xc1\xcb\x0d\x42\xff\xbb\x2c\xb9\xdd\xb2\x81\xca\x34\xe1\x79\x9a\x54\xcf\xa7\xed\x2f\xf2\x63\x6e\x95\x14\x9c\xa4\xd1\x69\x52\xbd\x18\x62\x26\x63\x50\x6f\xee\xb8\xd4\x63\x24\x61\x34\x71\xa9\xd1\xc2\x5a\xe1\xd7\xe5\x5a\x2a\xc5\x0e\xe8\xa5\xdc\x8f\x7e\xd6\xdc\x1d\xee\xcf\x57\x84\x51\xcb\x5d\xb9\x7c\xd1\x55\x5f\xca\xfd\x59\xa7\xdf\x02\x8c\xc1\x41\x96\x19\x53\x66\x23\xf5\xab\x31\x13\x96\xa7\x45\xfe\xee\xfa\xc3\x2a\x4d\x8a\xfc\x25\x7c\x32\x0d\x70\x8b\xa0\x0d\x81\x68\xac\x45\x4d\xaa\x05\x65\x36\x1b\x2c\x41\xea\x18\xde\xac\xa1\x35\xcd\xff\x4b\x50\x72\x8b\x40\x06\x1c\x71\x4b\x9e\x0f\x87\xce\x49\xa3\xa1\x56\xc8\x1d\x06\xb1\xbb\xa6\x34\x44\x46\x03\xb5\x35\x66\xcc\x1b\x53\x03\x48\x43\x41\x7a\x20\x5f\x99\x0d\x48\x9d\x26\xde\x69\x56\xc2\xbc\xe4\xa0\xa6\x1d\x3a\xc7\x37\x38\x55\x35\x42\x73\x85\x96\xa0\xff\x5d\x2a\xb9\xa9\x88\x81\x35\x0a\x87\x85\x53\x16\x2f\x92\x7a\xcc\xfc\x60\x5c\x1d\x25\xd4\x4f\x89\x63\x0f\x6e\xe8\xaf\xa7\xbd\x1a\x1c\x7a\xa4\xa0\x5d\x73\x5a\xfd\x34\x76\x11\x1d\xaa\xf5\xdf\x9d\xc5\x80\x37\x64\x6a\xc5\xdb\x3c\x4d\xfa\xf5\x33\x1c\x5e\x2e\xf8\xe7\x33\xb3\xb8\x33\x84\x3f\x99\x5b\x60\xa4\x49\x77\xa6\xc6\xf3\xb5\x36\x86\xd0\x8e\x39\x79\x6b\x3a\x54\x75\xfe\x1a\xf7\xa8\x4c\x8d\x25\x14\x2d\xa4\x1c\x88\xdb\x0d\x52\xc6\x3e\x17\x8a\xeb\xed\xa8\x5f\x15\x51\xed\x5e\x26\xc9\x46\x52\xd5\x14\xb1\x30\xbb\x44\xb4\x5c\xeb\x66\x7b\x46\x26\x06\x8f\x4e\x27\x80\xeb\xf2\x32\xba\x74\x8e\x5b\xea\xd1\xcf\xc0\x4e\x5e\x5e\x81\xea\xb1\x6c\x5f\xdc\x58\x78\xa8\x97\x00\xa5\x11\xcd\x0e\x35\xc5\xff\x34\x68\xdb\x1b\x54\x28\xc8\xd8\x3f\x94\x8a\xd8\xff\xe6\x27\x1e\x86\xd3\xb7\x88\xd7\xc6\xae\xb8\xa8\x22\x84\x2c\x07\x8c\x8d\x16\x4a\x8a\x2d\x64\xc0\x5d\xab\x05\x44\x8b\xee\xfe\xb7\x43\x3f\x14\x12\x34\x56\x41\x06\x1a\xef\xe0\xe3\x5f\x6f\xa3\x43\x50\x65\xbc\x0c\x2e\x66\xce\x83\x1e\x7c\x96\x25\x64\xdd\xce\xd8\x21\xb7\xa2\x7a\xcf\x2d\xdf\xb9\x78\x83\x14\xb1\xc1\x85\x4d\x1b\xe5\x1a\xa2\x27\xd3\xce\x45\x90\x00\x00\xd9\x76\x66\x03\x08\xa3\x1d\xc1\x37\xa1\x24\x6a\xfa\x2c\xcb\x67\xd0\xe5\xa2\x6e\xc8\x22\xdf\x3d\x03\x3f\x7b\xde\xba\xef\x2a\xbb\xe3\x92\xba\xfc\x6f\x7c\x84\xc8\xe8\x95\xb5\xc6\x2e\x66\xa0\x52\x4b\x8a\xbe\x3c\xfd\x76\x52\xdf\xfd\xef\x43\x66\xd9\xd3\x29\xe4\xfd\x97\x33\x41\x43\xe0\x7b\x10\x9c\x44\x05\x11\x2e\x8e\xaa\x18\xb2\x88\x70\xe6\x7e\x35\x6d\x44\xe5\xf0\x31\x98\xb8\x35\x52\x8f\x54\x4c\xa4\x3f\x83\x1f\xa4\xe5\xf1\x19\x18\xae\xee\x17\x57\x97\x07\xde\x3f\x80\xa1\x7a\x0e\xfc\xc7\xa7\xdc\xf3\xa2\x90\xef\x71\x24\x66\x71\x12\x7c\xdd\x68\xd1\x15\xee\x29\x69\xac\x3a\x57\x7f\x00\x7e\x48\x7a\x83\xb4\x52\xd8\x5d\xfe\xd9\xbe\x29\xa3\x40\xbd\x17\xb1\xb3\xe2\xba\xb8\x45\x41\x90\x85\xa8\x97\x41\x42\xa1\x9d\xc3\x84\xf9\x5c\xc6\x39\x7a\x43\x58\xc4\xfe\xc5\xae\x94\xae\x53\x6e\xc8\x80\x69\xa3\x91\x5d\x06\x1a\x9e\x82\x23\xc0\x5e\x3a\x59\x48\x25\xa9\xc7\xe8\x2d\x15\xc0\x04\x92\xf1\xb1\x97\x99\x03\xae\xb0\xc8\x09\x07\xe8\x88\xf1\x40\x2e\xee\x24\x55\x10\x4d\xdb\xe6\x73\xd5\x2b\x0f\x9b\x56\x59\xb0\xd6\x49\xb3\xd7\xa5\xf0\x58\xcd\xf5\x3b\x58\xe9\xe5\xfa\xad\x74\x14\xf3\xb2\x8c\xbc\x6c\xb3\xc5\x05\x07\xaf\xe8\xa1\x97\xd4\x1a\xed\x07\xfc\xda\x75\x85\xdd\xac\x6e\x6e\xde\x5c\xbf\xeb\xb4\x94\x5d\x9d\x0e\xbd\xaf\xed\xbb\xec\x9e\xbc\xf4\x2c\xe6\xc5\xf3\xba\x46\x5d\xbe\xaa\xa4\x2a\x43\x82\x02\x8f\x93\xbe\x16\xca\x88\xed\x69\x2a\xf7\x27\x73\x7f\x38\xae\xbd\x4a\x3c\x64\xc2\x7f\x74\xa8\x82\x69\xe8\x43\x9d\x99\x07\x57\x73\xfd\xbd\x91\x58\x1d\x27\x78\x34\x14\xfd\xfa\x23\xf7\xb9\x27\xe9\x3f\xe9\xef\x89\x68\x3f\xbc\xc3\xfe\x15\x23\xfc\xa2\xf2\xdf\x51\x69\xe2\x3f\x39\xff\x0d\x00\x00\xff\xff\x21\x27\xb8\xde\x8a\x0e\x00\x00")
+var indexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcc\x5a\x5f\x8f\xdb\xb8\x11\x7f\xcf\xa7\x98\x53\x8b\xab\x0c\xac\xa5\x4b\x80\x16\x45\x60\xb9\xb8\xdb\x18\x6d\xd0\x5c\x36\x58\x6f\x0a\xdc\xd3\x85\x16\xc7\x12\x63\x9a\x54\x49\xca\x1b\x37\xd8\xef\x5e\x90\x94\x6c\x4a\xb2\xbc\xde\x5c\xee\x90\x17\xaf\x28\xfe\xe6\xff\x70\x38\xa4\x76\xf6\xdd\xab\x9b\xeb\xbb\x5f\xde\x2d\xa0\x34\x5b\x3e\x7f\x36\xf3\x7f\x00\x66\x25\x12\x6a\x1f\x00\x66\x86\x19\x8e\xf3\x1d\xcb\x4b\x62\x60\x0a\x04\x76\x8c\xa2\x04\x37\x24\x55\x35\x4b\x3d\xc0\x83\x39\x13\x1b\x50\xc8\xb3\x48\x9b\x3d\x47\x5d\x22\x9a\x08\x4a\x85\xeb\x2c\x4a\x73\xad\xd3\x95\x94\x46\x1b\x45\xaa\x64\xcb\x44\x92\x6b\x1d\x5d\x4a\xe9\x5e\x87\x14\x3a\x57\xac\x32\x40\x71\x8d\x0a\xb4\xca\xb3\x28\xfd\xa8\xd3\xb2\x52\x52\xe3\xd4\x5a\xf2\x57\x27\xe3\xa3\x8e\xe6\xb3\xd4\x83\xcf\x52\x92\xfa\x13\xe3\x8c\xa8\xfd\x80\x64\x96\x7a\x7f\xd8\xc7\x95\xa4\xfb\x86\x8d\x7d\x89\xca\x0f\xec\xf0\xf9\x7c\x46\x5a\x8d\x23\xc8\x39\xd1\x3a\x8b\x56\x84\x16\x08\xee\x77\x4a\x89\xda\x44\x8d\x2b\x67\x29\x99\xcf\xd2\xf2\xf9\x91\xfc\xc5\xbc\xef\x5b\xce\x72\x62\x98\x14\xb3\xb4\x7c\xd1\xc8\x4c\x5b\xa1\x7e\xb8\x25\x4c\xb4\x92\x72\x29\x0c\x61\x02\x15\xac\x39\x7e\x9a\xae\x19\xe7\xd1\x81\x3b\x65\xbb\x16\xa7\xe4\xfd\xe1\x7d\x77\x26\x97\x7c\xba\xa5\xd3\x17\xd6\x7a\xca\x76\x67\x41\x7f\x0f\x78\x34\x00\x46\xb3\x88\xcb\x82\x89\xeb\x56\x93\x68\x3e\x5b\xcd\xdf\xde\xdc\x2d\x66\xe9\x6a\xfe\x12\x7e\x91\x35\x10\x85\x20\xa4\x81\xbc\x56\x0a\x85\xe1\x7b\xe0\xb2\x28\x90\x02\x13\x09\xbc\x5e\xc3\x5e\xd6\x7f\xa1\xc0\xd9\x06\xc1\x48\xd0\x86\x28\xe3\xfd\xa1\x51\x6b\x26\x05\x54\x1c\x89\xc6\x40\xb6\x0d\x4a\x6d\x8c\x14\x60\xf6\x15\x66\x91\x1f\x1c\x03\x60\x04\xac\x8c\x68\x9c\xcf\x65\x01\x4c\xcc\x52\x0f\xea\x98\xd0\x35\x39\xb0\x69\x8b\x5a\x93\x02\x8f\x56\xb5\xac\x09\x47\x65\xc0\xfd\x4e\x39\x2b\x4a\x13\x81\x92\x1c\x9b\x89\xa1\x17\x1f\x75\x6a\xdf\xf3\xcd\xe0\x59\x4f\x21\x97\x25\x3a\xba\x38\xa0\x7f\x1b\xc6\xaa\x01\x38\x4e\x81\x61\x94\x18\x32\xd5\x46\x21\xd9\x4e\xad\xa4\x0e\x21\xc0\xcc\xe1\x9d\x0e\x1a\xf9\xfa\x3f\x76\x14\x01\xa9\x8d\xac\x38\xd9\xcf\x67\xa9\x9b\x3f\xe3\xd5\x53\xd6\xf9\x47\x9b\xc9\x6d\x56\xaf\xa5\x34\xa8\x5a\x1d\xfd\xe8\x98\xca\xd5\xfc\x15\xee\x90\xcb\x0a\x29\xac\xf6\x30\x23\x60\x88\x2a\xd0\x64\xd1\xaf\x2b\x4e\xc4\xa6\xad\x1a\xa5\x31\x95\x7e\x99\xa6\x05\x33\x65\xbd\x4a\x72\xb9\x4d\xf3\x3d\x11\xa2\xde\x9c\x59\x9c\x0d\xc2\xae\x4e\x20\x82\x3e\xce\x9d\x69\x4d\x94\x71\xdc\xcf\xb0\x3d\xa2\xfc\xba\xaf\x5a\xb3\xbd\x71\xad\xe1\x61\x95\x02\xa0\x32\xaf\xb7\x28\x4c\xf2\xdf\x1a\xd5\x7e\x89\x1c\x73\x23\xd5\x8f\x9c\xc7\xd1\x9f\xba\xeb\x0c\x9a\x9c\x9f\x24\x6b\xa9\x16\x24\x2f\x63\x84\x6c\x0e\x98\x48\x91\x73\x96\x6f\x20\x03\xa2\xf7\x22\x87\x78\x62\xdf\x7f\x3e\xc4\x83\xa3\x81\x5a\x71\xc8\x40\xe0\x3d\xbc\xbf\x7d\x13\x1f\x84\x72\xe9\x8b\xcf\xa4\x03\x6e\x56\xe1\xaf\x8c\x42\x66\x29\x13\x8d\x44\xe5\xe5\x3b\xa2\xc8\x56\x27\x05\x9a\x38\x6a\x20\xd1\x91\x90\xad\x21\xfe\xee\x48\x39\x09\x14\x00\x30\x6a\xdf\x19\x03\xe4\x52\x68\x03\x9f\x8f\x04\x57\x60\x95\xe1\x4b\x97\x97\x0f\xd6\x98\x7b\xc2\x8c\x55\x79\xe9\x31\xf1\x69\x4d\x94\x94\xdb\x68\x72\x05\x84\xd2\x5b\xdc\x4a\x83\x9e\xc3\x15\x48\xd1\x10\x2e\x76\x28\xcc\xa4\x23\x9d\x09\x66\xe2\x46\xf6\x7b\xc5\xe3\x40\xef\x8e\x1e\x21\xd5\x03\xe4\xc4\xe4\x25\xc4\x38\xe9\xd9\x22\xc5\x42\x29\xa9\x62\xec\xc0\x0f\xcf\x0f\x80\x5c\xe3\x65\xfe\xc8\x39\x43\x61\xc6\xdd\xf1\x51\xb2\xd6\xac\x38\xf4\xdd\x37\x6c\x7d\xf3\xf4\x30\x79\xf6\x78\xca\xfb\x8d\x0f\xca\xe7\x40\x9e\x9e\xe7\xde\x43\x1c\xc9\x0e\x5b\x17\x4d\x06\xc2\xd3\x14\x4c\x89\x76\x15\x80\x2e\x89\x42\x0a\xf7\xcc\x94\xee\x5d\x45\x94\x61\x39\xab\x88\x30\xfa\xca\xbd\x91\xf7\x02\x8c\xdc\xa0\x00\xa6\xdd\x86\xe6\x49\x1a\x56\xeb\x5a\xe4\x76\xf1\xc0\x69\x57\x7e\xe9\x0a\x1c\xa4\x39\x45\x8e\x06\xe3\xc8\x69\x12\x5d\x00\xf4\x4b\x62\x1c\xa7\xc3\x15\x7c\x15\x2c\xf7\x23\x8d\x42\x53\x2b\xe1\x48\x8d\x5c\x1a\xc5\x44\x71\x74\xe6\xb3\xbe\x03\x5c\x42\xd5\x8a\x77\xb3\x27\x70\xc0\xc1\xdc\x02\xcd\x82\xa3\x7d\xfc\x69\xff\x9a\xc6\xc1\x0e\x33\x49\xb4\xca\x6f\x56\x1f\x31\x37\x90\x85\x7c\x1e\x67\xd2\xeb\x47\x26\x89\x6f\x23\x29\xd3\x76\xc7\x82\x0c\x22\x21\x05\x46\x8f\x33\x6a\xf6\xdc\x96\xc1\x8e\x69\xb6\x62\x9c\x19\xc7\xc3\x8d\x38\x46\x9d\xa8\x1e\x43\x0f\xd9\x91\x6f\xae\x90\x18\x6c\x58\xc7\x11\x09\x82\xe1\xb2\x2d\x58\x7c\xdd\xd5\xe4\x2a\x6e\x74\x9c\x8d\x82\x39\xbb\x25\xf9\x7a\x1c\xbc\xec\xed\x5b\xc1\x8c\xdb\xa6\xde\x30\x6d\x12\x42\x69\xec\xb7\xab\x68\xf2\x08\xc0\xef\x64\x21\x8a\x09\x81\xea\x0e\x3f\x19\xab\xd8\x72\xb1\x5c\xbe\xbe\x79\x6b\x33\x38\x7a\x36\x5c\xea\xce\x21\xb9\x42\x14\x3f\xf9\x5e\x6d\xdc\x25\xed\x5e\xd6\xf7\x4b\x40\xdd\xdb\x41\xf6\x15\x5a\x15\x1a\xc2\x33\x76\x18\x71\xd6\x4c\x23\xa6\x7a\xfb\x18\xe2\x11\x37\xfc\xeb\xc7\xdb\x05\x2c\xaf\x6f\x17\x8b\xb7\xa1\x26\x8f\x15\xa8\xd3\x95\xbf\x2d\x5c\xae\xb6\x2c\x9d\x03\xe2\x49\x07\x31\x5e\x7b\x47\xaa\x6f\x18\x94\x53\xb5\xd8\x87\x4a\x61\x2e\x15\xfd\xd2\x50\x85\xd4\xa7\x92\x38\x9c\x8f\xbe\xd1\x40\xde\x2e\xae\x6f\x6e\x5f\x7d\xbd\x10\x7a\x9b\xdb\xcd\x27\xf4\x40\x12\xc8\xcd\x8e\x92\xff\x90\x38\x73\x99\x6f\xbe\x34\xca\x47\xda\x53\x31\x3e\xce\x7e\xab\x11\x7e\x73\x73\xfd\xef\xaf\x17\x5f\x6b\x6f\x1b\xdd\xa3\xed\xfd\xd8\x3a\x99\xbf\x7b\x64\x7d\x7c\x46\xb7\xb2\xc1\x79\x76\xd2\x95\x4c\xaa\x0a\x05\xbd\x2e\x19\xa7\xe1\x6e\x34\x86\x08\xeb\xf2\x08\xa6\x53\x10\x46\x30\x41\x3a\x05\x88\xc1\x76\xbd\xb2\xb8\xe1\x0e\x33\x6c\x3c\x7a\x6d\x6f\xac\x07\x9d\x07\x47\xd3\x5c\xb8\x8c\xe7\xfe\xce\x37\x20\x07\x1a\x37\x4e\xda\x13\x2f\x64\x60\x54\x8d\xbd\xd9\xb0\x59\xd1\xdd\x3e\xe5\xde\x86\xda\x35\x35\x4b\xf6\x3f\x8c\x1d\x7e\x32\x54\xe8\x10\x9a\x33\x9a\x51\xb6\xeb\xeb\x75\x20\x4b\x7a\x8b\xa1\x3b\x3b\x4e\x66\x8f\xff\x1a\x4d\xe2\xb5\x7e\x4d\x0f\x06\x24\x8c\x8e\xd1\x84\x31\x3c\x61\x4f\x2e\x79\xbd\x15\x17\xdb\xe1\xe1\x7d\xfd\x0f\xf7\x18\x03\xe0\x40\xfa\x41\xb1\x23\xb4\x31\x81\x50\xea\x0e\x3c\x96\x2d\x0a\x54\x71\xa4\x70\x2b\x77\x68\x14\xc9\x37\xd1\xd5\x89\xf5\x6e\xcf\xad\x0d\x71\x81\xe6\xce\xe2\x74\x3c\x49\x38\x8a\xc2\x94\x6e\x41\xff\x30\x19\x9c\xd5\x9c\x5a\x9e\x73\x3c\x72\xec\x99\x3c\xa1\xdd\x0c\x0d\xf4\xcc\xc7\x7b\xed\xee\xc1\x2e\x46\xfb\x1b\x2a\xa8\xef\x99\xaf\x34\x76\x22\xb1\xb5\xb8\xab\x7e\x4e\x34\x42\x14\x9c\x75\xa6\xf6\x4c\x89\x34\x7a\xd9\xb1\x51\x97\xf2\xfe\x67\x5f\x43\xe2\x10\x0d\x0d\xba\x5b\xac\x56\x0a\xc9\xe6\xac\x10\x8e\x6b\x73\xa9\x08\x87\xbd\x44\x40\x53\xb8\xa6\x39\x97\xfa\xbc\x05\x0d\x12\x4a\xa2\x61\x85\x28\xa0\x21\xb9\x44\x8a\x2f\x6c\x4c\x14\x53\x77\x53\x39\x10\x34\x1a\xdf\x4e\x0b\x34\x49\xba\x3d\xe4\xdd\xcd\x3b\xf0\x5d\xc0\xeb\xb7\xff\x8c\x46\x35\xf7\x61\xd4\x1b\x56\x55\x48\xe1\xfb\xef\xa1\xf3\xa2\x4d\xd3\x39\xfc\x00\xff\xe8\xf0\x00\xf8\xd0\xda\xcc\xac\xc9\x4c\x14\x4d\x7b\x82\xf4\x0a\xfe\xfc\xf9\x14\x9b\x07\x70\xcb\x24\xd6\x13\xc8\x89\x10\xd2\xc0\x0a\x0f\x44\x1f\xe0\x25\x44\xa3\x2c\x9f\xec\x48\x59\x55\x5f\xc7\x91\xc3\x1e\xae\x17\xfa\x83\xd4\x63\xf0\x5b\xf1\x4f\x4d\xe2\x6d\x6d\xce\xa6\xd9\x87\x00\x7c\xf0\xf1\x86\x09\xfa\x00\x8e\xf4\xc3\x53\x05\xd6\xe2\x37\x88\xac\xc5\x97\x09\xdd\xb0\x7c\x73\x79\x3d\xf0\x55\x90\x3e\x69\xbd\x72\x79\x42\xc2\x99\xe3\x7d\xbe\x39\x1d\xfb\xf7\x6f\xfb\xfd\xdd\xc8\xb2\x67\x1a\x1a\x99\x4f\x51\xb3\x16\x5f\x4b\xd1\xcb\xd5\xac\xc5\x13\x14\xdd\x4a\x8a\x8a\x18\xa9\xa6\x79\x49\x44\x71\x3e\x68\x07\x30\xb4\xe0\x4b\x44\x50\xb9\x65\xc2\x66\x85\xae\x90\x6c\x70\x4c\x52\xc9\x8a\xd2\x7d\x1d\x59\x7a\x58\x5b\xb7\x9a\x1e\xe3\x12\x49\x68\xbb\xe0\x33\x06\x78\x8e\x0e\x75\x8e\xdd\xa0\x59\x4c\xd3\x81\x76\xb0\x25\x6a\xa3\xc1\x94\xd8\xf4\x86\x72\xed\x06\xba\xb9\x3c\x6d\xef\xfe\xfc\x24\x0b\x27\x81\x51\x60\x1a\x70\x5b\x99\x7d\x7f\x6b\x1e\x38\xe1\x60\xfe\xa9\xab\xb0\xe1\xed\x67\xd2\x6f\xe1\x0e\x37\xa0\xc7\xcf\x7e\xbd\xee\x25\x3f\xd1\x0d\x1a\x59\x14\xdc\x66\x95\xd5\x82\x89\x22\xba\x82\xfc\x4c\xf7\x97\x65\x30\x0c\xd3\xc3\x78\x03\x12\xc6\xa4\x39\x65\xf4\x1b\xee\x26\x9f\x1b\x14\x64\xe3\x4b\xa6\x8b\xec\x7d\x4e\xe8\xb3\xc9\x32\x10\x35\xef\x5d\x99\x8d\xcb\xea\xf5\xa0\xba\x22\xdd\xe3\x66\xe7\x2a\xee\xe7\xa1\x25\x83\x0b\xb9\x56\xcb\x0e\xe2\xd1\x7b\xb6\x91\x9b\x36\xff\x2d\xf1\x74\xf3\x78\xa6\xc6\x0c\xcf\x75\xc9\x89\xa3\x5c\x6b\xcd\x89\x73\x63\x17\xd1\x29\x53\x0d\xef\x33\xad\x67\x73\x52\xb5\xbf\x17\x5d\xef\x3e\xf5\x66\x36\x48\x1e\x27\xea\xf2\x70\x76\x82\xb9\xe8\x2b\xd8\x0b\xa4\x9b\xff\x9d\x2f\x4b\x9d\x93\xfe\x90\x73\xfb\xa2\x5f\x12\x2f\x3f\x4f\x83\xfb\x3e\x19\xfe\x13\x84\xff\xd7\x87\x59\xea\xff\x4b\xe4\xff\x03\x00\x59\x89\x7f\xd4\x3d\x22\x00\x00")
 
 // web/js/auxiliary.js file
-var jsauxiliaryJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xd4\x55\xc1\x8e\xdb\x36\x10\xbd\xfb\x2b\xa6\x6a\x0f\x14\x20\xc8\x6e\xb3\x08\x52\x01\xce\xc5\xc9\xa1\x45\x9a\x16\xbb\x5b\xf4\x10\x2c\x36\x0c\x39\xb6\xd9\x95\x48\x83\xa4\x6c\x04\x82\xfe\xbd\x18\x52\x96\x69\xad\x16\xc8\xa5\x05\xea\xc3\x5a\xa6\x66\xde\xbc\x79\x6f\x38\x5b\xa3\x87\xc7\x1a\xf9\x11\xef\xd0\x39\x65\x34\xac\x41\xb7\x75\xbd\x58\x70\xf7\x55\x0b\xd8\xb6\x5a\x78\x3a\x56\x5a\xf9\xdb\x83\xd8\xd4\x0a\xb5\x67\x39\x74\x0b\x00\x00\x4a\x17\xe1\x08\xd6\xb0\x3f\x58\xe3\xb0\xfc\x0b\xbf\xdc\x19\xf1\x84\x3e\xc6\x96\xc2\x22\xf7\xc8\x3e\x9f\x5c\xb5\x5c\xfe\xd0\x49\x23\xda\x86\xce\x6b\x23\x38\x41\x97\x7b\xe3\x7c\xbf\xfc\x5c\x40\x37\x70\x70\x15\x7c\xca\x3e\xe2\x29\x2b\x20\xfb\xd5\x28\x4d\xdf\x9b\xda\x38\xa4\x87\x0f\x44\x36\x7b\xe8\x8b\x40\x80\x3e\x9d\x57\x0d\x9a\xd6\x57\xf0\xe6\xf5\xcd\x8a\x3e\x05\x28\x89\xcd\xc1\x78\xd4\xbe\x02\x6f\x5b\x2c\xc0\xa9\xe6\x50\x63\xfc\xd5\xe7\x13\xf6\x8f\x4a\xc2\x1a\xf8\x89\xab\xf3\xc9\xa7\xec\xfb\xec\x81\xc5\x38\x8b\xbe\xb5\x1a\xba\xf8\xa6\xb8\xe4\xf4\x8b\xfe\x99\x50\x1a\x4f\x43\x1b\xa3\x4a\xc2\x68\xe7\xe7\xd2\xc7\x9a\x13\x75\xd3\xac\x03\xa2\xdd\x18\xad\x31\xc0\x17\x40\xb2\xd5\x77\xde\x22\x6f\x0a\xb0\xd8\x18\x8f\xf1\xd7\x05\x2d\x2a\xfe\xc7\x55\x22\x8b\x34\x25\x3a\x01\xeb\xb7\x03\x87\xf2\x2c\x78\xf9\x11\x4f\x6c\xe4\x55\x84\xb0\xd2\x7f\x3d\xe0\xf0\xe8\xe4\x21\x8f\xac\xa6\xc3\x12\x61\x59\x4e\xa0\xdd\x68\x49\xaa\xe4\xa5\x48\xf0\xf0\x52\x26\x1f\xc3\xaf\x7b\x2c\x45\x88\x8b\xaf\xfb\x19\x07\x02\xc5\x97\x75\x98\x31\xe5\x6f\xa3\xf4\xd9\x15\x17\xbf\x89\xc0\xff\xc7\x1f\xba\x06\x09\xf3\x7f\xcb\xa1\x70\xb9\x52\x85\xfe\x3b\x8b\x52\xd6\xe3\xcd\x51\x5b\x60\xd7\xfd\x7c\xb7\x8e\x0b\x2a\x7f\xd6\xcb\xe3\x35\xc2\xf8\x76\x76\xbd\x45\xde\xcf\x59\xcc\x5a\xb3\x43\x7f\x1b\xd8\xbf\x43\x27\xac\x3a\xd0\xe1\xf5\xec\x08\xa3\xb7\x6a\xd7\xda\xb0\xd0\x60\x0d\x9d\x12\x78\x87\xf6\x88\x96\x76\x59\xd7\xda\xda\x55\x90\x39\xdf\xea\x8a\xfe\x94\x75\xb9\x33\x66\x57\x63\x29\x4c\x53\xfd\xf8\xf3\xab\xd5\x4f\x59\xff\xd0\xc3\x72\x09\xb7\xf7\x9b\x4d\x0a\x96\x14\x69\x50\x2a\xbe\xa1\x47\xcb\x95\xf6\x8e\xea\x1c\x95\x44\x53\x41\x77\x52\xd2\xef\x2b\x78\x7d\xb3\x2a\x60\x8f\x6a\xb7\xf7\x15\xdc\xbc\x59\x15\xb0\xb5\xbc\xc1\x5b\xee\xb1\x82\x57\xab\xbe\x00\xde\x4a\x65\x86\x2d\x48\xf5\x7e\x23\xd0\xe8\x4a\x02\x3d\xae\xc7\x6b\xdb\x49\x3d\x3c\x11\xc7\x89\x42\x57\xfd\xe7\x8b\x31\x3d\x99\x80\x71\xfa\x35\x3f\xaa\x1d\xf7\xc6\x96\xa1\xa1\x77\x78\x54\x02\x5d\xb9\x43\xff\xa7\x43\x1b\xf8\xb0\x69\xab\xc3\xbe\xbe\xa0\x51\xf8\xbd\xe5\xe2\xc9\xb1\xbc\xdc\x1a\xfb\x9e\x8b\x3d\xf3\x74\x40\x63\x3e\x19\x56\x2e\xe5\xbd\xe5\xda\x09\x54\x47\xb4\x31\xac\x80\xce\x05\x24\x32\x28\x01\x7e\x28\x40\x2a\x1b\x13\xc9\x32\xd4\xd2\xa2\x38\x66\x7d\x9e\xb4\x65\xb6\x5b\xb4\x63\x43\xd3\xab\x11\x46\xe8\x77\x0a\x61\x49\x4e\xbc\xd9\x43\xca\xdc\x44\xb1\x80\x1a\x3b\x9d\x40\x1a\xad\x04\x0a\xae\xa5\x92\xdc\x23\xac\xc1\xe8\x5f\x36\xef\x37\xe7\x83\xf2\x8b\xd2\x92\x4d\x17\x11\x15\xbc\xfc\x9f\x4b\xaf\xdf\x60\x63\x62\x3d\x7b\xa1\xec\xa0\x28\x18\x1d\xc4\x9e\x2f\x94\x42\x0f\x0d\xcf\x0a\xe3\xd0\x7f\x20\xa1\x5f\xe8\xf9\xbc\x40\xbe\x7d\xa1\xce\xdc\xdf\x6b\x61\x18\x69\x50\x00\x1e\x51\xfb\x74\xa5\x84\x83\x32\x11\xf4\xc5\xa5\xe2\xf7\xca\x11\xf1\xe7\x6e\x5d\xe4\x0d\x3c\x12\x06\x41\x2a\x96\x32\x9d\x50\x88\xe5\x87\xf1\x1b\xa7\xd7\x0d\xde\xbc\x05\xf7\x0d\x23\x9e\xc2\x0f\x03\x2e\x9e\xe2\xeb\x3c\xcf\x17\xfd\xe2\x9f\x00\x00\x00\xff\xff\xbb\xdf\x12\x32\xda\x09\x00\x00")
+var jsauxiliaryJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe4\x5a\x5f\x93\x1b\xb9\x71\x7f\xdf\x4f\xd1\x9e\x5c\x5d\x0d\x93\xd1\x48\xbe\xd8\x89\x8b\x0e\x4f\x65\xaf\x54\xc9\xc6\xd2\x9d\x6a\x57\xca\x3d\x5c\xa9\x4e\xd8\x41\x93\x84\x39\x04\xc6\x00\xb8\x14\xbd\xe6\x77\x4f\x35\x80\x99\x01\x66\x86\xdc\x5d\x45\x4e\x1e\xc2\x17\x92\xf8\xd3\x68\xa0\x7f\xfd\x07\xdd\xa8\xd1\xc2\x2f\x35\xb2\x3b\xbc\x41\x63\x84\x92\xb0\x00\xb9\xab\xeb\x0b\xd7\xd1\x20\xea\x4b\x25\x25\x56\x76\xd8\xa5\xb1\x52\x9a\x4f\x4d\x32\x68\xff\x4b\x70\x54\x37\xe2\xaf\x98\x74\x6c\x15\x47\xcd\xec\xe4\x4a\xc2\xbc\xf5\xbd\x4a\xc3\x02\x96\xac\x36\x78\x71\xf1\xfc\x39\xd8\x35\x82\x55\x1b\x94\x20\x8c\xd9\x21\x87\xdb\x83\x6b\x63\x4d\x53\x8b\x8a\x39\xb6\x84\x81\x86\x19\x83\x1c\x84\x74\x9d\x0d\x5b\x21\x7c\xb8\x7e\x53\x00\x96\xab\x12\x5e\x3a\x02\x8b\x7f\x73\x5f\xdf\x17\xc0\x24\x87\x46\xa3\x41\x69\x91\x83\xf2\x73\x76\xcd\x4a\x33\x8e\xbf\x6f\x17\xad\xfa\x6d\xab\x25\x30\x30\x58\xed\x74\x20\x2d\x4c\xfb\xd7\x2a\x75\xb1\xdc\x49\x3f\x4e\x37\xd5\x07\x5d\xe7\x33\xb8\xbf\x00\x00\xa2\x60\x6c\x60\x7e\x01\x12\xf7\xc4\x52\xce\x55\xb5\xdb\xa2\xb4\x65\xad\x3c\xfb\xb3\xd2\x20\xd3\xd5\xfa\x1d\xd3\x6c\x6b\xca\x15\xda\x3c\x73\x93\xb2\x59\x44\xc6\x54\x6b\xdc\xd2\x71\x8e\xe6\x97\x8d\x56\x56\x55\xaa\x86\xc5\x62\x01\xd9\xda\xda\xc6\xcc\x33\x78\x09\xd9\xde\x98\x0c\xe6\xf4\x9d\x39\x4a\x1a\xed\x4e\x4b\xf8\xf4\xcd\xbd\xa7\x76\x9c\x3f\x7f\xfe\xcd\xfd\x98\xe0\x5a\x19\x7b\x7c\xfe\x09\xfe\x09\x72\xcf\xfd\x4b\xf8\x14\xce\xf0\x9b\x7b\x94\x95\xe2\xf8\xe1\xfa\xea\x52\x6d\x1b\x25\x51\x5a\x3f\x68\x76\xfc\x44\x6b\x65\xb3\x8b\xe3\xc5\x05\x33\x07\x59\x41\x77\x30\x42\x0a\x7b\xdd\x54\x97\xb5\xa0\xe1\xed\xf9\x90\xdc\x2b\xd7\x04\x0b\x58\x37\x5a\x19\x2c\x7f\xc2\xdb\x1b\x55\x6d\xd0\xfa\xb1\x65\xa5\x91\x59\xcc\xdb\x93\x2d\xe0\x3e\x80\xc7\xcc\xe1\xe7\xec\x07\xdc\x67\x05\x64\xff\xa9\x84\xa4\xef\xcb\x5a\x19\xa4\x1f\x6f\x08\xcf\xf4\xe3\x0f\x9c\x5f\x32\xc9\x05\x67\xd6\xfd\xff\x01\x57\xca\x8a\xf0\xe7\x0f\xd2\xec\x51\xd3\xaf\x1b\xcb\xb4\xbd\x76\x80\x16\x72\xe5\x5b\x54\x93\x34\x5c\x5d\xbe\xbe\x41\x7d\x87\xda\xb8\xee\x08\xe2\xf4\xff\xed\xce\x93\xfc\x93\xa8\x36\x8e\x01\xe5\xbf\xdf\x6b\x26\xcd\x12\x75\xc0\xb6\x50\x32\xfb\x78\x2c\xdc\xee\xe9\x73\x6f\xc5\x16\xd5\xce\xce\xe1\x77\xff\xf2\x9b\x17\xf4\x29\x40\x70\xdc\x36\xca\xa2\xb4\x73\xb0\x7a\x87\x05\x18\xb1\x6d\x6a\xf4\xff\x8e\xb3\xc1\xd1\xfd\x22\x38\x2c\x80\xed\x99\x68\x5b\x7e\xce\xfe\x21\xfb\x98\xcf\x62\x89\xdf\xfb\x9e\xa2\x9f\x73\x24\x29\x05\xa4\x9b\xa0\x8e\x82\x83\x30\xae\x45\x2b\xb5\x85\x6a\xad\x0c\xca\x56\xdf\x22\x1d\xd4\x85\x6b\x11\x1c\xd4\xd2\xfd\xf2\x54\x41\xd9\x35\xea\xbd\x30\x38\x14\xbf\xc4\x7d\x90\x5a\x4e\x94\x0b\x50\xf2\x1a\xb7\xca\xe2\x8d\xd5\xc8\xdc\xff\xd7\x77\x28\x6d\xaa\x38\x13\x4c\x77\x3b\x1d\x00\x2a\x9a\x15\x36\xe3\x8f\xc5\xed\xe3\x6f\x7f\xeb\x29\xb8\x81\x66\x77\x6b\x2a\x2d\x6e\xd1\x2d\x6a\xf2\xd1\x3a\x3d\x43\x6e\x7c\x38\x26\xed\x58\x86\x3b\x92\xbb\x01\x8d\x8d\xd2\x96\x3a\x84\x06\x43\x96\x8e\x19\x30\x4a\x49\xfa\xb6\x6b\x3c\x00\xd3\x34\x47\x72\xd4\xe8\xd7\x1d\x1a\xc6\xdc\x6a\x56\x6d\xae\x78\x01\x7b\xc1\xed\xba\x80\x35\x8a\xd5\xda\xce\x60\xf1\x7d\xe0\xa5\x6c\xc1\x5e\xc6\x80\xcb\xfb\x3d\x16\x70\x82\x46\x7c\x8e\xa9\x11\x2f\x80\xf4\xbc\xf6\x47\xdf\x9f\xa8\x57\xb4\x77\xc9\xd0\xa9\x93\x89\xd7\xf6\x62\xe6\x68\x2a\x62\xf9\xbe\x83\xb5\x5f\x97\x39\xf5\x1a\x80\xb3\xdf\xd1\x0f\xb8\x4f\x36\x42\x64\x4a\x7b\x68\x30\xfc\x34\xbc\x99\x75\x14\xc5\x12\x72\x4f\xaf\x54\x7b\x89\xfa\x4f\x78\x98\x45\x0b\xd2\x27\xec\x4a\x69\xb6\xc2\xd2\xa0\xbd\xb2\xb8\xcd\xdb\xc1\xee\x4f\xbf\xda\xac\x80\x21\xb5\x8e\xd6\xb1\xfb\xd5\x2a\x0f\x31\x35\x6f\x27\x78\x0e\x0d\x6f\xba\x16\xc3\x1b\x3f\xe7\x38\x04\xb6\x27\x3a\xf2\x94\xba\xb5\x2b\xdd\xf9\x46\x9c\xf9\x29\x63\x4f\xb9\xed\x2c\xc8\xc9\x59\x01\xa7\x6e\x4b\xed\x78\x34\x89\x8a\xef\xa4\x15\xb5\x6b\xe9\xe9\x39\xa5\x0f\x76\xaa\x83\x6a\xea\x8e\xc9\xf2\xf8\xf6\x41\xa4\x40\x7f\xe3\x8d\x9c\x00\x4a\x8a\xc0\x29\xd3\x34\x9a\x12\x83\x74\xc2\xa3\xfc\x59\x09\xd9\xda\x94\x78\xda\xdf\xc5\xb2\xfc\xbf\x31\x18\x09\x82\x34\xae\x98\x90\x66\x88\x96\xdb\x43\x34\x66\x83\x87\x22\x1e\xa0\xf4\xb3\x6a\xcd\xe4\x0a\x39\x20\x9d\x87\x0b\xcd\x76\x66\x4d\x71\x1b\x2e\x95\x46\x37\xd8\x2b\xce\x14\xd0\x7c\xdc\xd7\x4b\xaa\x55\x4f\x58\xb4\x80\xf0\xfa\xbd\x7a\x40\xbf\x67\x64\xf5\xb3\xec\x7f\xc3\x0a\x76\xe6\x22\xb5\x86\x43\x79\x50\x8c\xf2\x90\xbd\x2b\xba\xfd\xce\xfe\x8f\x4d\xc9\xdf\x57\xcf\x87\xba\x1d\x60\xb7\xc1\x43\x1b\x51\x34\xa8\x8d\x30\x16\x79\x4b\xde\x9d\x4c\x17\xfc\xdf\x6a\xb5\x37\xa8\xfb\xc8\xfb\x14\x0e\xe0\x3e\xe6\xe1\xd3\x9d\xa8\xd6\xcc\x3e\x73\xa3\x9f\x7d\x73\xdf\x0f\x3c\x7e\x8a\xf8\xe8\xb0\x0c\x15\x05\x94\xa9\x05\x5d\x2a\x4d\xd0\xd6\x07\x25\xd1\x43\xdf\x85\x3d\xd0\x30\x6d\x45\x25\x1a\x26\xad\x71\xc7\x85\x20\x6c\xcf\xe0\x97\x1d\x60\xca\xbe\x07\x58\x3e\x1b\x38\x5b\x0f\xb4\x9d\xec\xac\x54\x9e\x55\x51\xcc\xdb\xad\x34\x3b\x3b\x45\x2d\x97\x2e\x16\x7e\xe4\x70\xa7\xdd\xd3\xc3\xc9\x57\xc7\x6a\x3d\x74\xd4\xd3\xe1\x80\x8b\xdd\xf3\x21\x0e\x27\x9d\x3b\xd9\xd5\x3b\x3c\xaf\xff\xbd\x3b\x07\xac\x0d\x3e\x8a\x05\x77\x6b\x98\x64\xa1\x8f\x09\x52\x01\x95\x0e\x20\xc1\x4b\xb4\x50\x0e\x04\xbc\x01\x34\xf3\x18\x19\xcf\xc8\x6d\x21\x2f\x92\xb6\x1a\x97\xbd\x06\x3e\x73\x14\x79\xd1\x6b\xf6\x33\x43\x97\x93\x61\x93\x6a\x1a\xe4\x05\x2d\xc7\xd5\x56\x48\xa2\x63\x1a\x64\x1b\xec\x0c\x70\xba\xc8\x76\x67\x87\x4d\x3b\x39\xd1\xb8\x11\xd5\x06\x7b\x50\x3e\xab\x55\xb5\x09\x0b\xb5\x4d\x3b\x19\x1a\x27\x8c\x3e\x93\x1c\x50\x6b\x15\xa9\xe6\x13\x9c\x67\xeb\xa4\xbd\x5c\xce\xa1\xad\x8d\x3d\x5d\x47\xaa\x0f\x04\xbf\x5f\x61\x4c\x2f\x8d\xe7\x26\x84\x4a\x53\xdc\x0c\x67\x8f\xfd\x35\x7a\xb4\xb7\x6c\x48\x6e\xe0\xba\x3c\x01\xcf\xe1\x15\x77\x44\xd2\x6b\xc7\xc3\x6b\xa6\x18\xc8\xce\x46\xb8\xa7\x94\xc0\xd3\x0c\x94\xae\x12\x4d\x18\xc4\xe7\x9e\x02\xef\x58\x3f\xc9\x07\xf9\xd2\xe1\x90\x31\x66\x32\xf8\xf6\xdb\xc7\x1d\x02\x6d\xbd\x5d\xfc\xdb\x6f\x47\x49\xa6\x5f\x2d\x7c\x5e\x68\x74\xde\x67\x74\xaf\x17\xb1\x41\xdb\xc6\x85\x53\x5b\x0f\x50\xf3\xc7\x14\xf4\x76\x2a\x63\x11\xfb\xbf\x2e\x61\xe1\x2c\x5b\xdc\x33\xc9\xab\x37\x2e\xbf\xa4\x14\x2e\xce\x70\xe8\x0c\x47\xb7\x72\x3a\x20\xd0\x3d\x91\x88\x9b\x72\xd5\x7d\xc7\x64\x86\x6e\x2a\xae\xec\x3b\x4e\x64\xe8\x4e\x46\x6a\x29\xe3\x67\x62\x91\x29\x4f\xe6\x0c\x1b\x29\xaf\xff\xf1\x72\x1c\xc3\x26\x69\x99\xc4\xaf\xcf\x27\x06\xab\x66\x72\x6c\x6b\x96\x89\x98\x01\xa5\x81\xac\x67\xc8\x73\xb4\xe3\xdb\xb8\x23\x4c\x2b\xbc\x8b\x8f\x2d\x23\x08\x03\x52\x59\xb1\x14\xc8\x87\x60\x49\x0e\x3a\x77\x0b\x25\x90\x49\xfa\xcf\x61\x66\x8a\x50\xe2\x5b\x06\xe1\x89\x92\x56\xab\xda\x14\x81\x77\x4a\x4f\x81\xc6\xe5\x2e\x04\x2d\x5b\xb0\xea\x44\x88\xd2\x8b\xed\x7c\x34\x98\xca\xad\x67\x98\x5c\xc7\xbc\x8d\x48\x5a\x7d\x2f\x60\x23\x24\xf9\x05\xf2\x2b\x93\xb7\x12\xca\x93\x25\x51\xf0\xf4\xd4\x3e\xa8\x26\xdb\xd2\xae\xd3\x9b\x95\x31\x61\x4a\xbc\x4d\x12\x8e\x68\xd5\xaa\xa7\xe5\x5d\xd8\x14\xa5\x37\x6a\x40\xc9\x0f\x8d\xe8\xb4\x97\xe5\xc7\xf0\x35\x4e\x00\x4e\x73\x99\x46\x10\x0e\xa0\x8a\x64\x69\x76\x5b\x34\x14\x74\xee\x59\x02\xd4\x18\x99\x6c\xc7\x85\xa2\xe1\xee\xa6\xe9\xc1\x40\xa7\x09\xc2\x40\xe6\x3a\x33\xea\xcd\x5c\x77\x36\x04\x2f\x9d\xf8\xbb\x9e\xda\x29\x61\x46\x70\x1e\x1a\x89\x33\x80\x1e\x0c\x2d\x89\xd8\x89\x15\x92\x13\xf0\xee\xc1\x8c\xb6\xba\xd4\x6a\x1b\x2b\xea\x70\x33\x04\x97\xa9\xcd\x7c\x2d\xfe\x89\x7e\x3e\x2d\x34\x82\x49\x7a\x55\x60\xee\xfe\x6c\x5d\xe6\x3f\xd6\x3d\x50\x1a\x7c\x10\x65\x40\xd8\x91\xe7\x51\xd5\xa6\xb5\x00\x01\x7a\x5f\x89\x79\xa2\xd6\x92\x4c\x58\x77\xc5\x93\xd1\x45\x3f\x35\x89\x60\x15\x30\x39\x32\x24\x43\xee\xed\x18\xef\x5f\x5b\x06\xed\x12\x27\xe4\xe0\xf3\x2c\x41\x12\xe4\xdf\xc2\x3e\x1c\xfa\x01\x6b\xa4\x5a\x47\x6b\x18\xbd\xcd\x2c\xc0\x84\xbf\x62\xbb\xab\x2b\x66\x2c\xd4\xec\x80\x1a\x96\xc2\x5a\x52\x3a\xea\x6b\x67\x8a\x4e\x1d\x91\xf7\x56\x74\xcf\x6c\xb5\xee\x13\x2e\x6e\xb1\x76\x2f\x24\xff\x6b\x24\x5e\x7e\xbc\xf5\x0b\xe6\xc3\x4b\x9c\x3b\x93\xd4\x2b\x87\x03\xa1\xe0\xcb\x51\x2b\x8d\xae\x7e\xbc\xfd\x33\x56\xb6\xeb\x7b\x74\x80\xeb\x03\x3e\x97\xf0\x81\x05\xbc\x65\x76\x5d\x6a\xb5\x93\xdc\xf3\x19\xe2\xb5\x9f\x5c\xf7\x3f\xc2\x5e\x48\xae\xf6\x25\xc7\x3b\x51\xe1\x3b\xf1\x19\xeb\x6b\x12\xe4\x6c\x40\xcd\xe7\x8d\x4e\x93\xfb\x0f\xdf\xff\x08\x7a\x83\xed\x95\xab\x70\x0e\xef\x29\x53\x65\xf2\x59\xb9\x54\xfa\x35\xab\xd6\x3e\xfd\x05\x8b\xef\xd3\xb0\x30\x3e\x36\x3f\xa4\x14\xa3\xf4\x56\x59\x91\x80\x72\x74\x36\x5a\x49\xa3\x6a\x2c\xdd\x45\x25\xf7\x66\xd1\x41\x25\x2b\x00\x67\xb3\x36\x2a\x2c\x95\x97\x56\x10\x66\x80\x17\xe3\x3c\x60\xab\xd2\x88\xd2\xa7\xd3\x7a\x38\x85\xf0\x81\xfe\xf8\x1e\x61\x40\xb6\x45\xa7\xae\xd6\xb8\xac\x0f\x43\xc5\x31\x6b\xa6\xf1\xc6\xd1\x4c\xe3\xcd\x47\x44\xc7\x35\xda\xc0\x8e\x4f\xaa\x74\xe9\x2c\xc9\xee\xc4\x8a\x42\x85\x72\x8b\x5c\xb0\x57\x4e\x04\xae\xc4\xf8\x4a\x98\xa6\x66\x87\xb7\xd4\x9c\xdf\xbb\x1d\x26\x05\x26\xfa\xc4\x24\x69\xce\x49\x79\x8c\x42\x73\xc6\xb9\x73\x7c\x15\x0a\x42\xbb\x1b\x57\xc0\xbd\x71\xa4\xa8\x72\x17\x93\xfe\x58\x00\x17\xda\xcf\x9c\x43\x66\x50\x72\x25\xeb\x43\x76\x9c\x45\x8a\x3d\x38\xad\x2f\xa9\x56\xac\xd0\xfa\x8c\xda\x2b\xa4\x7b\x65\xe3\xd3\x80\x83\x34\x5b\x92\x27\xae\x94\x5c\x8a\xd5\x2e\xd8\xc3\x05\xdc\x8b\x0a\x43\x11\x70\x7e\x22\x87\xd0\x97\x09\xf3\xd9\x91\xf2\xa9\xd7\xef\x2f\x2f\x63\x32\x11\x79\x27\x93\x4b\xfa\xa9\x99\x90\xd6\xd0\x0a\x41\x12\xf7\x0e\xbd\x73\xf8\xf5\x77\xbf\x7b\xd1\x62\x78\x0e\xff\xfa\xdd\x8b\x02\x96\x9a\x6d\xf1\x9a\x51\xf8\xf5\xcf\x2f\x8e\x85\xf7\xfe\x41\x76\xb4\xa0\x13\xa9\xdf\x4d\x44\x3b\x4e\xef\x56\x6c\x8b\x9a\xf9\xd4\xec\x6d\x2d\xcc\xba\x2d\x9c\x6b\x44\x6f\xf8\xd2\x88\x32\x98\x3b\x0f\xfb\xd4\x30\x22\xab\xd6\xfd\x0d\x5f\x27\x75\x38\xc9\x5f\xcb\x4a\x51\xd0\x42\x3b\xfb\xb9\x2f\x7a\x6a\xc1\xe7\x90\xfd\x25\x2b\xc0\x54\xac\xc6\x6b\x34\xaa\xde\xd1\xd9\xbc\x52\x7b\xf9\xc7\xc3\x1c\x7e\x53\xc0\x96\x7d\xfe\xa3\xb0\xda\x6d\xf3\xd7\xbf\xa5\xca\xe8\xb1\x18\x52\x58\x9f\xa6\xf0\x5d\x4a\xe1\xb7\x2f\xa6\x29\x2c\xb3\xa9\x95\xda\x81\x1f\xbb\x52\xeb\xf8\xf6\x45\x26\xfd\xfd\xe5\x10\x80\xb1\xa0\xfb\x42\xad\x8b\xe3\xdf\x6b\x51\x6d\x6a\x74\xc5\x14\xf7\xab\xab\x4b\x9b\x2f\xc9\xaa\x76\x74\xaf\xb1\x35\x2f\x6d\x46\x38\xfa\xff\x54\xca\x1d\xe9\x28\x43\xfb\x08\x63\xf2\xc1\xa0\xf6\x96\x64\x08\xe9\xc0\x6c\x4f\xed\xbc\x1d\x79\x94\x19\x71\x5f\xa5\x0b\x70\x5d\x2a\xc2\x87\xb5\xf0\xb2\x17\x6e\x6f\x67\xa2\x95\x27\xcc\x8c\xc6\xea\x2e\x2b\x52\xa8\x1e\x61\xfe\x44\x02\xad\x9d\x1a\x30\xaf\x64\xd8\x16\x28\xe9\x76\x5c\xde\x0a\xc9\xf3\x61\x19\x82\x90\xf4\x96\x35\xf9\x44\xc6\xbf\x93\x86\xcb\xcd\x76\x72\x18\xac\xe3\x4d\xe1\x8f\x34\x24\xe4\x13\x26\x87\x19\xb4\x6f\x68\x2f\x91\xf1\xcb\x1d\xdd\x68\x1d\x5f\xbb\x08\xf3\xa7\xcc\x65\x3b\xe3\xdc\x2a\xe3\x49\x44\x36\x9e\x13\xeb\x43\x60\x79\x0c\xe5\xd0\x31\x4e\x7c\xa4\x0d\xc9\xed\xf4\x5c\x89\xa7\x4b\xce\x6a\xc1\x6a\xf1\x57\x34\xa9\xa6\x18\x10\x52\x04\x27\x7d\x7b\x88\xad\x1f\x93\xc3\xb2\xc3\xef\x61\xbf\x46\x09\xb7\xca\xae\xc1\x08\x8e\xc6\x87\x9d\x2d\x35\x04\x66\x41\xc9\x0a\x63\x2a\x5e\x84\x96\x6d\xd0\x40\xa3\xb1\x42\x8e\x34\x82\x88\x47\x94\xc3\x30\x61\x40\xab\xba\x26\x56\x58\xb5\x89\x33\x2c\x5f\xae\xdb\xd1\x9b\x9b\xbf\xec\x70\x97\xa4\x7d\xbc\x89\x93\x84\x7f\xb2\xd4\x1f\x23\x33\x8e\xb2\x1d\x6d\x99\xd9\xa4\x41\x6b\xdb\xe3\xbe\x4b\xbb\x46\x99\xd3\xa0\x73\xb1\x56\xb2\x03\x1f\x6e\x05\x1f\x7f\x22\xf3\x3b\x2a\x4b\x14\xad\x36\x8c\x72\xbe\x1e\x9b\x4f\x0b\x89\xfd\xa6\x4e\x54\x56\x9e\x86\xf2\x48\x35\x1e\x9a\x3c\x52\xc4\x33\x8a\xed\x1f\x29\xe5\xb3\x94\xf2\xe0\x85\xc5\x60\x66\x3d\xa0\xff\x88\xda\x47\x58\x26\x79\xdd\x11\x3f\x78\xe8\xdf\x3a\xcc\x4e\x24\xb3\x03\x1a\x4e\x5c\x4d\x02\xbc\x4a\x2a\x06\x7b\x94\x9c\xaf\xd0\x04\xdc\x0d\x87\xb6\x09\xdb\x49\x7b\x1b\x41\x4b\x22\x72\x97\xda\x1e\xca\x34\x84\x40\x5e\xd9\xeb\x5e\xdf\xa2\x18\xbd\x53\x7f\x63\xba\x50\x93\x7e\x28\xed\x5e\x1d\x08\xb9\x7a\xc2\xbe\x4f\xe2\xaf\xdd\xe0\x69\xe8\x79\x19\x3f\xd1\xf6\x3f\x19\x7a\x13\xb0\xad\xd1\xc6\x25\xfa\xf6\x63\xf5\x61\xc0\x21\x7d\x1e\x7c\xe5\x13\xce\x35\x81\x96\x5b\x34\x20\xcb\xff\x4e\x80\xe5\x51\xe1\xac\x08\xe4\x38\x9b\x58\xd5\x29\xbc\x30\xff\x5e\x33\x8d\x39\xce\xa6\x86\xd0\xc7\xae\xb5\xda\x03\x8e\xfa\x8e\xa3\x96\xe7\xcf\x61\x45\xc4\x8a\x50\x7e\xf6\xc6\x30\x31\xde\xc2\xc0\x4a\x51\xab\x55\x70\x1b\x1e\xa0\x22\x07\x89\x9f\xed\xf8\x54\x1e\x7b\xfc\xe1\x71\x51\x46\xf6\x9e\x8c\x7d\x76\x9c\x8d\x88\x0d\x30\x34\xde\xc0\xe3\x8d\x94\x97\x56\xa4\x50\xad\xf9\xed\xd7\x19\x61\xb1\x35\xf3\xef\xb4\xda\x0a\x43\x95\x27\xa3\xea\xbb\xb8\xf2\xd2\x2a\x77\x1b\xcf\x05\x6c\x8f\x06\xf4\xce\x25\xce\x67\x3b\xd7\x10\x16\x1f\x79\x5f\xe7\x69\xc7\x1e\xb2\x52\x75\x4d\x9e\x17\xf6\xc2\xae\x81\x4d\x0a\x2c\x14\x2d\x11\xb4\xad\x4a\x87\x94\xd7\x69\x65\xb2\xc7\x4f\x9a\xe5\xbe\xb1\x9a\x4a\x08\xe8\x0a\x5a\xe5\x16\x8d\x61\x2b\x74\x45\xb0\x59\xe9\xab\x08\x3f\x09\xbb\xce\xb3\x38\xf0\x76\xe0\xc9\xda\x24\x01\x7e\xf6\x45\x43\x03\x57\x97\xaf\xa1\xab\xc6\x07\x76\xe3\xe0\x62\xe2\x09\xd0\x8a\x51\x92\x2d\x14\x5d\xa3\xb9\xd4\xe7\xce\x95\x47\xcf\xb8\xba\xb7\x9b\x06\xd0\x58\xd6\x5e\xe7\x54\x12\x9e\x44\xd9\xb9\xff\xd9\xd5\x23\x0a\x22\x42\x55\x3a\x79\xb3\xd3\xdd\x1c\xfa\x05\x7a\x91\x53\xa7\x7f\x11\x35\xd5\xdb\x5f\x1a\xbb\x5e\x58\xf4\xbb\x9f\x4a\xad\xc7\x6f\x7b\xd3\xa4\x7a\xdb\x5a\x76\xbf\xe2\x46\xc3\x9b\xb7\x82\x0f\x5b\xde\x08\x89\x57\x92\xe3\xe7\x10\x0f\x8f\xbc\x8b\xa8\x30\x62\xe7\x7c\xfd\x39\x94\x41\xfb\xe1\x51\x32\x6f\xd0\x37\x18\x95\x65\x4f\xaa\x5a\x07\x29\x4c\x3f\xb1\x48\x4e\x73\xc8\xd3\x03\xfe\x77\x20\x46\xef\xb7\x4f\x93\x88\xac\xc8\x38\x86\x9b\x7c\x8d\xd2\x56\xf0\x13\x11\x0f\x22\xba\x7e\xa5\xaf\x76\x26\xe3\x1b\xe6\x55\xd5\x03\x32\x7f\xec\xf1\x0c\x81\xec\xcf\xe7\xf4\xc9\xcc\x1e\x7e\xc7\xd3\x2b\x54\xf7\xda\xb2\x67\xbb\xb5\xbd\xac\xae\xf3\xd1\xda\x5b\xd6\xe4\xc9\x31\x3e\x7a\x93\xb3\xd9\x99\x75\x86\x10\xa0\x65\x12\x48\x45\xb3\x4f\x6a\xfd\xd4\x61\x8d\xdd\xc0\x64\xb5\x95\x63\x4d\xd7\x7e\xe4\xc0\x0c\x30\x30\xd8\x30\x4d\x1b\xf4\x97\xf3\xde\xa8\x85\xeb\x75\x38\x17\x7f\xdd\x33\xe3\x97\xa0\xc9\x93\x10\x8f\x64\x4f\xc9\x74\x7e\xcb\x84\x84\xc7\x08\x88\x09\xe9\x72\xcd\x4c\x18\x5a\xba\xa7\x86\x53\xd0\x68\xc7\x1a\xb4\xfd\xd8\x22\xf0\x9e\xba\xf8\xd0\xcd\x38\x77\x4f\x13\xde\x08\x63\x51\xa2\xbb\x2c\x51\x11\xcc\x65\x11\xb2\x62\x32\x44\xec\x21\x3f\xca\xad\xd4\x28\x57\x94\xed\x5f\x2c\xe0\xc5\xa9\xf0\x28\x65\x95\x63\x8d\x64\x49\xbb\x9d\x3d\x10\x36\x0d\x22\x95\xf4\xbc\xf3\xe1\x4e\x8f\xfd\x5b\x8b\xff\x1e\x00\x94\x5f\x97\x93\x73\x34\x00\x00")
 
 // web/js/hprose-html5.min.js file
 var jshproseHtml5MinJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x9c\xb9\x69\x93\xa3\x4a\xb2\x26\xfc\x57\xba\x8f\xdd\x5b\x96\x79\x51\x25\xfb\x76\xaa\x94\xc7\x40\x48\x48\xec\xbb\x40\x65\x67\xae\xb1\x83\x40\xec\x8b\xa0\xab\xef\x6f\x7f\x4d\x99\xb5\x9d\x9e\x1e\x7b\x6d\x26\x3f\x88\x20\xc2\xfd\x71\x0f\x8f\x27\x3c\x3c\xc8\x78\xf2\xcb\xa7\x64\xac\xc2\x21\xaf\xab\xa7\xea\xf9\x1f\xbf\x8d\x7d\xfc\xb7\x7e\xe8\xf2\x70\xf8\xed\xd3\xf7\x81\xbf\x75\x8f\xa1\xc9\xef\xfe\xd6\x6d\xbf\xfc\xf9\xa9\x8b\x87\xb1\xab\xfe\xd6\x7d\xa9\x3e\xc2\x7f\x6e\xa7\x3a\x8f\xfe\x06\x6d\xba\x7f\xfe\x90\x1e\x9f\xaa\x4d\xf7\xfc\x8f\x6f\x62\xc9\x53\xf5\x05\xfa\x13\xe8\xbe\x40\x7f\x6e\xaa\x2f\xf0\xa3\x05\xff\xf9\xfc\x53\x7a\x78\x97\x7e\xa0\x8f\x9b\xe1\x3b\xf8\x43\x67\xbb\x7d\x28\x7d\xf8\xf0\xd0\x7a\xb4\xe1\x3f\xff\x80\x7e\x7f\x1a\xb7\xd0\xeb\xa3\x67\x33\x6c\xa1\xd7\x47\xe7\x66\xfc\xf0\xe1\xef\xc3\x1f\x1f\xe1\xdf\xff\x3e\x7e\xf8\x30\xfc\x01\xff\xee\xbf\x41\x7e\x81\xff\xfc\x0c\x3d\xba\xe1\x5f\xac\x25\x7f\xb5\x96\xd4\xdd\x53\xf7\x9f\x5b\xe8\x0e\x43\xff\xf2\xb7\xa9\xfe\x7d\xf7\xb8\xed\xfe\x73\xac\x36\xc3\x56\xf6\x87\xec\x25\x29\xeb\xba\x7b\xaa\xc0\xb1\x7a\xfe\xaf\xb1\xda\x74\xdb\xee\xe3\x08\x0c\x9b\x6a\x5b\x7d\x1c\x80\xf1\x13\xf4\x5a\x7d\x7a\xae\x80\xed\x63\xe8\xe3\x76\xac\xde\xec\x7d\xaa\x5e\x31\x84\xc6\x68\x82\x44\x68\xfc\xd3\x73\xf5\xf1\x6d\x18\xf8\x3e\xfc\xef\xdd\xf9\xd4\xbd\x42\x77\x32\x79\xff\xfb\xd1\xf9\xdc\x7d\xfc\xb7\xc2\x6f\x66\x3e\x42\x77\xea\x5f\x06\x5e\xbb\x4f\xcf\x1d\xf0\xef\xf1\xdf\xe2\xfe\xa5\xda\x74\x7f\xfe\x8c\x56\xfe\x58\xf7\xef\x2b\xf2\xba\x85\xfe\xf8\x52\x6d\xa0\x3f\x7f\xff\x52\x01\x63\xb5\xf9\x38\x56\xbf\x88\x86\xbf\x8a\x7e\x81\xfe\x7c\xdd\x22\x30\x46\x62\x14\x4a\x60\xd4\x1f\xff\xf3\x3f\x6f\xd1\xba\xf9\xf7\xa7\xf7\x46\x5e\xbd\xb1\xe2\xe3\x58\x6d\x7e\x88\x91\xcf\x9b\x8f\x3f\x75\x9e\x7f\xff\x3f\x29\xfd\x1f\x35\x7e\x3a\xe3\xff\x1b\x06\x7e\xfc\xc1\xc0\x8f\xff\xc2\xc0\xfa\x2f\xd2\xd5\x8b\x1f\x6c\xbb\x4d\xf5\x12\x06\x5b\x68\x53\xbd\xa8\xdb\xee\xa5\x8c\xab\x74\xc8\x36\xd5\x4f\x9d\xf8\xd7\xe9\xbe\x84\xc1\xeb\xb6\x7a\x51\x1f\x64\x43\x70\xfc\xc3\x03\xe2\xcb\xa3\x17\x00\x7e\x89\xd0\xf4\x17\x95\x87\x91\x27\x14\x79\x7e\xb3\x00\xfd\x0a\xdd\xff\xdc\x6c\x0f\xb1\x1f\xdb\xed\x9b\x17\x0f\x43\xbf\xee\xb6\xf4\xe1\xfd\x66\xdc\x0c\xcf\xff\x28\x9f\x1e\x8d\x87\xd2\x03\x75\x33\xbc\x81\x03\xdb\xe1\xa7\x70\xf9\x5d\x78\x93\x3c\xff\xe3\xc1\x92\x87\x9d\x7c\x0b\x7d\x4a\x5e\xf3\x4f\x00\x90\x3f\x8f\x5f\x06\x20\xff\x73\x5b\x7d\xe9\x80\xfc\x17\xdf\x77\xef\x7a\xef\x7e\x0d\x9b\x64\x13\x6e\xfc\x4d\xbd\xfd\xed\xb7\xcd\xf4\xc8\x08\x0f\xa4\x64\x0b\x7d\xc2\x5f\x93\x4f\x00\x90\x3c\xff\x23\x4f\x9e\xc2\x6d\xfc\xd4\x3d\x6f\x3e\xc2\xdb\x6d\xf8\x3c\x64\x5d\x3d\xff\x6d\xdf\x75\x75\xf7\xf4\xdb\xd0\x8d\x55\xe8\x0f\x71\xf4\xb7\xbc\x6a\xc6\xe1\xb7\xe7\x4f\xd3\x97\xe4\xcf\x6d\xf8\xf9\x33\x82\xbd\xbe\x22\xd8\x3f\xf3\xe4\x69\xd8\x1e\x9e\xfe\xf1\xcf\xe7\xcd\xdf\x9d\xa7\x61\x33\x3d\xff\x15\x20\xac\xbb\x6e\x6c\x7e\x05\xf8\xee\x00\x81\xbd\x26\x9f\x12\x60\x4b\xfd\x5f\xbb\x10\x6e\xc3\x97\xa1\x36\x87\x2e\xaf\xd2\x27\x98\x78\xde\x3c\x94\xbe\x85\xfc\xc3\x87\xa7\x70\xfb\x1b\xf4\x1b\x10\x3e\x6f\xea\x6d\x08\xfc\xf6\x1b\x50\xff\x13\xfc\x5f\x10\xf0\x1f\x5f\xff\x57\x02\xfc\x07\x98\xbf\x0c\x71\x3f\x3c\xd5\xcf\x7f\x54\x2f\xf2\x76\xa8\x7e\x7f\xf2\xb7\x8d\xdf\xf5\xf1\xa9\x1a\x9e\xea\xcd\x03\xed\x31\xe0\xff\xb2\xef\xff\x18\xaa\xdf\xf3\x27\xff\xf9\x31\x62\x6e\xe5\xa7\x61\xf3\xbe\x74\xf2\x2f\xbc\x5c\xff\x85\x97\xde\x76\x7a\x8b\xc9\x63\x31\xea\xa7\x7f\xfc\x73\xd3\x3d\xb4\xbd\xe7\x5f\xc9\xd3\xfc\x65\xa1\xb6\xd5\xcb\xf2\xb1\xfb\x08\xbf\xc5\x07\x7a\x1d\x3e\x7c\x78\x1a\x80\x6d\xf5\x12\x3e\x7f\x82\xfe\xbe\x1d\x3f\x7d\xfc\x38\x3e\x0f\x0f\xea\x86\x8f\x91\x2d\xf4\xc0\xbb\x7f\xa9\x5e\x16\x00\xf8\x73\xfb\x68\x0e\x00\xf0\xe7\xa6\x7a\x59\xbe\xc9\x28\x4f\xd5\x2f\xfe\xdd\x9f\xaa\x87\xa5\xa7\x6a\x2c\xcb\xed\x43\xfc\xeb\xd7\xea\x25\xfc\xfb\x76\x7c\xfe\xf0\xe1\xa9\x7a\xb9\x6f\xbb\xa7\xf1\x6d\x82\xe1\xf6\x31\xb5\xe5\x6d\x3b\xcd\x5b\xe8\x27\x82\xf2\x2b\xd5\x97\x8f\xd5\xcb\xfc\xa9\xfb\xf0\xe1\x29\x7d\xaa\x5e\xac\x87\x2b\x0f\xf9\xf7\x59\x7e\xf7\xe0\xe9\x0d\xe7\xf9\x0d\xa8\x7a\x59\x7e\xf1\x26\xfa\x25\xb3\xff\x98\xf7\xb7\xd8\x41\xaf\xe3\x87\x0f\x4f\xe3\xfb\xd4\xdf\xe6\x38\xfe\x42\x6e\xe1\x5d\xf3\x97\xa9\x77\xff\xc7\x39\x4b\x0f\x8f\x1f\x5d\x9b\xea\xc5\xda\x3e\x66\xfe\x73\xec\xfa\xeb\x06\xff\xb8\x45\x36\xd8\x6b\xf5\x47\xf5\x3b\xfa\x53\x82\xfd\x45\xe2\x31\x08\xfd\x0e\x43\x0f\x99\x8f\xe8\xef\xd5\x47\xe2\xa7\x5c\xf0\x2f\x2b\x9f\xbd\x79\x14\x04\x6f\x06\x37\xd5\x8b\xb3\x85\x7f\x5d\xf4\xe2\x01\x9b\x27\x4f\x7f\xaf\x5e\x9c\xbf\xf2\x3c\xf0\xa3\xbf\xf5\x83\x3f\xc4\xbf\x3d\x7f\xca\x93\xa7\x07\xc6\x5f\x05\x94\xfa\x6f\x71\x15\xd6\x51\x5e\xa5\xbf\x3d\x7f\x0f\x57\xf6\x3e\x3f\xe7\xa7\x85\xec\xe7\x42\xd9\x4f\xd5\x4b\xf6\x86\xf6\xd8\x57\xdd\xff\xdf\xce\xac\x5e\xfe\x63\x3b\x54\x9b\xea\xe5\xb2\xad\x5e\xb2\x97\x68\xf3\xd4\x7d\xfd\x3a\x3c\x30\x5e\xec\x4d\x52\x3d\xbf\x6e\xa1\x0f\x1f\xde\xdf\xa3\xcd\x5b\xef\xa3\xeb\xc1\x20\xe5\xad\x33\x78\xde\x48\xdf\x1b\x8f\x87\xff\x22\xfe\x8c\x02\xf4\xcb\xca\xc8\x3f\xf3\xe0\xcf\x24\xfb\x22\x6e\xbb\x37\xfd\x37\xed\xe0\xc5\xda\x8e\x1b\xe6\x7d\x76\xc9\x1b\x1d\xcb\xb7\x5f\xfd\xed\xd7\x78\xfb\xfd\xef\xb7\x5f\x7b\x3b\x6c\xaa\x97\x68\x9b\x3c\x5c\xe7\xb7\xd0\x26\x78\x6c\xb8\x5f\x99\x60\xff\x08\xc9\x26\x79\x24\xc3\x4d\xbc\x99\x1e\x51\x99\xb6\xe1\x53\xf5\x12\x3d\x7f\xa8\x5e\xb4\x8d\xfe\x54\xbd\xf8\x9b\xea\x65\xd8\x3c\x55\x2f\xc9\xe7\xcf\xd8\x33\x30\x3d\xbf\xad\xd5\xf7\x91\xfd\xc3\x95\xe7\x67\x7f\x0b\xfd\x10\xee\xde\xba\xfe\xf8\x21\x32\xfe\xcb\x7b\xff\xed\x3d\xd9\x56\x2f\xff\xfd\xe6\x71\xf5\x62\x3c\xff\xfe\x78\x35\xde\xa6\x51\xbd\xe8\xef\xaf\xfa\xdb\xdc\xaa\x97\xf2\x6d\xa6\xc9\xf3\xef\xdf\x21\xea\x5f\xfd\xf9\xfa\xf5\xf1\xb2\x25\x5f\xab\x97\xe4\x0f\xfa\x77\x18\xde\xf8\x5b\xf8\x79\xe3\x7f\xfd\xfa\xe4\x6f\xdb\xa7\xea\xe5\x11\x03\x7f\x33\x3d\x03\xc8\xe6\xa7\x20\xf5\x3b\x0c\x3f\x7f\x8a\xcb\x3e\xfe\xdb\x1b\xb3\xfe\xfb\x57\xf3\x3f\xec\xfa\x5b\x04\x78\x40\x70\xdf\x20\x7e\x01\x20\x7f\x87\xa1\x4d\xbc\x35\x9f\xaa\x97\xe2\xcb\xf5\xc9\x7f\x7e\x64\x1a\xff\x79\x13\xbf\x6e\xb1\xb7\x10\xd5\xdb\xa7\xf8\xf5\x15\x7e\xfe\x08\xbf\xb9\xff\x84\x7c\x85\x3f\xc4\xcf\x9f\x3f\xd7\x1b\x18\x7b\x8d\x9f\xab\x97\x12\xd8\xba\x4f\xd5\x8b\xf0\x18\xfe\x18\xbf\x89\xf9\x9b\xfa\x57\xa7\x4a\x60\x6b\xbd\x4d\xb9\xfe\x88\x3d\x7f\xfe\x8c\x6d\xde\xba\xbc\xa7\xea\xa5\x7d\x37\x06\xbd\x56\x2f\xe5\xf3\x3b\x61\x1e\x7c\xae\x5e\xca\x3f\xe0\xdf\x3f\xc2\xff\x7c\xc3\x78\x98\x8d\x1f\x8b\x3a\x3c\xe5\x0f\xb4\x87\xfb\xd1\x83\x9f\x8f\x4c\x57\x3e\x12\xc4\xed\x87\xee\xa7\xe6\x41\xb3\xcd\xdb\xa4\xdf\xe4\xb6\xe3\x83\x07\x9b\xef\x59\x9e\xdf\x46\x6f\x02\xd0\xf3\x3b\x76\xb7\xe5\x9e\xaa\x97\xeb\xe6\x9d\x2d\x0f\x81\x77\xa9\xf7\xe0\xec\x9f\xba\x37\x07\x7f\x37\xde\x1b\x9b\xe8\x3b\xfa\xf3\xf3\x46\xf8\xd6\xe6\xdf\xc3\xc9\x3e\x96\xef\x57\x93\x61\xf5\x63\x2b\xff\x92\x6c\x0f\x0f\xc2\x56\x2f\xc1\xf6\x41\xe4\x17\xff\xfd\x31\x6c\xbb\x27\x98\x7e\x2b\x43\xf6\x8f\xe6\x5b\xab\xfb\xd1\x1a\x7f\xb4\xfa\x1f\xad\xfa\xa7\x4a\xb1\xed\x9e\xb0\x47\x43\x78\xf4\xc1\x6f\xcd\x76\x7b\x7c\x6c\x95\xb7\x36\xb7\xbd\xbd\x9d\x58\xd5\x4b\xf5\xa3\x75\xdd\xfe\xe3\x9f\x9f\xbe\x97\x1e\xe3\x16\xfa\x84\xbd\x8e\x9f\x00\x60\x7c\x7e\xd0\x60\xfc\xf3\x5d\x9b\xf8\x31\x81\x5f\xd2\x1c\xf3\x6d\x02\x8f\x33\xe4\x6d\x37\x3f\x4e\x94\xd3\x53\xf5\x32\x3c\xbf\x3d\xea\xf7\xc7\xfe\xfd\xd1\xbd\x3f\xc6\xf7\x47\xff\xfe\x10\x9e\x37\x97\x47\xd4\x9f\x7f\x78\xd0\xbd\x79\xd0\x7d\x02\x80\xee\xf9\xf4\x46\xc5\xee\xcf\x97\xf5\xf9\xd3\xfc\x20\xed\xf3\xe6\xf1\xa8\xde\x75\xdb\x97\xf5\x79\x23\x3e\xd8\xf4\x4b\x16\x70\xfe\x72\x9f\xd8\x24\x9b\xfc\xbd\x34\x7a\x90\x06\x7f\xfd\x5e\xb3\x3d\x7f\x5f\x8d\x37\xb3\xf5\xf6\x51\x24\xbe\x95\xa3\xc9\xb6\xfe\x4f\x7a\xe3\x6f\xff\xe7\x7f\x9e\x6a\x90\x7e\xde\xe4\x5b\xff\x3f\xf1\x4d\xf8\x78\xf7\x41\xfc\x79\x33\x6e\xa1\xc7\x3d\xe7\x13\xf6\x3a\x7c\x02\x80\xe1\x79\x04\xb6\x4f\xef\xca\x30\x30\xfc\xf9\xfc\xf9\x33\xf5\x5f\x3f\xae\x4c\xe3\x2b\xfd\xed\xef\xeb\xd7\xbf\x9f\x9f\xaa\x77\x67\x9e\xff\x80\x7e\xe7\xdf\x0e\xea\x9f\x4e\xf3\x7f\x39\x5e\xa0\xd7\xee\x71\xaf\xaa\x5e\x98\xbf\x6f\xbb\xb7\x13\x96\x79\x3b\x6e\x6e\xdb\x1f\xf5\x77\xf5\xc2\x6c\xe0\xe7\xcd\xfd\x8d\x77\xbf\xf4\xde\x36\x18\x44\x13\xcf\xcf\xcf\x9b\x5f\xef\x58\xe7\x9f\x99\x38\x4f\x9e\xba\x57\xea\xeb\xd7\xf1\x15\xfb\xfa\x75\x78\xc5\x7e\x06\x42\x7b\x23\xff\xb8\xe9\x9e\x3f\x3d\x82\x97\x6c\xe1\xcf\x9f\x7f\x4c\x45\x7d\xcb\x18\xc9\xf3\x46\x7d\xcb\x3e\x6f\xe4\xd6\xb6\xc9\x47\x78\x03\xff\x34\xa3\xbe\xcf\xe2\xed\xae\xd3\xbd\x56\x2f\xf1\x27\x00\xa8\x5e\x1e\x79\xe1\xf4\xa5\x7a\x89\xbf\x31\x09\x7d\xe8\x1e\xff\xd2\xf1\x13\xa2\xfd\x5e\x2d\x3d\x0e\x4f\xfd\x6d\xa7\x29\x1b\xe8\xf9\xbb\x97\x8f\xd4\x74\xfa\x32\xfe\xf9\xdd\xc9\x61\x4b\x7d\xf7\x70\x00\xb6\xdf\xe5\xe1\xe7\x3f\x28\xe0\x21\x2a\x6d\xba\xe7\xdf\x1f\x8d\xe3\xbb\xce\x4f\x3b\xb7\xbf\x94\xfe\xca\xb6\x7b\x7a\xdb\x3e\xa7\xc7\xae\x79\x2b\x0e\x8f\x3f\x5a\xd2\xbb\x97\xd4\xa3\x1d\xff\xf5\x62\x30\x3f\x50\x1e\x4c\x54\xfe\x4a\xdf\xea\x25\xfe\x85\xc0\xa7\x77\x02\xbf\x51\xf6\xf8\x8d\xcc\x8f\xb6\xf4\xb2\xfe\xe2\x91\xf6\xa0\xc4\x63\x85\xde\x82\xbf\xc9\xdf\xaa\x83\x6f\x75\xdc\xe1\x91\xdd\xd2\xbf\x6f\x87\xc7\x93\x7d\xd4\x73\x0f\x6b\xd5\x0b\xfb\x56\xc6\xb9\xdb\x27\xf8\xf3\xe7\xf1\x3d\x2b\xa7\xdb\x61\x93\x3f\x96\xae\x7a\x49\x81\xea\x85\xdd\x54\x2f\x87\x6d\xf7\x94\x3f\x6f\x1e\xd5\x78\xfe\xed\x3a\x50\xbd\x1c\x1e\x05\xfe\xf2\xc8\x00\x3f\x5d\xe0\xbe\x07\xff\x47\x60\x0e\x5f\x9e\x9e\xba\x0f\xd5\x8b\xfb\xfc\x06\xf8\x0c\x3c\xbd\x91\x7d\x7c\x7e\x7d\x7d\xa5\x3e\x3e\x7a\x7e\xa9\xda\x2e\x3f\xcf\xdf\xf1\x2d\x1c\xe3\xaf\x7e\x3c\xe2\x32\xfe\x12\x95\xc3\x23\x12\xd3\x2f\xd6\xf7\xbf\x96\x8b\xf0\xa7\xa8\xfe\xdb\xb8\x1d\x3f\x7f\x86\xbf\xbe\x2f\xeb\xb4\x19\x9f\x3f\xcd\x59\x5e\xc6\x4f\x08\x4e\xbc\x8e\x3f\xf2\xd1\xf8\xe3\x96\xf2\x03\xca\xf8\xd7\xcb\x51\xfe\x0e\x98\x27\x4f\xc9\x76\x7c\x7d\x25\x3f\xc0\x9b\xf1\xf3\xe7\x2d\xbc\x19\xb6\xdf\xd1\x9f\x60\x20\xf9\xfc\x99\x7a\x06\xf2\xc7\xa6\xcf\x1f\x86\x87\x4d\xf2\xf7\xed\xf0\x8d\xd0\x0f\xa3\xf9\xa7\xe7\x6f\x43\xdf\xb5\xf2\xe7\x4f\x41\x17\xfb\xc5\x3f\x7f\x7a\x96\xff\xf0\x2c\xff\xdf\x3d\x5b\xfe\xc2\xbb\x69\xdb\x3d\x91\x04\xf5\x97\xfb\xc2\xf1\xbd\x86\xff\x21\xb3\x7b\x5b\xe3\xf5\x41\xc7\xc7\x22\xff\x2a\x6a\xfe\x25\xd5\x6d\xe1\x6f\x41\xaf\x5e\x76\xbf\x5c\x26\xb6\x4f\xc3\xe7\xcf\xf0\x33\xf0\xee\xf1\xba\x19\x7e\xb8\x37\x7c\x7c\x7a\x5b\x9f\xdd\x2f\x8b\xe0\xfd\x4b\xf6\xdc\xc2\x9b\xb7\xcb\x68\xdd\x3d\x6e\x23\x9f\xaa\x97\xdd\xf7\xbc\xb7\xfd\x0e\x98\x3c\x6f\x92\xb7\x58\x26\xc0\x76\xdc\xe4\x5f\x1f\x8b\xf6\x23\x6f\xe4\x3f\xa1\xdd\x9f\x49\xe8\x1b\xc5\x37\xe1\xf6\x51\xd9\xbc\xc3\x3f\xcc\x0c\xdf\xee\xbc\xc9\x56\x7f\x1a\x37\xd5\xa6\x7b\xdc\xef\xc2\x37\xf0\x10\xd8\x26\x1b\xff\xeb\x36\xf9\xfc\x39\xff\x0e\xee\xff\x04\xd7\xff\x65\xc5\xb7\xdd\x97\xf1\xc7\x77\xb1\x61\xfb\x54\xbd\xe4\xaf\xaf\xaf\x30\xfc\xfc\x5f\xc9\xe6\xe9\x97\x4f\x14\xff\x6b\x78\x7e\xfd\xcb\x7b\xf5\xd2\x3c\xff\xf1\x10\xdf\x0e\x9b\x07\xc6\x36\x01\x9e\x10\x08\xa3\x3e\x26\xaf\xaf\xaf\xf8\xf3\xe7\xcf\x30\xf1\xfa\x0a\x13\x9b\x8f\x30\x41\x92\x24\x02\x13\x1f\xaa\x97\xfc\xad\x5e\x6b\xb6\xd5\x4b\xf3\xf9\x33\xf5\x35\x7e\xaa\x5e\xc4\x47\xb6\xc8\x3f\x7f\xde\x52\xcf\x1b\xe8\xf9\x91\xcb\xf3\x8f\x6f\x05\x6c\xf3\xf1\x07\xf0\xc7\xa7\xff\x77\xcc\x5f\xb3\xbb\xf5\xaf\x6b\x06\x7d\x23\x42\xf7\x93\x06\xef\xf3\x7f\x23\xfc\xc3\x87\xf7\x57\x14\x7e\x77\xa8\x7a\xc9\x3f\x0c\x1f\xe1\x4d\xf2\x88\x2e\xfc\x15\xfe\x38\xfc\x5f\xb8\xf2\x3d\xc8\xc9\x4f\x87\xc4\xf7\xb2\xa0\x79\x2b\x0a\xf2\xed\xb7\xdb\xee\xf7\xe4\x88\x7f\x4b\x02\xff\x1b\xe4\x4f\x80\xd3\x03\xe0\xa7\x4e\xf5\xed\xd0\x7e\x5c\x1f\x5f\xb7\xd0\xa7\x8f\x1f\xbb\xe7\xea\x4b\xf7\xe7\x16\x86\x7e\xdd\x5b\xff\xfd\x17\xad\xcd\xb7\x58\x3c\xf8\xbb\x09\x7f\x60\x6c\xfc\xed\x97\x3f\x37\xf5\xf6\xcb\x9f\x9f\xc2\xf7\x64\xb8\x79\x30\xee\xed\x5c\x7c\xab\x02\xaa\x2f\xc9\x9f\x1b\x18\xa1\x3e\x74\xcf\x79\xf2\x28\xab\xb6\xdb\x27\x04\xc1\x3e\x74\xef\xb7\x84\x04\x80\x5f\x7f\x80\x7d\x3f\x96\xaa\x47\xb2\x1e\xbf\xa9\x03\xc0\x3b\xc0\xdf\xb7\x0f\xed\x0f\xe3\xf3\x4f\xa9\xfa\x4b\xfe\xe7\xf6\x09\x85\x3f\x74\xcf\x9f\x3f\x13\x5f\x09\xf4\xc3\xf8\x56\x7a\x3e\x80\x11\x04\xfb\xfb\xf6\x09\xc1\xa0\x87\xa5\x5f\x71\x13\x00\xf9\x7f\xb7\xf8\xf6\xe1\xe6\xdf\x4a\x0d\xff\x9b\x5f\x30\xfe\xe6\x17\x8c\x7c\x7d\x7a\x78\xf6\xdd\xc5\xe1\x87\x8b\x7f\xef\xfe\x45\xa5\xfb\x27\x81\xe3\x28\xbe\xdd\xe6\x1f\x3e\x3c\xf9\x2f\xcd\xd8\x67\x4f\xef\x1f\x6c\x5e\x92\xae\xbe\xed\x32\xbf\xdb\xd5\x51\xfc\xe2\x37\x4d\xb9\x7c\x1b\xd9\xd4\xcf\x8f\x04\xfb\x11\x7e\xfe\xe7\xf7\x1c\xf1\x0a\x7d\xf8\xf0\x54\x7f\xff\x9a\x96\x6f\xfe\x2f\x90\x9e\x37\xfe\xcb\xb5\xce\xab\xa7\xdf\x7e\xfb\x85\x3f\xff\xf1\x97\xaf\xa4\x04\xf6\xe1\x03\x0d\xbf\xdd\xea\x09\xfc\xf7\xea\x95\x26\x3e\x7c\x80\x11\xf4\xad\x83\x84\x7f\xaf\x5e\x31\xf2\xc3\x07\x9c\x7a\xbc\x03\xd8\xef\x18\xba\xdd\x6e\xab\x3f\x08\xe4\x77\x8c\x7c\x6f\xa1\xbf\xff\x52\xb3\x57\xd5\x53\xf7\x93\x65\xef\x1c\xfb\xf1\x39\x32\xdf\xa2\xff\xf5\x20\xc8\xeb\x2b\xf2\xa0\x5c\x3c\xff\x8d\xe9\x3a\x7f\x79\x9c\xbe\x8f\xeb\x64\xbd\x7d\x5c\xaf\xa0\x4f\xc9\x6b\xfc\x29\x06\x80\xe7\xb7\xc5\x41\x3f\xc4\x8f\xbc\xf6\x1f\x4f\xdd\x4b\xf8\x6d\x96\xcc\xf0\x14\x3f\x3f\x56\x82\xfa\x48\xfc\xd7\xb0\x79\x38\x34\x7c\xfd\x9a\x7c\x8c\xb7\xdb\x2d\xfc\x6e\xfc\x51\xa6\xa3\xaf\xe3\x87\x0f\xf9\x6b\xfd\x69\x04\x80\x4d\x0d\x00\xcf\xe1\x97\xfa\xcf\xad\xff\xfa\xfa\xfa\xf4\x48\x28\xaf\xe3\x07\x04\x7b\xfe\x80\xe0\xf8\x27\x7f\x0b\x7d\x0f\x76\xf8\x73\x2a\xdd\xdb\xff\x17\xaa\x6d\xf5\x78\x7e\x7a\xdf\x6a\xdf\x6e\x02\xdd\x4b\xb4\x5d\xdf\xef\xd7\x9f\x8a\xc7\xdb\x8b\xf9\xfc\xe9\xc7\x56\xff\xef\xa7\xfe\xad\xcf\x7b\x7e\xfe\xe7\x5b\x14\xaa\xed\x8f\xef\x68\xc4\x66\xa8\xb6\x5f\x7e\x7e\x56\x7b\xfb\x28\xbd\x49\xaa\xed\x17\x68\x03\xfd\xb9\x09\xab\xed\x17\x78\x03\xfd\xfc\x97\x45\xf5\xcf\xa7\x21\xcb\xfb\xe7\xa7\xdf\x5c\x9d\x61\x18\x9d\x0b\x6d\x66\xcf\x7c\xfb\x23\xf9\x7d\x7b\x13\xb0\x7a\xe4\xba\x8c\x22\x25\xc1\xc0\x43\x1f\x1d\xd3\xcc\x2e\x7c\xdc\x0d\xa0\x25\x59\x7c\x37\x38\xf8\x74\x0b\x1c\x0e\xea\xdc\x9c\x96\x78\x21\x19\x05\x8d\x42\x83\x84\xbd\xf5\x00\x46\x41\x8f\x88\xa5\x79\x01\x0e\xf0\x80\x39\xbd\x36\x1e\x2f\xeb\x02\x5c\x8b\xa3\x1a\x37\xb2\x2f\xd2\xd3\xd2\xd6\x87\x7c\xe7\xe9\xc0\xd2\x14\x97\xcb\x8d\x2f\x38\x0c\x02\x57\x4f\xf1\x98\x33\x4b\x29\x79\x06\x4d\x27\x19\xb6\x50\xc2\x2f\xbd\xc1\x48\x5b\x3b\x25\xd7\xc3\x9c\xa8\x48\xa3\x0b\x97\x6c\x04\x81\x11\xda\x73\x62\x98\x02\x88\x62\x78\x65\x7b\x47\xc6\x15\x53\xb4\x12\x59\x1c\xf9\x82\x60\xd7\xe6\x38\x25\xe4\x2c\xde\x95\xf8\x5e\xef\x85\x16\x8a\x74\xfb\xda\x9b\x10\xe9\xf1\x10\x2a\x10\x22\x90\x58\xf3\x6a\x53\x77\x2a\x3f\x58\xc6\x0a\x13\xec\x3c\xcd\xc7\xab\xb9\xa0\xd0\xd4\x86\x70\x07\xae\x27\x63\x87\x13\x05\x85\x8d\x68\x24\x37\x87\x74\xca\xd0\x91\x91\x95\x74\x55\xb4\xf8\x4a\x39\x40\x72\xca\x67\xeb\xc6\x0a\x8b\xb6\x78\x53\x5f\xaf\xc4\xa4\x83\xfa\x55\x30\x8a\x13\x69\xd7\x07\x85\xd0\x8e\x44\x08\xd0\x38\xcf\x00\x24\x08\x8d\xf6\x4c\x55\xee\x52\xe8\xf6\x9a\x67\x87\x13\x75\xc9\x00\x64\x77\x95\x71\xf2\xd0\x98\x6c\xe2\x74\x2e\x65\xd4\x85\x70\x87\x0b\x0c\xa7\x75\x7b\x26\x48\x9e\x5d\x7c\x00\xab\x57\xfe\x76\x65\x71\xee\xe4\xd3\x71\x63\xa2\x25\xdf\x4a\xd8\xc2\xf5\x9c\x02\x12\x7a\x34\x36\xf7\xd2\x97\xa5\x96\xb4\xce\x8a\x2d\xab\x28\x3c\x9b\x87\x1d\x21\xe2\x61\xee\x1a\x74\x2f\xf8\x15\x7c\x22\xc8\xa0\x59\x7b\x18\x6f\xb0\xf0\x5e\xd6\x20\xd1\xec\xe2\xc9\x1e\x12\x29\xbe\xda\xe1\x69\x97\x42\x47\x13\xd9\xcf\x48\x58\xef\xef\x19\x95\x63\x9c\xe6\xde\xc8\xab\x6c\xa5\x81\x72\xb1\x2c\x4a\x41\xc2\xeb\x21\x18\x85\xfb\x70\x61\x6e\xaa\x67\xaf\xa8\x95\x34\x41\x68\x12\x45\x3a\xec\x71\xb3\x18\x54\xf1\xc6\xfb\x17\xa0\x63\x33\x86\xe7\xe7\xc4\xed\x5c\x15\x35\x55\xd0\x09\xa7\xa3\xc6\x7b\xf5\x61\x55\xba\x8c\xbb\x36\x07\xc2\xf0\x56\xae\x5b\xf2\x96\x0a\x58\xd9\x01\x39\x20\x34\x2c\xd4\xdd\x59\x13\xb4\x43\x14\x6e\xba\xc7\x8e\x63\x53\xa0\xd0\xca\xa1\x4a\x92\xf9\x08\xea\xd9\x14\x01\xfa\x1e\x3b\x96\x77\x86\x2a\x2e\x5c\xb5\xe7\x31\x40\x33\xef\xc7\x21\xd0\xfb\x70\xa1\x9a\x39\x38\x85\x72\x72\xc2\xbb\xe6\x50\xdd\x0e\x59\x21\xec\x71\x1e\x42\x22\xd7\xbe\xde\x34\xe2\x76\x3e\xe6\xbb\xc3\x09\x32\x01\x4b\x56\x90\x5c\xbb\xef\x21\x80\x01\xa9\xdb\x04\xc0\xee\x3e\xf4\x8c\x5b\x8a\x35\x6c\x9c\x47\xa5\xec\xa4\xa9\x10\x6b\x26\x6f\xfb\xf6\x2e\x8b\x03\x4c\x53\x7b\xd2\xb1\xef\xc7\x12\x8c\x49\xc8\x00\x12\x79\xbe\x86\x92\x98\xc9\x43\x75\x68\x03\x05\x58\x44\xe0\x1c\x88\xa7\x2b\x82\x07\xc8\x74\x41\x58\x99\xa9\x92\x82\x76\x39\x0a\xcf\x79\x5f\x57\x4d\x29\xe8\x55\x1f\x2f\x69\x90\x1f\x51\x36\x6f\x08\x4a\x5d\xae\x0c\x62\x46\x09\xb1\x8b\x54\x3e\x41\x68\x9d\x1b\x41\x40\xeb\x57\x7b\xf5\xbb\xd0\x0b\x76\xb1\xa3\xc6\x60\xd7\x78\x27\xbc\x18\xa9\x3b\x73\xd1\x0e\x3c\xce\x72\x3e\x8e\x45\x55\x87\x82\xa5\x2c\xf9\x11\x8a\x6b\x82\x4a\xea\x25\x95\x8d\x9d\xec\x57\x9e\x9a\x6b\xac\x66\xfb\xec\x5a\x5a\x93\x03\xc7\x3d\x5d\x71\xac\xca\x68\x66\x1a\xcf\xc9\x10\x4d\xd8\x91\x23\xf5\x60\xb5\x68\x57\x40\x93\x31\x3f\x30\xe7\xaa\x45\x26\x09\x6a\x8d\xfd\x25\x2c\x97\x26\xc7\x96\x43\x19\xe8\x31\x44\x9c\x58\x68\xea\x45\x87\xb9\xa7\x6d\xe9\xce\xfe\x38\x58\xb7\x32\x97\xb5\xf2\x04\x98\xbb\xde\x73\x8d\x29\xa0\xe1\x2b\x74\x5b\x68\x2c\x38\xf2\x32\x2d\x09\x19\x6c\x9e\xce\xfe\x09\x09\xe0\xea\x36\x8d\x9a\x71\x3d\x69\xae\x41\xb5\xa2\x5c\xdd\x13\xb5\xde\x39\xc0\x9a\xc2\x89\x50\x97\xe9\x92\x66\xe0\xb0\x17\x10\xab\x3b\x15\x81\x4c\x73\xc7\xf2\xae\xb5\xb8\x4f\xef\x4e\x81\x27\x9a\xb1\x85\x6b\x24\x98\xba\x75\x03\x23\x73\x28\x22\x3d\x1f\x89\x74\xac\x25\xc7\xbb\x3a\x39\xba\x33\x73\xda\x5a\x9d\x86\x75\x58\x32\xef\xda\x8e\x23\x93\xdd\x3b\x34\x2c\x84\xbd\x48\x02\x2b\xc4\x51\x77\x29\xbe\x5f\xe8\xa2\xc2\xc0\x73\x21\x36\xe9\x9c\x9c\x90\x98\x18\x1f\x39\xc3\x4e\xa5\x53\x2c\xb4\xc1\x04\xf5\x79\x00\xc0\xbb\x64\xa2\x2f\x97\xab\x87\xf9\x4c\xd8\x10\x97\x02\xce\xef\xd7\x82\x77\x81\x7c\x61\x34\xf8\xde\x59\xa7\x35\x29\x01\xdc\x5b\xe6\xe1\xba\x14\x56\x59\x4c\x37\x0b\x91\x76\xb3\x84\x12\xba\x68\xe4\x02\x70\x03\x02\xb9\x9f\x09\x00\xb0\x1d\x9d\x66\x67\x51\x4b\xf8\xeb\x7e\x4d\xfa\x52\x3b\x78\x67\x3d\x6b\x52\xdd\xed\xcf\xd2\xc0\x34\xf5\x32\xda\xfb\x65\x0d\x83\x83\xe9\x80\x7c\x74\xbe\x1f\x31\x69\xbd\xce\x60\xc1\xce\xad\x96\xb6\x71\x72\x35\x02\xfe\x36\x24\xa4\x5d\xef\x5d\xf0\x90\xee\xe9\xeb\x95\x9b\xcd\x39\x13\xb1\xa9\x9a\xbc\x82\xb0\x67\x17\xa9\x85\xe6\x36\x08\x47\xdd\x84\xef\xaa\xc5\xdf\x41\xca\x8d\xa1\xde\x50\x6a\x71\x3a\x4f\xc8\x1c\x43\xd2\xa1\x34\x48\xc9\xbd\x4f\xb7\xec\x54\x4e\x90\x5d\x22\x88\x4c\x2f\xb5\x4d\x86\x05\xef\xc6\xee\xb5\xad\x25\x43\xbc\x1c\x56\x7c\xd6\xed\xda\x0a\x88\xf1\xa4\xec\x83\xe3\x31\xe9\x73\x25\xc8\x09\xe8\x4a\xd2\x69\x2e\xf1\xac\x63\x1d\x99\x21\x16\x27\x51\xdb\xdf\x5b\x21\x77\xb4\x03\xac\xe9\xb3\xea\x56\x57\xa3\x47\xfd\x5a\xb3\xa0\x51\x9e\x4f\x22\xbc\x17\xac\x8b\x3c\x79\x7b\xcf\xdf\x73\xb8\xd1\xe4\x48\x5a\x9c\x7a\xcb\xa3\x40\x93\xdf\x49\x03\xba\x82\xe6\xd4\x09\xa0\x36\x55\xd7\x3a\x0d\x09\xc2\x8e\xe6\xf0\x54\xf0\x0c\xb5\xab\x67\xf9\x28\xb4\x76\xbf\x4c\x3a\xc2\x9f\x6d\xab\x36\x66\x57\x37\xa2\x1b\x7b\xbc\xd3\x31\x2a\xf5\x47\xac\x9b\x53\x15\x52\x47\x13\x1b\x6e\x70\xc1\x71\xca\x61\x69\xc5\xc9\xc0\xe6\xba\xf2\x80\x73\xeb\x23\x96\x86\x03\x43\x5b\xbb\x21\x9d\xef\xd5\x04\x37\x26\xac\x3a\x1a\x27\xe4\x36\x8c\xae\x92\x5c\x31\x32\x6f\x16\x61\xe7\x1c\xee\x4c\xb5\x87\x77\x91\x03\xc7\x2a\x12\x5c\xe8\x88\xab\xcd\x88\x40\x35\x6d\x4f\x5a\x58\x58\x04\x55\xba\x52\x21\x0d\x14\x79\x2d\x8a\x94\x62\x0b\x5e\x54\x78\xd9\x19\x30\x75\x24\x0d\x54\xa1\x3e\xb0\xf7\x6c\xb8\xdd\xfd\xf2\xc8\xce\x31\xb5\xe8\xea\x29\xc8\x51\x98\x65\xea\xdc\x55\x9b\xec\x76\xeb\x62\xaf\xa8\x71\x95\x88\xcc\x3b\xd9\x23\x42\x14\x1c\x1d\xe4\x52\x53\xbd\x74\x9c\x32\x8a\x3d\x28\xe3\x1e\x55\x35\x03\x4f\x03\xcb\x6f\x3b\xd8\x0d\xc8\xab\xc4\xa9\x82\x4b\x73\xea\x0d\x72\x1c\x48\x86\x00\xbd\xc7\xfd\x3d\x54\xe5\x1a\xdc\x8a\xda\xd5\xcb\xc7\x5b\xe0\x9e\x8c\xae\x32\x69\x70\x30\x8e\x89\xe1\xb9\x91\xbd\x68\xd7\xb2\x64\x08\x2b\xd3\x13\x3a\x44\xcf\x51\x63\x78\x3e\xe2\x40\x3b\xc8\xea\x55\x43\xbb\x45\xf5\x94\xc1\xda\x6d\x47\x36\xdc\x55\xf5\xb2\xdc\xf4\x08\x2d\xe3\x9d\x59\xa2\x29\x1c\x9c\x23\x8f\x8b\xdb\x0e\xe1\x33\x3f\x98\x0c\xe3\x50\xb1\x04\x60\xe5\x28\x9c\x38\x0c\x42\x4f\x6e\x43\xf0\x4b\x79\xa1\xe5\x3c\x86\x2e\x74\xd6\x09\x97\xb1\x87\x5a\x0e\xde\xcf\x95\xd4\x7a\xe1\xaa\xd6\x1d\x0f\x37\x31\x7d\x4e\xad\x44\x5c\x85\x86\xe1\x3a\x58\x28\x70\x6d\x29\xd2\x88\xbd\x4b\x66\x43\x6b\x82\x92\xc4\x24\xc1\xeb\xf6\xc0\xed\xac\xa0\x71\xb5\x83\x6e\x51\x7d\xa2\xdc\x3c\x52\x83\x19\xb0\x2c\x6f\x15\x9f\xf2\x0c\xae\x37\x2d\x71\x8b\xb3\x65\x1f\x9f\x31\x73\x56\xa1\x41\xdf\x29\xe4\x59\x45\xcd\x30\x3a\xa9\x0b\x70\xa4\x06\xe4\x7c\xcd\x77\xbe\xb2\x26\xc6\x5e\x18\x39\xdc\x18\x50\xb7\x9d\xb4\x1b\x85\x9e\xf7\x0a\x02\x13\x67\x95\xae\x9d\xaa\xb0\x2a\x28\xeb\xe0\x0e\x43\xd9\xdd\xa0\xc0\x71\xae\x1b\x42\x14\xdc\xeb\xf2\x7c\xe2\x23\x63\xac\xc8\x8b\x55\x28\xa6\x2c\x8c\xae\x44\x1d\xba\x2a\x0a\x8e\x45\x7b\x76\xfb\x9c\x68\x6a\xc2\x8d\x31\x9a\x40\x01\x4b\xd0\x80\x20\xa9\xd7\x2b\x3a\xf4\x67\x71\xaa\x44\x8c\x0f\x79\x3d\x43\x00\x05\x46\xee\x59\x16\xee\xee\x38\xd0\x49\x77\xb9\xee\xab\xd4\x5d\x56\xa4\x6d\x25\xa3\xee\x4e\x4d\xdb\x4d\xe2\x61\xa9\x81\xbe\x90\x3d\x40\xe5\xee\xea\xb2\x00\x67\x00\x22\xea\xbe\x83\xa2\xc1\xc8\x9b\x94\x54\x06\x87\xb8\xe2\x41\x6b\xeb\x9e\xaf\x83\xf3\x9a\x32\x84\x10\x75\x64\x5c\x8b\x2b\x3f\x28\xbc\x7d\x37\xc6\x7c\x95\xd1\x66\x42\x9d\xe4\xdc\xfa\xc9\x90\x83\x8c\x2d\xdd\x32\x9d\x0d\xf4\xa1\xcf\x16\xc2\x1f\x05\xc5\x55\x0a\x21\xaa\x51\xc4\xa0\x76\x75\xea\x64\x46\x3c\x1d\xec\x0b\xe3\x1b\x86\xed\x79\xce\x75\x6f\x48\x48\x95\x49\x74\xd6\x37\xc7\x2c\x26\x7d\xf5\x58\xe4\x15\x13\x98\xda\x2d\x1c\xa9\xdd\xf5\x74\xa7\x2d\x49\x2f\x93\xcb\x41\x3d\x8d\x3a\xc7\x8d\x9a\x7f\x3e\xa5\x61\xe9\x46\xb6\x0e\xb9\x56\x24\x80\xea\xdd\x26\xaf\x19\xad\x74\xb7\x02\x06\x40\xad\x26\xf7\x9a\x85\xd2\xf3\x89\x47\x84\x7d\xe6\x21\x2e\xdc\x3b\x17\xc3\xa6\x8a\x53\x74\xce\x07\x36\x58\x24\x2c\x0a\xb9\xa3\xe6\xdf\x85\x8b\xd1\xed\x4e\xe0\xf9\x3e\xe5\x35\x6e\x08\xe8\x81\x84\x2d\x06\x5b\x09\xed\xee\xb8\x45\x37\xf3\x85\xb4\x1c\xed\xc6\x81\x04\x07\x28\xba\x50\xc2\x95\x1a\xb6\xf4\x3d\x7d\xed\x05\x33\x28\x8d\xe8\x7a\xeb\x1c\xe5\x98\x8e\x5e\x7e\x26\x88\x13\x6a\x50\x62\x3d\x9a\x10\xca\xce\x37\xe1\xcc\x9f\x96\xc1\x2b\x9a\x38\x09\xf7\x13\x82\x9b\x1a\xb1\x93\x1c\x3e\x94\xee\x65\x4b\xe4\xe8\x69\xe4\x86\x66\x95\xaf\xa3\xb2\x08\x4d\xd2\x92\x3a\x7f\xb8\xf2\x11\x78\x3d\xc9\x50\x03\x63\xec\xf5\xe2\x95\x25\x3a\x71\x31\x51\x37\xae\x56\x97\xa2\x59\xec\x29\x0e\x09\x16\x4f\x4b\xef\x67\xec\x26\x4a\xeb\xe0\xfa\xf6\x24\x63\x47\x19\x5a\xb8\x7a\x71\x2b\xb8\x94\x10\xdd\x22\xce\xaa\xa2\xd3\xae\xa0\x5a\xb1\xdb\xf4\x3b\x31\x74\x65\x98\xef\x65\x0d\x2f\x9c\x42\xb6\xb4\x5b\x28\x66\x89\x6d\x16\x55\x6a\xa7\x45\x7d\x69\xc3\x18\xa0\x60\x8b\x5d\xb0\xfc\xc8\x6b\x6c\x49\xb6\xbb\x54\xbf\xd5\xc1\x2e\x15\x17\xc8\x04\x75\x31\xd6\xcd\x7a\x20\x46\x5a\xca\x90\x58\xa9\xa1\xa3\xcd\xc3\x0e\x7b\x9f\xe9\xbd\xd1\x5e\x42\x52\x26\x7b\x6e\xca\x1b\xb1\xf2\x2b\xd2\x42\x7c\xcc\x16\xcf\x54\xc9\xb0\x3c\xe3\x6a\x59\xd8\x4a\x18\x84\x64\xc8\xed\x50\x64\xf7\x43\xd0\x39\xf6\xca\x45\x11\x41\xe1\xf7\xc3\xb8\x8b\x41\xfa\x06\xdc\x6a\xaf\x40\x7a\x4d\x52\x76\x67\x50\x13\x4c\x47\xaf\x04\xbb\x4f\xaa\xc3\x45\x15\x4d\x8c\x13\x15\xf2\x0e\x58\x4a\x72\xb0\x90\xd6\x6d\x39\x56\xe9\x5d\xbe\x53\xfa\x2e\xf7\xd4\x88\x10\x7d\x56\x1e\xf5\x72\xa1\x27\x7d\x62\x3b\xa8\xc1\xd2\xfa\x42\xd3\xbb\x4a\x5b\xf4\xf4\xae\x05\x87\x4c\x36\x86\x04\xea\xbd\x5d\x10\x38\x93\xdc\xf5\xb2\x7e\x70\xa7\x33\xe2\x1d\x84\xb9\xe2\x32\x11\xb8\x83\x79\xd6\x81\x04\xd1\x79\xb3\xaa\x11\x27\x14\x60\x69\x4b\xbd\x27\xd9\xa2\x5a\x7e\xbc\x8b\x2a\xf4\x88\xc9\xfb\xe4\x9c\xb2\xd0\xea\x84\x84\x66\x0a\xfa\x70\x02\xf9\x45\x39\xc6\xc5\x8e\xc3\x41\x10\xa2\xf6\x07\xdf\x52\xe9\x15\xe2\x29\x92\xad\x89\x39\xcf\x7d\x46\x3e\x9b\x88\x7b\x13\x8a\x5b\x22\xd5\x17\x2c\x3b\x24\xf6\x15\xd0\x45\xc7\x69\x1c\x06\x82\x28\x78\xa6\x68\x7d\x1a\x17\x74\x0a\x9d\x30\xb9\x68\x87\x9b\x6b\x71\xd4\xa5\x45\x38\x65\x14\xd4\xd8\x3e\xa2\xb2\x08\x84\xfb\xf8\x06\x0d\x66\x3c\x32\x18\x7e\x27\x40\x03\x8f\x67\x78\x71\xe4\x21\x08\x4e\x03\xbb\x96\xe7\xa8\x71\x8c\x96\x4d\xc8\xeb\x88\xc8\x17\x78\xa7\x4a\xca\x8e\x3b\xc4\x05\xd9\xc7\xd8\x22\xa4\x8c\x49\x45\xa7\x2c\xc5\x9b\x76\x4d\x12\x72\x92\x8a\x61\x68\x19\xbb\xc6\xd6\xeb\xfd\x4c\x05\xdc\xe4\x2c\x69\xb2\xaf\xec\x40\xbf\xd8\xfa\x4e\x74\x60\x1a\xab\xbd\x2b\xcf\xee\xd8\x09\x04\x1a\x63\x58\xd5\x45\x07\xf3\x50\xd2\x58\x6c\x25\x7b\x81\xa8\x80\x6b\x8e\x9e\x8f\x3a\x2f\x26\x9c\x03\x1e\x44\x95\xe1\x27\x91\x89\x54\xac\x06\x63\x6f\xbf\x6b\x73\x9f\xbd\x4b\xba\x15\xc9\xc4\x04\xba\x5e\x09\xb1\x49\x69\x1f\xf0\xfe\xc0\x01\x2a\x39\x05\x62\x77\x64\x4a\xb9\x6f\x2c\xfd\x4a\x15\x2c\x7c\x22\xc5\x68\x92\x2a\xd1\xe9\x0f\x5c\x76\xb1\x0c\x3b\x4a\xa3\xd8\xd8\x19\xbd\xc0\x4b\x0b\xa1\xec\xd0\x1a\xbb\x9e\xc5\x9a\x93\xfc\xfe\x0a\xa9\xfa\xa0\x5d\x2e\x07\xa5\xa8\xd4\xcc\xba\x65\x4a\x7e\x3c\x5f\x66\xea\x46\xc0\x5c\x2e\x1f\x19\xe5\x10\xd1\x94\x66\xa8\x3c\xc3\x1d\x4a\x46\x8a\x55\x78\x20\x89\xa1\xba\x4f\x24\x39\xd3\x60\x3d\x22\x87\xbc\x3d\x1d\xce\x0b\x03\x45\x77\xdf\xdc\x79\x00\x63\xc2\xc5\x7c\x05\x4d\x4f\x3b\x23\xc2\x39\x0d\xe1\x71\xd2\xf5\xe3\x8d\xd1\x49\x28\x3d\xb1\x5c\xe8\xb3\x27\x78\x19\x6d\x5e\x33\x5d\xf4\x62\x43\x82\x95\x57\x4a\x6a\xd3\x87\x65\x3f\x68\xa8\x06\xdf\x1c\x8a\x3d\x0a\xa5\x4d\x43\xce\xa4\x3a\x76\x5b\xf6\x7c\xb2\x30\x53\x02\x29\xb5\x32\x96\x5a\x73\x6e\x45\xc0\x2f\x97\x22\x53\x3a\x31\x2c\x44\x1b\x8a\x9c\xc3\x02\xa4\xd7\xbe\x77\x31\xbf\x4a\x66\x10\xd3\xef\x50\x32\x4f\x4d\xb1\x3b\x96\x15\x15\xfa\x33\x7c\xcb\xd8\x13\x93\x50\x9a\xec\x4d\x34\x46\xad\xae\xe5\x01\xd1\xe5\x02\x02\x67\x27\x5b\xfb\x24\x62\x62\x23\x42\x43\x47\x3a\x9e\x6d\x4f\xd0\xe4\xb4\x18\xc3\x88\xc7\x2b\x1d\x48\xf4\x22\x16\x73\x1b\x3e\xf4\x6b\x01\xee\x99\x54\xc4\xc9\xd3\x25\x2d\x99\xe9\x2e\x6a\x03\x23\xf7\xf6\x8e\xb5\xc5\x7d\x87\xd0\xe6\xe4\xa1\x45\xe2\x9b\x81\x26\x44\x63\x4e\xea\x08\xb3\x93\xf5\x5b\x37\x93\x36\x31\x85\x56\xb6\x73\x64\xa3\xc8\x0f\x3c\xd1\xa4\x98\x9e\xf0\x42\x1e\xee\xfc\x65\xd4\xb8\xf6\xd2\xca\xb3\x9a\xfb\x07\xe2\x4e\xae\xd1\x1a\x10\x49\x70\x2a\x26\x60\xca\x34\x63\x36\xb0\x84\x38\xcb\x91\x72\xbb\xe9\x05\xca\x12\xb5\x8c\x63\x01\xc4\x92\xc8\xb5\x29\x6c\xd5\x3b\xd0\x34\xec\x62\x5d\x1c\x4a\x8e\x01\xd6\xfc\xb0\x43\x51\x6f\x07\xce\x37\x53\x54\xf9\x7b\x95\xdd\x4d\x56\x41\x46\xb1\xf2\x81\xa0\xe7\x67\x79\x61\x4c\x1e\xb9\x1b\x49\x63\xd2\x11\xe8\xa2\x16\x77\x70\x43\x04\x5e\x48\xd6\x3e\xec\x9a\x7d\x75\x9c\xa0\xce\x0d\x55\x30\x56\x94\xe3\xbe\xd4\xa1\x2a\xc9\x07\x00\x75\x15\x9a\xdd\x0d\x00\x3b\x8a\xfb\xfa\xd2\x08\xb4\xbd\x3f\x9f\xf0\xe3\x60\xb9\xf7\xf1\x70\x8b\xcf\x45\x73\xa0\x6a\x31\x89\x76\x65\x76\xed\x11\x89\x2a\x2f\x1e\x33\xfa\x7a\xd0\x20\x34\xbc\x44\x66\xc5\xef\x59\x8f\xbd\x1f\x03\x1b\x28\x74\x41\x24\xeb\xa1\x18\x92\xae\xc2\x4d\x86\x9a\x6b\xa4\x51\x13\x8d\x60\x0f\xc7\xd5\x00\x8e\x05\x16\x51\x8a\x66\x41\xfb\xf9\xae\x1a\x95\xa2\x02\x50\xb8\xcb\x2a\x87\xd3\xcd\x3d\xcc\xc3\x1e\xe3\x7b\x7b\x53\x1f\xf1\xcb\xa1\x85\xb3\x86\x8d\x00\x61\xc9\x2d\xf9\xee\xe0\xbb\xba\x60\x13\x8c\xa7\x61\xa6\x39\x43\xde\x85\xcd\xb4\xa1\xb1\x92\x55\x5d\x4f\xce\xbc\xdb\x75\xd6\x05\x3c\x5e\xe9\x43\x17\x8a\x19\xa7\x4d\xc9\x5e\x8b\x98\x0a\x3c\xee\xc6\x22\x97\x4f\xb4\xe7\x49\xd5\x38\x24\xd3\x71\x94\x75\xbf\xe7\xac\xc4\x03\xa6\x7d\xd2\x1e\xe3\x3b\xb9\x6f\x69\x3f\x99\x7c\xc5\x8b\x01\x7c\xa7\x1d\x8f\xd0\xa4\x2f\x92\xcf\x50\xd7\x70\x41\x86\x53\xb2\x84\x05\x06\x75\x8a\xd0\x37\x92\x2e\xcd\xb2\x43\x52\xd7\x9d\x7a\x47\xf1\xf6\x74\x6c\x95\xd9\x51\x2c\x83\x6e\x96\x71\x17\x94\x38\xe6\x4a\x61\x8a\x1d\x07\x82\x26\xce\x46\x69\xf2\x02\x96\x96\xa2\xa1\x96\xce\xea\x21\xd4\xce\x50\x78\xc3\xe9\x8d\xc4\xae\x99\xd3\x79\x0c\x11\xa4\xb1\x7d\xb8\x1b\xed\xf8\x06\x4d\xd4\x91\x75\x8f\xbb\x6b\x51\x4c\xaa\x9f\x0b\x98\xaf\x8f\xfc\x6d\x18\xab\xe8\x46\x43\x1d\x0d\xf2\xbb\x12\xea\xd3\xca\x6a\x41\x1f\x8a\x65\x6a\x85\x33\x88\x6c\x27\xe8\x7c\x14\xcf\x97\x33\x97\xde\x88\x85\x85\xd0\x9a\x60\x64\x75\xbd\x3a\xb7\x01\x37\x4e\xa1\x2e\xdf\x8f\xfb\x93\x32\x48\xd7\x95\xa8\x67\xb9\x15\xa5\x7e\x4c\x3a\xe0\xb2\x77\x58\xb3\xdb\x93\x73\x16\xb2\xd2\x40\xdf\x2e\x41\xae\x07\x64\xba\xdf\xf9\x81\x8a\xd8\x7a\xe3\x74\x00\xcb\x1f\xcd\x9b\xe3\x79\xe1\x72\xef\xcf\x13\xa9\x4b\xf3\xdd\x3a\xb1\x42\xe2\x8c\x97\x01\x27\x0b\xf8\xac\x30\xea\x62\x52\x31\x5d\xf1\x16\xab\x82\x20\x35\x29\xfd\x59\x3e\xb5\xf1\x58\x18\xfb\xfb\x15\xe1\x6d\x37\xba\x29\x38\x89\x35\x61\x21\x37\x41\x05\x02\x80\xbb\x27\x22\xcd\x61\x08\xa4\xde\xed\xb8\x55\xd4\x82\x8b\x79\x70\xac\x03\x82\x5d\x84\x91\xa9\x2f\x61\x35\x80\x16\x37\xee\x29\xa5\x10\xa7\xbc\x61\xae\x92\x28\xdd\x20\x92\x18\x26\xd5\x3e\x08\x93\x29\xe5\xfb\x6b\x64\xf1\xb7\xab\x7a\x05\x19\x92\xc9\x7a\x29\x41\xb4\xb3\x41\x58\x24\xe9\xd0\x79\x20\x3a\x03\x43\x57\x7b\x9b\x60\x86\x4e\xe1\xcb\xcb\x44\x40\xe1\x9d\x80\xf4\x13\x4d\xe9\xe2\x34\x0b\x07\xca\x76\xb1\xab\x8a\x33\xa1\x7b\xe5\x91\x63\x26\xe1\x26\x7d\x16\xb8\xc6\x09\x06\x7b\xec\x6b\xc6\x2e\x75\x92\xd5\x0e\xf8\x4c\x71\x09\x74\x8d\x50\x8e\x10\x80\x80\xce\x84\x9b\x00\xf2\x86\x38\xc3\xa0\xc0\x5d\x96\x0b\xda\x80\x36\x16\xe1\xeb\x1c\xdf\x5a\x99\x42\x21\x7e\xb0\x22\x11\x83\xd9\x29\x3d\x84\xb3\x07\x94\xa0\x97\x9a\xa8\xac\xdf\x5b\xeb\x32\xbb\xb4\x2a\x9d\xa1\x82\xe3\xd7\x81\x80\x63\xf4\xac\x5a\x98\x48\xfa\xcd\x71\x87\x7b\x43\x7d\xef\x79\xcb\x17\x88\x63\x7d\x1b\xc9\x22\x90\x9c\x53\x47\x3b\x84\x7c\x56\x4a\xab\x1a\xfc\x9b\x52\x9f\x70\xaf\xb0\x8c\x11\xf5\x4b\x95\x59\x79\x93\x45\xbb\x45\x44\xd7\xb4\x16\xad\x00\xbc\x35\x4d\x12\x9d\x85\x4e\x29\x0d\x67\x38\x66\x81\x83\xb7\x96\x34\xde\xe4\x13\x72\xdc\x95\x7b\x05\xa8\x71\xf4\xb4\x26\xf2\x5d\xbe\x37\x91\x7b\x36\x19\x27\x91\x10\x1c\x00\x4a\x76\x49\xe8\x46\x8e\x0e\x16\x12\xc7\x7b\xcd\xbe\xdc\xdb\xe8\xc4\x53\xc5\x19\x3b\xb6\x66\x0e\x71\x4c\x48\xdc\x8c\x98\x4e\xa9\x61\xea\x39\x52\x8d\x0b\x1c\xd8\x1b\xae\x23\x34\x03\x7e\x65\xf4\x82\xb6\x72\xbe\x8d\x71\xe5\x0c\x55\xe7\x86\x04\xa9\x42\xc0\x18\xfc\x0c\xf7\x82\x45\x21\xd7\xe5\xca\xf2\x0d\xcf\x17\x90\x1e\xb3\x02\xad\xa1\xee\x75\xf6\x8c\x45\x18\x50\xa1\xbd\x5f\x10\xa2\xa5\xe6\xd3\xe9\x80\xf1\x98\xb7\x64\x8c\x39\xdc\x2f\x94\x0d\x1c\xe1\x05\x51\x04\x3a\xb7\x4c\x3a\x56\x44\xcd\x40\x5b\xf4\xc2\x83\x29\xa1\xe3\x04\xb3\x90\x41\xe3\x33\x23\x50\x71\x58\x57\x53\xe4\x3d\x67\x23\x65\x67\x12\x53\xac\x89\x5c\x73\x59\xb4\x2a\x56\xbb\x49\xba\x27\x75\x5d\x17\xab\xe2\x0d\x6b\x83\xde\xed\xe3\x0e\x72\xaa\x14\x3b\x9d\xa8\xc6\x6c\xca\x93\x9e\x9d\x03\x08\xf1\xe3\xf9\x40\x05\x1e\x1f\xe2\x37\xbe\xef\x51\xcd\x88\x44\x43\xee\xa0\x38\x1d\x3a\x30\xd2\x33\xad\xcd\xcd\x73\x17\xf2\x0d\x99\xde\x82\x1b\xdf\x68\x34\x5a\x85\x89\xd7\x78\xd8\x4a\xf7\x13\x77\x2c\x04\xa7\x68\x07\x84\x3f\x48\xce\x0d\xbd\x6a\xca\xe2\xe6\xd7\xc5\x75\x4f\x8e\x37\xae\x22\x5c\x75\x95\x55\x64\xa2\x7b\x50\x7d\x1a\x4c\xcf\xde\xed\xb8\x07\x6d\x51\xeb\xe0\x02\xbc\x87\x7d\xdf\x38\xb6\xec\xcb\x14\x05\x38\x7b\xce\x40\x1b\x44\x30\x82\x18\x57\x56\xea\x24\x5c\x8f\xa6\xee\xf3\x71\xe3\xf9\x69\xb4\xab\x35\xe1\x68\x52\xb9\x36\x35\xba\xd7\x59\x8a\x8c\xf0\xb7\xb4\x0a\xfb\xfc\x2e\x2f\x4e\x81\x4a\xc6\xed\xe8\x40\xfe\x05\x0e\x31\x98\x0d\x07\xa9\x00\xb2\xa3\x49\x1d\x8f\x2b\x89\xe3\xa7\x3d\x68\xe7\x67\x52\xa0\x2f\x4e\x8d\x0d\x3d\x30\x2f\x42\x12\xec\x51\x3b\xba\x1e\xd9\x22\x3e\x52\x0d\x9a\xe0\x78\x8b\x82\x3d\x3a\xaf\x7a\x96\xe3\x17\x8e\x22\xb8\xbd\x09\x9e\xa0\xd4\x72\xcf\x45\x6e\x17\x75\x7f\x22\x57\x47\x00\xf4\xb3\x59\x8c\xf5\x45\x1c\x73\x3c\x15\x32\xde\x36\x18\xc5\x81\x6c\x87\xda\xdf\x32\x65\x80\xcb\x4a\x3d\x97\x49\xc3\x9f\x49\x98\x09\xcd\x7e\x5f\x60\x00\xea\x5c\x19\x83\x1e\xc6\xce\x3e\x50\x68\x4c\x69\x67\x59\xb1\xaf\xa0\x21\xcb\x97\x7c\xd0\x4b\x45\x93\x4d\x1f\x4e\xd4\x98\x5e\xb1\x0b\x42\xfb\x3d\xec\x9f\x9d\x63\x82\xba\xb2\xb6\xb6\xf6\x29\x21\x97\x4b\x78\x51\x8e\x35\xdc\x1c\xeb\xc1\x25\x2e\x2a\x16\x8e\x7e\x25\xb3\x20\xd4\x4b\x48\x94\x5f\xec\x43\xeb\x9c\x47\x34\xa1\xba\xc9\x94\x53\xc8\x09\x67\xd9\xa4\x9c\x21\x18\x07\x12\x9f\x24\x8f\xb6\x7c\x19\x29\xa4\xde\x39\x56\x79\xc6\x44\x3d\x72\x74\x90\x16\x43\x55\xed\x2a\xba\xbc\x97\x1d\x7c\x2a\x98\x72\xcd\x53\xf2\xd5\x17\x60\x17\x65\xee\x7c\x16\x17\x61\x28\x61\xc1\x69\x9f\xb5\x1c\xce\x50\x68\x5b\x9c\x40\x94\x08\x67\x0c\xba\x1c\xf7\xa5\x2c\x2f\x41\x6f\xab\x42\xe2\x71\xa7\x8a\xe1\x12\xf5\xe2\xb7\x40\x3e\xfb\xbd\x69\xe4\xfd\xdd\x84\xc1\x8b\x8d\x3b\xa7\xbb\x15\xba\xf6\x51\xba\x8e\x13\x72\x70\xa8\x74\x6a\xcc\xd3\xde\x96\xa9\x7d\xed\x98\x1c\x19\x77\x4c\x3d\x43\x68\x7c\x3c\x32\x2d\xb4\x38\x45\x56\x36\x4c\x73\x6c\xd1\x3b\x0a\x57\x56\x0f\xdc\x6c\xee\xa0\x49\x44\xbb\x40\x60\xd3\x17\x35\x27\xcd\x77\x01\x3a\x79\xc8\x9a\x69\xf1\x1d\x2e\x15\xaa\xa2\xce\x7b\xa2\x6b\x90\x23\x89\x1f\x87\x92\x68\x4c\xea\xb6\x12\xe5\x40\x20\xab\x38\xa0\x5c\xaa\xce\x3a\x92\x2f\x92\x49\x8b\x24\x6f\xe6\x01\x7e\xda\x6b\x35\x99\xa2\x98\x54\x5b\x85\x8f\x6a\xd3\x30\x1d\x45\xaf\xdd\x4f\xfa\x91\x48\xfd\xa6\x4f\xc5\x29\x49\x07\x8c\x63\x33\x26\x02\x98\xb2\x3b\xe5\x81\x17\x9f\x6c\x75\x35\xa1\x0a\x63\x66\x81\x77\x0e\xc1\x79\x41\xf6\x19\x40\x2f\x4a\x0d\x9c\x9b\xc2\xd7\xce\x4e\x42\x86\xb9\x73\x20\x54\x76\xe4\x4a\x8a\x5d\x64\xa9\xad\xd7\x2c\x27\x8e\x22\x71\xa4\xa6\x21\x76\xe4\x5a\x13\x53\x67\x07\x8d\xf3\x81\x3b\x5f\x81\x8e\x02\xbd\x12\x39\x2a\x7a\xa6\x87\xf9\xee\x8a\x1e\x22\x43\x69\xce\x0c\x7a\x8b\x4a\xa1\x47\xfd\x0a\xcd\x23\x20\x2b\x96\xfb\x01\x3a\x34\x40\x26\xd3\xcc\x7d\xaf\xc6\x7e\xbb\x38\x25\x18\x45\xd3\xdd\xc0\x26\xb8\x3c\x5b\x1c\xae\x9d\x19\xc9\x87\x9c\x69\xb0\x04\xce\xdb\xf9\x5e\x0f\xd2\x3d\x75\xd1\x8f\xcd\x18\xde\xc6\xf6\x32\x41\x86\xdb\x55\x16\xca\x79\x19\x7e\x56\xfb\xa1\x99\xc5\xab\xdc\xc5\x6e\x2c\x9d\x27\x58\x28\xeb\xb8\x43\x48\x9c\x76\x63\x49\xbe\xeb\x99\x4c\x40\x44\x95\xb1\x71\x81\x26\xfd\xbe\x9e\xf2\x1d\x18\x20\x2d\xad\x79\x32\xbc\x4b\x6d\x92\x83\xc2\x6b\xa9\xcb\x6d\xc6\xf7\xe6\x59\x1e\xdb\xfa\x98\x56\xc6\xbe\x82\x69\x4d\x6a\x71\xff\x4a\x69\x3b\xd1\xdf\x35\x44\xe2\xef\x8f\x6a\x22\x1b\x87\xdb\x74\x10\x08\xff\xec\x92\x57\x29\xac\xe1\x0c\x77\x25\x6a\xac\x2b\x2c\x0d\x1d\xb6\xa3\x76\xc9\x72\x46\x64\xeb\xbc\xc4\xaa\xd6\xaf\xd1\x00\x17\x9e\xb0\xf6\x91\xca\x2b\xfd\x5c\x7b\x77\x5f\xef\x13\xd8\xbb\x47\xba\x8a\xf9\xa1\xb2\x5c\x0e\x79\x0c\x3b\x73\x66\x4e\x1c\xd5\xc3\x95\xb6\xde\x51\x2a\x5a\xe8\xa8\x8c\x59\x84\x3b\x35\x19\xe6\x49\xa4\xd8\x96\x3e\xa9\xf3\x20\xb0\xc4\x56\x23\xcd\x20\xd2\xf0\x97\xa4\xc6\x50\x3b\xbd\x2e\x2b\x7b\x8a\x47\xf1\x48\x67\x11\xdb\x8f\x10\xe3\x27\x8b\x82\xaa\x00\xe0\xa6\x75\x01\x78\x22\x6f\xb7\x82\xab\x83\x1e\xd7\x46\xc2\x82\x41\xc6\x89\x01\xfa\x73\x3f\x4d\xe0\x45\xa4\xba\xd9\x47\x61\x66\x55\xc3\x0a\x61\x9a\x59\x8f\xfb\x9a\x41\xc5\x70\x3c\x83\xa0\xdf\xb1\x64\x28\xa4\xe9\xc1\x5d\x3c\xe2\x14\x74\xc3\x2e\x3b\xd0\xf0\x1c\x80\xe8\x88\x1a\x10\xde\x12\x27\x3c\x3a\x87\xac\xe0\x1e\x6e\xa1\x3b\x8d\x90\x50\x0c\x72\x51\x09\xe8\x9d\xe6\x01\xa5\x57\xa6\x60\x3f\x58\x66\xb1\x42\xf4\xe9\x26\x68\x8d\x06\xf8\x86\x96\x88\x01\x05\x0a\x10\xae\xdc\xcf\x62\xe5\x52\x3b\xab\xad\x13\x50\xd6\xd7\xf2\x58\xb8\x17\x67\x5f\xdf\xe3\xf6\x98\xe6\x8a\xad\x4e\x1a\x15\x28\x34\xe1\x1c\x0d\x8e\xdb\x35\xfe\x2a\xaa\xde\x28\xe7\xae\x1c\x52\x0c\xcc\xb6\xd0\xad\xe4\x33\x6f\xb1\x41\x7e\x2c\x87\x5d\x46\xb0\x98\xd5\xe2\x16\xa3\xd1\x87\xc9\x5d\x88\x43\xa4\x60\x5a\xcb\x77\xe4\x18\x48\xbe\xc5\x44\x48\xd5\xba\x26\x8c\xe3\x31\x8e\x95\x2a\x86\xef\xee\x75\x54\xd1\x41\x87\x87\xfa\x3e\xc0\x2d\x2c\xa9\x07\x93\xea\x13\xec\xde\x75\xc7\xf1\x92\xef\x0f\xd0\x49\x4b\x24\x00\x9b\x80\x48\xed\x1a\xb2\x13\x31\x24\x3a\x7b\xd5\xe8\x42\xc9\xaa\x30\x78\x7f\xdc\xe7\x54\xc8\x40\x06\x90\x5e\x35\xed\x84\x22\xa5\x4c\x29\xd2\x55\x98\x16\xc0\x2d\x6a\x83\x11\x60\x77\xe7\xf0\xfb\x01\x0f\x6e\xe3\x0d\x88\xeb\xfa\x02\xae\x81\x7c\xc6\x14\xb6\x74\x4f\xb5\x9e\xc0\xa0\x23\xf8\x19\xd3\xd3\x30\x47\x2d\x6e\xd4\x4f\x67\xb9\x35\xb5\x3c\xc4\xca\xcb\xfd\x1a\xf6\x95\xd0\x5a\x38\xd3\x63\x26\xd1\x12\xcd\x42\x36\x67\x47\x31\xb1\xbc\x81\x24\x65\xe4\xce\x98\x0d\x7a\xa8\x0e\x99\x22\x5f\xd0\xb8\x74\x11\xdc\x13\xa1\xc0\xd8\xa9\x4d\x92\xf9\x76\x54\x60\x8c\x97\xd2\x28\xf0\xf6\x23\x09\x1e\xfb\x35\x2a\xe3\x25\xb8\x1f\xae\xf7\xb3\x2a\xf5\x26\xba\x97\x7a\x2f\x1c\x10\xbf\x37\x97\x19\x3f\x3b\x11\x82\x08\xc1\x99\x27\x81\xb6\x73\xb1\x9c\x45\x97\x7b\x7c\xbc\x43\x77\x5f\x77\x12\xf7\x7a\x8f\xef\x98\x25\x0e\xbe\x7c\xb8\x5d\x69\xd4\x0a\x86\xb4\xda\x97\xfb\x7a\xea\x4d\xb1\x58\x3a\xa1\xca\xe1\x93\x41\xc3\x19\xbf\x1f\x00\xa2\x19\x52\x72\xa9\xd7\x72\xb6\x66\x0e\x99\x66\x60\xe9\x1a\x21\x67\x98\x8a\x04\xb2\x54\x1d\xd5\x50\xb6\xf6\xeb\xb0\xae\x8b\xbe\xcb\x79\xd5\x1b\xae\xb0\x4b\xe8\x46\xdd\x19\xd7\x7e\xcc\xfb\x2a\x86\x48\x49\x49\xcf\x85\x0f\xc1\xe3\xa4\x51\xbb\x19\xb1\x44\x82\x20\x18\x7b\x9c\x02\x62\x31\x8f\x22\xe0\xe4\xc1\x9d\x9a\xc0\xc6\x18\x4c\x9b\x74\xe9\x28\xf3\x58\xf7\x36\xa8\xbb\x3b\x5e\x9e\x05\x78\x34\x50\xd6\xf3\x6a\xef\x68\xdf\x1a\x79\x96\x97\x16\x2d\x4f\xde\x65\x5c\x0e\x97\x61\xb4\x95\xb2\x2b\x53\x8b\x5b\x91\x2c\xb8\x04\x25\x4d\xc5\x76\x78\x38\xc0\x96\x64\x79\x01\x49\xe9\x9d\x7d\xec\xc9\x96\xb4\x32\xf7\x08\xa5\xe9\x5d\x63\x61\x4a\x64\x81\x9b\x37\xcc\x53\x06\x66\xb7\x93\x8a\x0d\xdc\xf5\x74\xb9\xd6\xf8\x38\xa8\xbe\x3b\xe8\x64\x02\xf5\xa3\xc1\x9c\xe5\x4c\x03\x65\x36\x87\xb9\xd4\x59\x2e\xfb\x4e\xf0\xed\xca\xa3\x80\xeb\x0d\xbd\xb6\x97\xeb\x39\xe9\x0d\x60\x87\xaa\x52\x97\x71\xfc\x2e\xf7\xc3\x82\x10\x93\x1e\x6c\x38\xa0\xbc\x3b\xe6\xaa\x34\x2a\x8a\x20\x7b\xcf\x6f\x4b\x41\x68\x25\x97\xe8\x15\x75\x80\x41\x15\x2f\xdd\xe1\xae\x4b\xb1\x57\x5d\xd1\x53\x72\xc8\x3d\x2e\xb5\x5d\xa2\x3c\x77\xe7\x5d\xc4\x8d\x5a\x7e\x6e\xce\x29\xd3\x94\x24\x4e\x00\x25\x62\xb0\x07\xe5\x6a\xd0\x01\xef\xe7\x87\x33\x9d\xb0\xf2\x54\xc2\x48\x74\x81\x5c\x94\x07\xe9\xab\x72\x84\x81\x7c\x5c\xba\xeb\x3a\xb9\x5d\x47\xb4\xc3\x80\x62\xc2\x11\x56\x81\x12\x3e\xc2\xa2\x58\x5d\x27\x6a\x24\x18\x35\xb0\x6e\xe7\xc1\x94\x4c\xde\xde\xdd\x7a\x6d\x5c\xd2\x4b\xc4\x64\xd4\xad\x39\xb6\xe0\x60\x09\x3e\xea\x1e\xf0\x2b\xc7\x05\xda\xfe\x96\x0f\x71\x6b\x1b\xa1\xa9\xab\x86\x8d\x55\xe2\x0d\x27\xcb\xc3\x70\x58\xa1\xe6\x7a\x9b\x8e\x31\x00\x54\x61\x69\xc6\xd4\x20\x02\x58\x87\x5c\xa8\x01\xd7\x4f\x3d\xbe\x74\x5c\x67\x9e\x65\x8b\xe8\x15\x1a\xb7\x69\x9d\xa6\xe0\x73\x62\x38\x72\xc1\x55\xd4\x1e\xb9\xa3\xd5\x18\x4c\x25\x73\x94\x52\x51\x71\x77\xb3\xeb\x56\xa9\xe4\x54\x44\xd5\xcb\x53\x87\xf5\x79\x75\x34\xc7\x48\x4e\xa7\x93\xd3\x5e\xee\x24\xbc\x9f\xad\x66\x25\xaf\x38\x4e\x18\xc5\xee\x5c\x78\xf6\x5e\x3c\x82\xbb\xbc\xe4\xe2\x9d\x14\x90\x0a\xed\x2b\xad\xd7\x32\xc1\x5c\x07\x20\xb7\xde\xc2\x68\xa2\xa6\x25\xe1\x0f\x37\xc3\xf3\xe6\x1c\xf2\x8b\x03\x19\x5c\x75\xec\x70\x80\x01\x9d\x38\x4c\x69\xbc\xee\x62\x72\x81\x63\x29\x25\xe7\x56\x63\x84\x00\xbf\xaf\x7e\xb9\xa7\x24\xe3\x40\x40\x03\x7d\xc8\x6c\x2d\xdb\x67\x97\xdd\xd1\xf5\x2e\xbc\x36\x8a\xc9\x6a\x5d\x4e\x2d\x72\xaf\xcf\xc8\x4e\x61\xbc\x8c\x6a\x7a\x36\x14\x19\x05\x9a\xc4\x26\x35\xae\xb7\xb1\xdc\xcb\xc4\x4a\x33\xf1\xd8\x00\x6c\xd8\xed\x9d\x58\x65\x4e\x5a\x4b\xfb\x86\x91\xc2\xa4\x2a\xcb\x79\xb7\xb8\xb7\xf3\xea\x5f\xaf\xe7\xc1\x76\xf2\x04\xc7\xa5\x1d\x29\xed\x2e\x16\x28\x5f\x20\x72\x86\x86\xbd\x9d\x6b\x1e\xdf\xb1\xc3\x6a\x61\x3d\x6a\x05\xb3\xc0\x93\x13\x70\x4f\x41\xa2\xad\x51\xb2\x93\x60\xc4\x5a\x29\xae\xdf\xb1\xc0\x94\x45\x19\x73\x3f\xb7\x9a\xa1\xe4\xea\xe1\x04\x4a\x4c\x82\xe8\xc7\x6a\x4c\x91\x3d\x46\x41\x26\x94\x22\x4e\x03\xcc\x73\x66\x55\xbc\x99\x8d\x7a\x9c\xd6\x78\xb6\x54\x58\xe6\x73\x12\x3e\x91\x43\x5e\xba\x52\xc1\x1e\x8f\x8e\xcb\x47\xeb\x3e\xe9\x45\x8b\x92\x05\x34\x1f\x91\x90\x68\x0d\x72\x49\xb1\x59\x37\x2f\x9d\x83\x7a\xe6\x5d\x2c\x14\x1f\x34\x15\x17\x8b\x0f\x93\x85\x1c\x8c\x66\xa4\x29\x48\x01\xee\xae\xb9\xf7\xc4\xab\x89\xe0\x62\xca\x0f\x36\xee\x15\xb9\x33\xac\xa2\xe9\x56\xfb\x16\xab\x11\x05\x77\xc2\xd6\x5b\x5b\xd6\x5f\x72\xe5\x68\x73\x9a\x9b\xfb\x77\x2f\x17\xe2\x28\x46\xf6\xbc\x6b\xe1\x95\x54\x28\x18\xc9\xf1\x57\x57\xec\x97\x40\x96\x14\x12\x33\x30\x4e\x6d\xc0\x3b\x6f\x92\x45\x65\x01\xec\xdd\x56\x6b\xc1\x2b\x3b\xc9\xda\xbb\xc2\x15\x01\xb1\x10\xb5\xa7\x78\x37\x0c\x08\x8d\xd7\xd2\xdd\xc3\xd1\x58\x5b\x4e\x57\x78\x95\x7b\x49\xcf\x44\x30\x3c\xe7\x68\x00\xb8\x5c\x84\x44\xd7\xf1\x24\x39\x17\x29\x04\x27\x4a\xb7\x1b\x3a\x29\xf5\x8a\xca\xa1\xea\x22\x69\x51\xd0\xa5\x00\x16\x5c\x52\x0a\xbb\xc8\xd1\x41\x2d\xe4\x9b\x37\x38\x46\x9a\xa9\x65\x76\xdc\xf1\x99\xa6\x9c\x50\x4a\x1f\x6e\x00\x05\x57\x05\xdd\xf5\x97\x4e\x00\xa5\x25\xe7\xf7\x08\x47\x94\x22\xea\xa1\x90\x8e\xa3\x42\x55\x8a\xc0\x42\x71\x08\x46\x5e\xba\xe0\x5c\x85\x43\xee\x24\xa8\x1b\xac\x47\xa5\x6c\xb9\x72\x2f\x70\x51\x9f\xac\xa5\x7f\x64\xf9\x6e\x68\x71\x39\x3d\x0f\x57\x18\x96\xd2\x4c\x10\x14\x06\x05\x48\xcc\x0b\x17\x4f\xc7\xa3\x3d\x8c\x23\xf8\x79\x25\xbb\x72\x26\xfd\x3e\x1b\x70\x32\xe5\x44\x36\xd9\x97\x55\xe1\x16\x4b\x70\xaa\x6e\xc6\xa2\x37\x22\x73\x3c\xa2\xd6\x2a\x84\x3c\xcd\xd0\xdc\xd0\xc4\x6d\x25\x2d\x93\x16\xdd\xad\xa0\x86\xc0\xfb\x32\xe5\xec\x2e\xec\x52\xb6\x43\x4c\x36\xf0\xe0\x85\x29\x9c\xb2\xcd\xc3\xa1\xd9\xe7\x97\x5d\x8c\x88\x45\x55\xdf\xdc\xc3\xd1\xb9\xc7\x62\xca\xcf\x46\xbc\x3f\xf4\x8a\xd9\xc3\xf5\xbc\xb4\x5d\x70\x17\xaf\xb5\xa4\x98\x4d\xdd\x8a\x82\xef\xa1\x07\x38\x76\xe9\x2b\x0c\x11\xd2\x3e\xec\x2c\x68\x69\x45\x51\xef\xf3\xdd\xd9\x4d\x82\x2e\xcc\xe6\x73\xa0\x77\xde\xed\x54\xef\x01\x09\x8d\xe2\xe1\xe4\x34\x81\x19\x0f\x4b\x0d\x71\x18\xb1\xcb\x40\x42\x73\x1d\x1f\x76\x8b\x2a\xcc\x6e\xd1\x22\x72\x74\x23\x07\x50\x07\x68\xc0\x51\x0f\x2a\x6d\x57\x78\x85\x1c\x94\xe6\x6c\x13\x95\x13\x0a\xe7\x8c\x99\x85\x05\x44\x1a\x54\xe3\x83\xfd\x25\x2b\x92\x95\xa1\x57\xf2\x2c\x93\x93\xec\x54\x83\xda\x9e\x96\x74\xe6\x16\x8e\x3c\x00\x0e\x92\xfa\xed\x71\xd7\x06\x90\x39\x0d\xc4\xc5\x97\xc5\xd2\xae\xcf\x98\x42\x10\x87\x76\x6f\xa2\x35\x1b\x72\x5e\xef\x81\xa7\x1a\xdd\xe5\x09\x59\xde\x13\x00\x56\xcf\xcd\x6c\x41\xfb\xac\x8c\x4d\x0f\xbd\x52\x50\x38\xb5\xd4\xb4\xf4\x66\x52\x5c\xf1\xee\xa6\xd4\x67\xb3\x19\x64\xa0\xd3\xa7\x82\xa9\xaf\xf5\x91\x13\xe3\xd5\xa0\x6e\xd5\x75\x18\x8e\x24\x77\xc2\x59\x67\xbe\xdf\x0f\x5e\x04\x1c\xc2\x45\xc6\x08\x6c\xa9\xa5\x85\xc3\xce\xb5\x70\x51\x61\x62\x75\x64\x2c\xf6\x5a\x65\xcc\x86\x75\x97\xf3\xfb\xea\x5c\xe8\x24\x23\xd0\x51\x9e\x9d\x6b\x76\x9c\xc8\x71\x3c\xdd\x87\x4a\xca\x69\x91\x9c\x97\xe1\x66\xf1\x6c\x22\xcb\x2d\x99\x5f\x63\x56\xa8\xcc\x8b\x78\xe6\x81\xe5\x20\x09\x27\xca\xd8\x9d\x33\xcd\x34\x86\x3d\x0d\xcb\xb3\x46\x33\x09\x71\xe1\x92\x02\x0a\x0f\xb4\x80\xaa\xc9\x8a\x17\xe8\xce\x92\x98\xf3\x95\x41\x68\x7b\xdf\x9d\x11\x91\x51\x2d\x84\x3f\x2a\x14\xce\xb8\x3c\x01\x00\x7b\xa3\x39\x9f\x4a\x72\x0e\x54\xb8\x22\xcc\x53\xc7\x29\x2c\x36\x10\xc7\x42\x50\xe8\x78\xd9\x61\x12\x02\xe8\xd0\xa1\x6b\xc4\x4a\xe1\xd4\x14\x4b\x94\xea\x2a\x09\x2b\x1f\x60\x85\xd4\xa8\x65\xea\xdf\xb5\xbd\x9d\xb8\xa2\x7c\x1d\x15\x09\x67\xe6\x3d\xb8\xe2\xc2\xec\xdc\xae\x6b\xd4\xfa\xf1\x7e\xe7\x27\xab\xcc\x59\x51\x85\xe6\xec\x6c\x5a\x1d\x02\x41\x4a\x40\x9f\x05\x39\xd7\x5d\x7f\x77\x88\xe8\x0b\x02\x65\x02\x7d\x01\x04\x33\x3e\xd3\x77\x4a\xb6\x42\xbc\xc7\x34\x92\xe7\xd0\x93\xc5\xce\xa3\x66\x46\x24\xce\xa7\x60\xce\xae\x97\xfc\x12\x12\x1d\xb2\xb0\x11\xa0\xab\x49\xe0\x9f\xd4\x26\x12\x2a\x1c\x19\x0f\x86\x1b\x1c\x4b\x8c\x20\x0e\x4a\x55\x13\xa6\xe8\x15\x76\x41\xef\x57\x59\xec\xa4\x01\xe4\xd8\xb3\xce\x0c\xdc\xdc\xaf\x0d\xe7\xf5\xc4\x68\x78\x7c\x8e\x85\x3c\xe0\x42\x25\x04\xed\x06\x0c\x3b\xec\xf8\xb1\xcf\x93\x43\xe9\x96\xde\x18\xcd\x71\x4c\x94\xd1\x70\xc4\x1b\x07\x3b\x8e\x2e\x27\x46\xe3\x4d\x3d\x13\xfd\x68\x7a\xcd\x71\x2d\x48\x58\x92\x1b\x68\x58\x6f\x6e\x42\x03\x3c\x91\xa4\x49\xbb\x34\xd3\x0c\xf0\xc4\x48\x55\xfd\x02\xe3\x78\x8f\xda\x55\x7f\x32\xa6\x56\x88\x4f\xe8\x04\x3f\x88\x10\x79\x27\xf7\xa6\x01\xec\x01\xeb\x86\x05\x50\x2a\x61\x92\xd2\xcc\x06\x2e\xa9\x7a\x46\xa2\xb4\x06\xf7\xd4\x69\x82\xcd\x7d\x2c\x1f\x2e\x2b\x63\xd0\xdd\x94\x23\x27\x3e\x00\x13\xa7\xac\x61\x8e\xc4\x7c\x8f\xb9\xeb\x80\xb2\x88\x35\x38\x65\xd6\x78\x84\xdb\x08\x96\x87\xa0\x81\x6c\xd6\x31\xc6\x2c\x52\xf7\x97\xe4\xa8\x42\xa8\xad\x9d\xf8\x4a\x61\xf5\x42\x00\xdb\x34\xac\xf1\x81\x4e\x90\x45\x33\xd4\x9b\x4c\x9c\xbd\x06\x9a\x55\x75\xd1\xb9\xeb\x85\xc0\x71\xff\x28\xde\x74\xc1\x4e\x4c\xff\x5a\x24\xe0\x4e\x2f\x20\x1e\xa5\x13\xfb\xe0\xcc\x41\x78\xa1\x38\xc8\x4c\x4d\x11\x64\x4f\x47\x6a\x42\xef\x38\xef\x99\xbb\x5d\xd7\x59\x3c\x4c\x8b\x05\x89\x86\x23\x61\x18\xad\x68\x6a\xd5\x0e\xd3\x49\xf4\x9a\x22\x56\x44\x49\xf4\x6a\xcf\x59\x2e\xa5\x50\x5c\xa6\xf7\x68\xe6\x21\x0e\x64\x39\xa8\x45\x8a\x5b\x61\x5e\x83\xb2\x8a\xcb\x15\x9b\x73\x40\x85\x18\x6a\x10\x06\x28\x15\xe3\xca\x2e\x93\xc3\x94\x4d\x40\x2a\x1c\xb8\x5a\xd9\xab\xcb\xbc\x24\x82\x31\x73\x70\x38\x21\x69\x4a\x05\xa5\x14\x28\x24\x11\x8e\x4b\xee\xf7\xd6\x72\x76\x17\xbc\xbe\x4a\x13\x35\x05\x11\xa8\xe3\x2c\x73\xf7\x72\x7e\xc2\x7b\xd6\x69\x92\xc5\x1c\x56\xed\x86\x55\xb0\x7d\x52\x73\xf5\xb6\x90\xd7\x95\x89\x98\x7d\x24\x89\x3b\xd5\xc3\x7a\x39\xef\x01\x2c\x44\xfa\xf4\x3c\xa4\x3d\x53\x13\x8e\xc3\x07\xb5\x18\x9d\xd6\x96\x9e\x03\xa6\x39\xb4\x97\xb5\x61\x67\x60\xd0\x9b\xeb\xcc\xe3\x69\xb8\xe3\x95\x0c\xba\x97\x18\x72\x65\x5b\xa6\xc0\x21\x2a\xf2\xd9\x7e\xd9\xdd\xe1\x83\x3b\x4b\xc5\xb2\xb4\x47\x92\xdc\xe1\x61\xe0\x00\x9a\x24\xd1\x33\x0d\x87\x8d\x36\x01\x61\x2c\xa8\xe3\xe8\xb0\x00\x9b\x24\x09\x43\x34\xbc\x2a\x72\xe4\x50\x8b\xa0\x3d\x3b\x34\x8e\xde\x27\x27\x24\xc5\xf5\xb8\x63\x2a\x06\x5d\xc0\xa4\xed\xbc\xd3\x02\xd2\xcb\x8d\x33\x6e\x10\x4d\xd5\x6d\x5a\x5d\xf8\xa4\x90\x4a\xda\xb7\x79\x29\x5a\xce\xbd\x75\x93\x53\xd0\x5e\xa1\x78\x0d\xa3\xf8\x78\x2d\x6e\xd7\x09\x14\x78\x5c\x1d\x2e\x86\xdc\x21\x49\xb8\xba\x1e\x81\x33\xb7\xdb\x32\x20\x11\xa8\x6b\x05\x75\x42\x98\xc8\xca\xa3\x5d\x3e\x09\x6e\x92\xcc\xc0\x84\x33\xd0\x72\xba\xd4\xf7\x09\x23\x31\xc8\x90\xb8\x31\x50\x23\x4e\xa8\x3d\xa8\xdd\x77\x58\x90\xc9\xaa\xb7\x2e\x3c\x0a\x82\x2d\x42\xdd\x55\x04\xf2\x56\xad\x88\xb5\x03\x5f\xd3\x02\x09\xa8\xac\x84\x8f\x82\x4a\x9c\x54\x42\xd2\x03\xa1\xb9\xb0\x44\x74\x8d\x0c\xe4\xd4\x96\xa1\x5c\xd8\xa2\x81\xdc\x89\xf6\x50\xdf\xd2\x14\xe6\x2c\x1a\xd0\x88\x8a\x3b\xca\xcb\x0e\xd9\xcd\x97\x83\xe4\x3a\xeb\x40\x23\x83\xd9\x32\xd6\x01\xa3\x7d\xb8\xa2\x35\x18\x55\xed\x33\x2c\x0d\x53\x5f\x27\x33\xc8\x12\x59\xa7\x99\xba\x1f\x76\x4e\xe4\xb9\xe0\xcd\x9c\x35\x29\x17\x58\x57\x45\xce\x7c\x4b\x90\x27\xcc\xe8\xfd\xc3\x74\xe2\xaa\x15\x10\xaa\x25\x2a\xa3\xa2\xf7\xa4\x08\xaf\xa5\xde\x5c\x6c\x74\xde\x81\xfc\xe4\x52\x8a\x07\x4c\xaa\xab\xdc\x18\x22\xe5\xe8\xb5\x56\x00\x91\x0b\x9c\xf5\x86\x97\xa0\xcf\x90\xe5\x00\xe8\xf4\xb5\x32\xef\x83\x58\x65\x22\x01\xee\x3c\xa9\x3e\x0e\x24\x4b\xd5\xc4\x8d\x36\x64\xab\x05\x0e\x43\x77\x82\x38\xc5\x3b\xce\x2e\x9a\xad\x45\x86\xb9\xa8\xc3\x91\x4d\x0d\x5f\x20\x72\xc7\x59\xd9\x09\x13\xed\xf4\xea\x69\x86\x9c\xee\x3b\xae\x8d\xd5\x38\x3e\x7b\xaa\x77\xb0\xcf\x61\xc0\x3b\x46\xcd\xd9\x55\xd6\x6b\xa5\xa5\x2a\x3b\xbc\x5d\x67\x13\xb8\x1e\x0c\xd0\x17\x58\x45\x63\xe4\x20\xd4\x88\x00\x1b\xd7\x1d\x14\xd7\xa8\x9b\x04\xed\xa4\xf7\x24\xd1\x02\x16\x09\xae\x30\xa1\x49\x7a\xdb\xa6\xac\x85\xb2\xc3\xac\x5d\x1a\x59\x19\x8a\x70\xf5\x76\x14\xc6\xa3\x7b\x8d\x46\xc6\x33\x8f\x98\xda\x2c\x32\x39\xcb\x58\xe9\xd1\x6e\xfd\x35\x75\x09\xae\x5e\xf3\xae\xb8\x25\xd5\x5c\x7a\x8a\x17\xf6\xd0\x91\x5f\x4f\x15\x42\x83\x7a\xa6\xb8\xca\xda\x8b\x62\xae\x73\x77\x4c\xb8\x88\xe4\xad\xb1\x11\xd0\xa2\x00\x42\xaf\xb2\xb3\x34\x59\x47\xcd\x49\x1d\x4d\xaa\xbc\x9d\xcd\xc0\xe0\xc2\x9d\x16\x17\x2f\xf2\x94\x5a\xce\x3b\x04\x0c\xe8\x36\x95\x89\xd3\x64\x3a\xf9\xc9\x90\x81\xb5\x69\x4b\x01\x94\xfb\xc2\x93\xc2\xe0\x12\xb6\x5a\x5a\xd6\xb3\x78\x41\xd5\xdc\x57\xca\x0b\x2e\x9b\x63\x15\x95\x77\x1a\x71\x16\x53\xd7\xb8\x11\x39\x73\x4a\x71\x1a\x70\xcd\xb2\x74\x2e\x1d\xce\xb2\xcc\xbb\xb5\x28\x49\xfa\x4e\x02\xfd\x28\xc2\x48\xed\x5a\x91\xb9\x19\x17\x3b\xd9\x6a\x7c\xbd\xe3\x12\x5b\xbb\x95\xb9\x0e\xf0\x58\x56\xec\x48\x8e\xab\xbd\x36\xeb\xc1\x10\x3a\x57\x5d\xa9\x97\xf9\xf1\x38\xf0\x82\x48\x90\x54\x73\xac\x28\xf5\x7a\x29\xca\x81\xad\x31\x56\x76\x1d\x79\x5c\xa2\xbb\x1c\xc9\x72\x26\x90\xc8\x41\x53\x08\x5c\x96\x31\x9b\x3f\xf3\xc0\xe8\xdb\xb0\x06\x80\x24\x55\x14\x8b\x03\x81\x44\x1f\x1b\x77\x56\xa4\x05\x7c\x6c\x91\xf2\xca\x67\x24\x14\x99\x07\x2c\x32\xc1\x9b\x08\xbb\x9d\xbf\xea\x57\x20\xf1\xce\x53\x79\xa3\x2f\xc1\xd5\xbc\x24\xee\xb1\x02\x43\x72\xa9\x35\x64\x21\xf2\x19\x83\x83\x79\x49\xa9\xb4\xd9\x99\x42\x56\x29\xa3\x61\xb2\x95\xc0\xbb\x27\x5e\xbb\xf0\x1e\x7e\x40\x65\xae\x21\x77\xe7\xc9\x93\xd9\xea\x4e\x71\x47\xef\x30\x1f\x8b\xf4\x64\xba\x6e\xba\xd4\xe6\x45\x01\xa7\x68\x3d\xf7\xaa\x0d\x63\xf5\x68\x96\x67\xab\x70\x73\x53\x5c\x9b\x41\x77\x81\xd1\x1d\x46\x8f\x61\x17\x8c\xbf\x55\x61\x79\xc8\xd8\x13\x3c\xa3\xe2\x74\x95\x4e\xf3\x22\x48\xad\x95\x61\x32\x92\x52\x4d\xa3\xdb\xca\x32\xf6\xbe\x2d\x63\x6d\x68\x46\x2a\x1f\xc2\xc8\x7e\xac\x4b\x38\x9a\x89\x5e\xbb\xdf\xa8\x73\x47\x1c\x90\x91\x90\xf6\xe7\x74\x2f\x61\x5e\xc7\xd7\x7e\x8a\xb6\x74\x3c\x12\x3e\xa9\x2a\xbb\x44\x3f\xa5\x68\x2b\xa0\x27\x6c\x11\x09\xf1\x46\xe2\x97\x93\x00\xef\xa1\x01\xd8\x67\x91\x73\xee\x02\x0a\x62\x6d\x48\xee\xfd\xba\x91\xb0\x1e\xec\x13\xc9\xdf\x25\x7a\xca\x94\xfe\x04\x4a\xab\xb3\xb7\xaa\x61\x3f\xd9\xe7\x23\x39\x22\xc4\x30\xb6\x14\x48\x8c\x4c\x91\x1e\xfb\xc3\x91\x3e\xd1\xa9\x7f\x4a\xd1\xbb\x95\xd6\x90\x73\x52\x57\x1a\xc8\x46\xf2\x8e\xe9\xc4\x59\x67\x1b\x02\x12\x3a\x68\xa2\x7a\x0c\x77\xc4\xde\x3c\x9b\xf7\xd9\x40\x8c\x4a\x8a\x1c\x88\xbd\xb5\x65\x97\x59\xb9\x34\x02\x49\xb5\x68\xe3\x9e\xda\xdb\x80\x35\xd7\x31\x5d\x75\x97\x13\x1b\xcd\x10\xde\xd6\x70\x56\x20\xb6\xe3\xf4\x5a\x74\x49\x30\x1f\xbe\xa3\x21\x1b\xeb\xca\xc1\xd8\xab\x7a\x54\x27\xed\x5e\x61\xda\x96\x10\xad\xd0\xe3\xf4\x0b\x74\xa2\xfc\xd2\x3a\x23\x47\xd5\x11\x22\xc4\x6f\x8f\x29\x24\x82\x80\x50\xe7\x1e\x4d\x92\xfb\xc5\x46\x23\x72\x80\x6f\x78\x6d\xee\x51\x2a\x0d\x2a\x9f\x69\x6b\xf8\x8a\x6a\xda\x9c\xa8\x96\xd5\x38\x4e\x2e\x21\x8b\xdf\x41\x87\xb3\xe7\x3d\xb2\x13\x02\xf7\x28\xba\x9e\xfb\xc0\xb2\xce\x9d\xd1\x3b\x0b\x46\x1a\x3a\xa3\x5e\x19\xfe\x52\xdb\x47\x8d\xb8\x8d\x90\xe6\x5b\x0b\x40\x51\x22\x7b\x28\x33\xf2\x28\x0f\x94\x7b\x15\xf3\x10\xf4\x0e\xf4\xc1\xc6\xb4\xea\x00\x2f\xc7\x84\x35\xf7\x17\xa0\x25\x86\xf6\xe4\x49\xfc\xe8\xac\xc7\xfb\x14\xcb\x74\x32\xe5\x4c\x74\x6e\xc8\xf4\x40\x51\xd2\xbd\xb6\xe9\x80\x29\x7b\x42\x12\xfb\x86\x42\x50\xbb\x36\xd4\x4a\x38\x02\x5c\xba\xc4\x8b\x46\xed\x2f\x54\xa9\x99\x7d\xe8\xec\xdc\xd8\xa2\x8f\x29\x44\xc7\xb8\x94\xec\x21\x69\x3f\x25\x37\xad\x91\xad\xd1\x9a\x18\xeb\x0a\xdf\x31\xf5\x5a\x41\x87\xb2\xa1\x95\xdb\x1a\x73\x93\xed\x83\xe3\x0c\xae\xfb\xe8\x3a\x71\xe4\xa1\x23\xf7\x7e\xd9\x99\xa0\x6d\x93\x35\x1e\xa3\x04\x7c\x81\xc2\x53\x23\xb3\x31\x0f\x20\x86\x17\x4a\x18\x38\x37\x66\x85\xb1\xb5\x53\xd7\xf7\xa6\x17\xd7\xa2\x98\x60\xaf\xd7\xa1\xbb\x94\x99\xa2\x7a\xed\x2f\x4a\xb3\xf0\x00\xdf\x92\xc8\x34\xdc\x4e\x8b\xb6\x98\x3d\x87\x4e\xb1\x64\x68\x9e\x77\x12\x28\x68\x75\x89\xeb\xbc\x2b\x06\x3d\x0f\x74\x22\xab\x3a\x87\xc5\xce\x3b\x86\x5d\x26\x7a\x57\x69\xb3\xd8\xf4\xf1\xc2\xce\x41\x0b\xdf\x54\x5f\x6a\xd9\x4a\x4d\xd6\xe0\xba\xe7\xf4\x44\x3a\x21\x50\x99\x0f\x3b\x68\x5f\xe7\xac\x89\x73\xba\xc6\xf7\x94\x8b\x86\xca\x1d\x14\xe5\x3a\x62\x65\x1d\x4f\xeb\xcc\x17\xb0\x0a\x11\x44\xaf\xec\x0c\xda\xb1\x89\x23\xd2\x06\x07\x43\x38\xce\x88\x54\x47\x54\x03\x1e\xc3\x8b\xc9\xd8\x7a\x47\x5d\xd0\x34\xb2\xce\x3d\x19\x89\x77\x64\x3d\xe1\x6b\x1c\x65\xb9\x88\x50\x70\x00\xee\xd4\x73\x03\xed\xe9\x0b\x70\x3a\xa3\xb1\x22\xf9\x0d\x6e\xb3\x37\x5a\xe2\xc0\x88\xb6\x23\xa7\x37\x2e\xbb\x81\x29\x9d\x11\x8d\x30\x24\xdb\x09\xc5\xd0\xeb\x1c\xbe\xd7\x1a\x86\x10\xe5\x50\xd4\xe7\xa4\xb8\x04\x0d\x02\xa8\x97\x95\xb8\x2b\xd9\xba\xc4\x99\x91\xb1\xce\xda\x79\xa0\xcf\x1a\xc4\x95\x28\xc9\xc4\x04\x35\x34\xdc\xd3\x59\x9f\x46\xf8\x52\x07\x76\x16\xdd\x0e\xab\xc8\x8f\xca\x74\x6a\x5c\x16\x72\x82\xf2\x72\x4e\x69\x0a\xca\x00\x32\x72\xe4\xcb\xec\x2e\xa4\xe6\x52\x7b\x61\xd1\x5b\xc3\x56\x08\x5c\x1d\xc3\x5c\x07\x63\x29\x3a\xe9\x46\x44\xb4\xf0\xdc\x04\x8a\xb0\x9b\x96\xb4\x09\xd5\x06\x71\xa9\x31\xee\x6b\xa7\x53\x56\x75\x65\x61\xa1\xbf\xf7\xf3\xc1\x0f\xea\x10\x22\x6e\x26\x26\x07\x17\xed\x2e\xb8\xf2\x1a\x26\x45\xeb\x2b\xfb\xbe\xcf\x2e\xca\xd9\x8b\x54\x70\xe4\x5a\xb0\x5e\x5a\xe8\x00\x9d\x1a\x03\x04\x92\xa8\x64\x8d\x94\xef\x03\x61\x41\x41\xb2\x86\xa8\x8a\xf1\x8c\xac\xeb\x2f\xae\xc1\x1b\x77\x1c\xf4\x04\x83\xb8\xed\x74\xf6\x7c\x2a\x27\xfe\x22\x38\x77\xd3\x00\x20\xdf\x38\xde\xb9\xc3\x32\xa3\x85\x28\xe1\xa7\x4a\x50\x65\x21\xef\x5a\x3e\x60\x25\xd8\x4b\x77\x29\xb6\xbb\x8d\x77\x0e\x91\x62\x42\x26\xfc\x12\x37\x32\xe3\x28\x60\xc1\x65\xa7\xf2\xab\xa1\xb1\xdc\xde\x1d\x9d\x92\x30\x52\xe5\x7e\x23\x76\xa6\xcb\xb6\x5d\x06\x51\xe9\x0c\x42\xbe\x8a\x5c\x0a\xa2\x22\x58\x66\x6d\x15\xee\x90\x60\xd1\x7e\xbf\x53\xa8\x74\x09\x10\x5f\xe1\x39\xdd\xcb\x2e\x27\xb0\xbd\x28\x58\x71\x0e\x21\x59\xba\xcc\xa7\xb8\x3f\xb4\x80\x07\xab\xf8\xc1\x67\xbd\x25\x58\xf6\x8a\xab\xd0\x1d\x64\xb0\xa8\x35\x9d\x68\x50\x00\x11\x79\xba\x05\x84\x18\xec\x62\xdd\x1e\x7d\x0c\x90\x35\x75\x85\x3b\xdc\x82\x78\x43\x1e\xf8\x8c\xe9\xae\x55\x84\xb9\x64\xb2\x60\x46\x99\x59\xc1\x1a\x53\x62\xef\x26\xd8\x68\xd0\x0b\x3e\x90\x78\x76\xbb\x38\x91\xd2\x67\xe2\x81\xef\x2e\x13\xe7\xba\xf0\xdd\x4e\x25\x8f\xb0\xc0\xab\x4a\x2f\x14\x3b\x15\x48\x68\xd4\x31\x69\xe4\xed\x94\x8f\x85\x87\xb8\x37\xc3\xbf\xb2\x96\x61\x0b\x77\x27\x70\x6f\x96\x71\xd0\x62\xd1\x16\xfb\xdb\xb9\x28\xc1\xc3\xc9\x55\x8d\x32\x6d\x9c\x02\x26\x67\x10\x1c\x19\xbf\x65\xb2\x65\x0a\x2f\x3a\xcf\x11\x5c\x77\x0b\x98\x19\xf3\x18\xd4\x64\x4b\x1d\xec\x26\x9c\xc2\xf5\xcb\x7a\xcf\xa6\xb2\xd3\xec\x9e\xea\x2e\x32\xdd\xc4\x65\xc3\xce\xfb\x7d\x8d\x58\x7c\xb3\x2b\xfb\xd8\x54\xa5\xf8\x1c\x4a\x7b\x24\x48\xf4\xd5\x34\x80\xba\x48\xf0\x36\x23\x98\x4a\x84\xb5\xab\xe2\x2c\xb1\x0c\x07\x9e\xae\x45\x11\x6b\xde\x57\x85\xf6\x7a\xbb\x05\xcf\xe1\x6e\x76\xe8\x13\xc6\x54\xbd\x3d\x88\xfc\x72\x76\xe7\xb3\x91\x1f\x4f\x13\x83\xae\x57\xd3\x90\xfc\x56\x0d\xa7\x78\xc7\x35\x20\x39\x5e\xc6\x63\xe8\x25\x75\xcc\xa7\x25\x16\x49\xd7\x66\x47\x82\x70\xbd\xc3\x80\x6c\xf6\x8b\xc8\xd6\x2e\xd6\x19\x57\x9d\x05\x88\x92\x08\xbb\xd7\x80\xa3\xc9\x07\x63\x4a\x62\xf1\x16\xa5\x48\xdf\x44\x12\x7d\x6d\xab\xaa\x40\x5d\x83\x8e\xc8\x6b\xcb\x51\x39\xd8\x07\x23\xa5\xd5\xd8\x1d\x4e\x7b\xab\xbb\x49\x1c\x59\xc3\x49\x92\x1e\xa5\x75\xe7\xc5\xbe\xa6\xbb\xdc\x4d\x02\xb8\xa0\x07\xe7\xe0\x30\x9f\x53\x83\xdc\x17\x23\x28\x1d\x04\x88\x1d\xef\xad\x24\x0a\x29\xb2\xd6\xe6\x35\xb6\x68\x54\x66\xfd\xbe\xbf\x30\x5a\xd6\x0e\x0c\xda\x6a\xbb\xab\x60\xed\xec\xb6\xc7\xcb\x38\x85\x89\xd9\x3b\xdc\xac\xf0\xc2\xe4\x18\xb9\xc3\x65\xc6\x15\x91\x9d\x2a\x5a\x19\x05\x50\x77\x39\xb5\xd9\xb0\xb4\x5a\xb8\x1a\x54\x06\x6c\x61\x17\x4a\x85\x33\xa9\xd1\xb9\x1c\xd0\xe8\xe1\x9e\x76\x93\x3e\x66\x5a\x91\x58\xb7\x6c\x38\x49\x8b\x43\x1e\x77\x68\xda\xa2\xfd\xd5\xbc\x3a\x8b\x2e\x49\x4e\x75\xf3\x11\x0e\x6c\x4a\x3b\x54\xaa\xab\xcb\x26\x07\x12\x73\x38\x4c\x90\x77\x9a\x75\xf7\xc2\x4e\x43\xfb\x0a\xbc\xdc\x64\x22\x29\x5a\xa7\x20\x1c\x4b\x4a\x4b\xc5\xa0\xe9\x26\x59\x69\x44\x51\x25\x84\x83\xce\x52\xeb\xec\xe7\x3e\xbf\x22\x01\x47\x7b\xa1\xd4\x43\x76\x15\x61\x85\x99\xa3\xd7\x45\xc5\xa7\xf3\x89\xb9\x23\x95\x71\x8d\x56\x00\x80\x11\xf8\x28\x2b\x0d\x3c\x39\x23\x7c\x80\xe1\x81\xb3\x21\xbd\x04\xab\xf0\xb6\x96\x87\x6c\xb2\x95\x11\xb8\x68\xa7\x9b\x47\x65\x58\x6b\x18\x2c\x7d\x16\xf6\x59\x82\xa0\x57\xb7\xf5\x42\x89\x62\x52\xef\x76\x82\x6b\x89\xad\x58\x37\x3e\xb0\x25\x54\xed\xd1\x3e\xd7\x01\x3c\x8c\x14\xb4\xd9\x0b\xbb\xe0\xa4\x19\xf1\x8e\x8c\x90\x88\x73\xa5\xd9\x8e\x8b\x7b\xbb\xe6\xa0\x2b\x4b\x48\x5b\xa1\x75\x46\x3a\x7e\x70\x07\xaf\x20\xb3\x9f\x9c\xde\xca\x54\x4d\x31\x0e\x7d\xeb\xed\x9a\x01\xe7\xa7\x7b\xaa\x22\x80\x26\x13\x38\xdb\x02\x94\xd8\xe5\x63\xbc\x24\x5a\xe2\x64\x76\x2a\x0b\x17\xe2\x16\xd6\x30\x29\x91\xfd\x3d\x43\x69\x83\x9f\xe0\xcb\xcd\x46\x6f\x4c\x3a\x77\xfa\xe2\x4e\xb6\xcb\x1d\x34\x3b\x1d\x4c\xb3\x71\xf7\x10\xc9\x9b\xc8\xa9\xc0\xca\xbe\x6f\x18\x2f\xcb\x66\x68\x16\x0c\x9d\x92\x2a\xdd\xa1\xa8\xe3\xf5\x38\x01\xe2\xaa\xce\xdd\xe0\x11\xf4\xa8\xef\x53\x34\x9c\x75\x9c\xac\x0d\xb6\xbc\xba\xc6\x55\xbb\xec\xb9\xb6\x14\xee\xc3\xfe\xce\x68\xaa\x06\x68\x30\x0f\x78\xc7\x4e\x19\x58\x98\xe7\x61\x9e\x8f\x52\xe6\x6e\x82\x28\xad\x80\x3a\x95\x3b\x8c\x01\xb1\x42\xce\xc3\x5c\x74\x5c\x6c\x49\xf0\x60\xb4\x88\xa5\xf5\xac\x57\x76\x1f\x38\xf7\x93\xe6\x2e\xe3\x61\x16\xe1\x84\x1d\xd9\x78\x17\xd9\xd1\xca\xee\x66\x8e\x4f\xaa\x62\xae\x8f\xe8\xb2\x92\xc8\xe9\x88\x81\x9d\x55\x08\x10\x8b\x98\xed\x7e\x9f\x37\x47\x5c\x1d\x05\x88\xba\x21\xe1\x8a\x2d\x17\xb5\x94\x41\xfa\x66\x1e\x98\xf4\xa0\xef\xee\x3a\x1e\x95\x09\x2d\x9c\xc7\x25\xc4\x65\x0e\xe0\xab\x3d\x7a\x64\xf2\x65\x1f\x43\x27\xd0\xd2\x50\xb6\xa2\x80\x12\x64\x1c\xed\xa0\x87\xcb\x74\xa4\x76\x48\x37\xb8\x72\x2b\x0d\x92\x6b\x8f\x2c\xd2\x16\x90\xae\x59\xb5\xe7\x43\x9c\xe2\x6b\x39\xac\xe5\x17\x0e\xc9\x2e\xea\x69\x67\x47\xd6\x78\xb9\xe1\xf8\x1a\x88\x96\x3f\xef\x14\xdd\xd7\xe2\x06\x01\xd2\xae\xa7\x65\xf9\xa6\x5e\x5a\x23\x26\xb3\xbc\x3f\xe3\x26\x4a\x08\x2c\x5a\xee\x25\x65\x75\x1b\xef\x60\x79\xfc\x24\xc3\x3c\x27\xe3\xc3\x55\x38\x46\x20\x6f\xc2\x39\x24\xa7\x2e\x47\x5c\x24\xf6\xe4\x0f\x62\x4a\xdf\x30\x90\xdd\x55\xe6\x9e\xa5\x8b\x36\xd0\x4f\xe2\xb1\x97\xc0\x74\xb4\x95\xdb\x2c\xc1\x17\x1c\x31\xca\x72\x97\xa1\x12\x79\xed\xd9\xe4\xff\xe3\xd8\xae\x6d\x28\x37\x00\x00\x80\xee\x92\xee\xe4\xc2\x4c\x4a\x65\x66\xe6\xdf\x99\x99\xd9\xd3\x47\x97\x25\x5e\xf7\x10\x13\x90\x6f\x53\x81\xae\x9e\xf6\x8e\xa1\x23\x73\x37\xf1\x9e\x1d\x2f\xaf\x64\xc9\xdf\x70\xf1\x1c\x5b\x4f\xb7\x70\x79\x11\x7c\x42\x81\x1a\x27\x53\x70\xda\x12\x6b\x51\xea\xf2\xce\x96\x6f\xef\x5a\xcf\xef\xc6\x1f\x0f\xef\x0c\xe8\xbc\xf0\x5b\x3a\xbb\x0f\xa1\x4d\x78\x07\x55\x50\xb5\xf6\xf1\x25\xc9\x42\xce\x11\xc1\xdd\x2f\x9e\x48\x91\x07\x90\xa8\xb0\x4e\xf5\x36\x59\x9c\x44\x36\xb7\xac\x77\x4d\xb3\x1f\x5c\x19\xa4\x58\x16\xb2\xfa\x63\xa5\x72\x45\xa4\x49\x17\x3d\x90\x9f\xe6\x29\x6f\x2d\x7e\x72\x19\xcb\x2a\x5a\xe6\x68\x70\xf3\x82\xf7\x00\x49\xcd\x99\x8c\xdc\x75\xac\x7d\xfe\x97\x42\x8b\x24\x4e\x46\xd4\x7c\xda\x92\xb1\xd9\x0c\x68\x89\x7c\xaa\x5a\xb0\x95\xbd\xf3\x02\xc3\x80\x80\x44\xd1\x34\xac\x70\x8d\xe4\x88\x41\x5e\x20\x21\x27\xa8\xce\xae\x07\xfe\xd8\x42\xec\x71\x00\x0e\xc4\x65\x4a\x69\x32\x1b\x4d\x5b\x98\x3f\xd9\x5b\xed\xa8\xb6\xdd\xbc\x1c\x66\x40\xc7\x7c\xab\xc2\x3d\x71\x06\xd7\x22\x5f\xc9\x6f\x08\x9e\x46\xc8\x84\xd2\x84\xbc\xb9\x8f\x19\x5a\xdc\xb3\x6e\xce\xe0\xde\x52\xd4\x43\xc2\xc3\xec\x60\xb1\x24\x21\x14\x3f\x3c\x34\xcc\xb1\xcd\x6d\xfd\xc6\xee\xa7\x01\x41\x26\xce\x94\xa7\xf3\x54\x5f\xa6\x72\x11\x55\x4b\x8d\x6c\x6b\xd6\x71\xea\xf9\x84\x2c\x3b\xb6\x8e\xd7\x9e\x98\x61\x93\x36\xd9\x30\x5e\xe3\xda\x88\x99\x65\x07\x9a\x73\xbc\x63\x38\x23\x6c\x65\x52\xfa\x04\x67\xb4\x50\xd0\x20\x54\x73\xaa\x98\x41\xf7\x91\x18\xc4\x3e\x5a\x58\x30\x7e\x94\x02\xcf\xda\xe6\xf6\xbc\x30\x46\x2d\x0d\x58\x9e\xb7\x94\xd2\x10\x18\xee\x4d\x1a\x48\x35\x8c\xef\xc0\x60\x38\x6d\x82\xa8\x75\x5a\x79\xbd\xa7\xe1\x94\xfd\x20\x14\xa7\x77\x09\x32\xde\xaa\x70\x8a\x07\xc8\x0e\xf2\x34\x71\xcc\x4a\xc4\x0e\x4d\xd2\xba\x28\xb1\x7d\x06\xe7\x5a\x66\x32\x69\x0a\xb8\xeb\x21\x11\xa5\x5b\xaf\x5b\x22\xc6\xb5\x0e\xb6\x81\x3f\x3f\xcd\x45\x45\x7d\x44\x02\x3c\x36\xdf\xae\x8e\x98\x80\x4d\x4e\x65\x36\xbb\x3b\x6f\x0c\x0a\x2f\x3e\xb0\xf5\xa2\x1b\xa8\xe3\x54\xd6\xd2\x20\x7f\x64\xc8\xd4\xbc\x74\x6a\xed\x86\x11\x8c\x5e\xd6\xc9\x99\x6b\xcb\xc4\x34\x3d\x60\x18\xaf\xfc\x03\x4c\xb1\x30\x69\xad\x0a\xc5\xed\x93\x7e\x8b\x9a\x5e\x2b\x63\x90\x3d\x97\xfd\xa8\x88\xb3\x06\x19\xd9\x3e\xff\x77\x54\xf7\x57\xac\x73\x29\x3a\x35\xc5\x2e\xd5\x53\x84\x8f\xcb\x41\xdb\x8b\xa3\xaf\x8e\x5e\x62\x64\x8d\xa2\xf8\xe8\x07\xed\x18\xe6\x02\x6a\x26\x1c\xdd\xbe\xe2\x59\x3d\xa9\x66\x72\x60\x26\x4a\xd5\x21\x99\x4a\xec\xcd\xe2\x18\x10\xd2\xf1\x50\x9b\x80\xa4\x69\x6e\xd9\xea\x73\x76\xe7\xe7\x91\xc0\x7b\x9d\x7e\x3f\x23\x41\x82\x3f\xd6\x7e\x51\xaf\x7a\xbb\x0c\xe5\xc5\x9b\x98\x83\xec\xd6\x23\xed\x0e\x62\xd0\x67\x55\x42\xd8\x7e\x91\xbc\x50\x5b\x6f\xca\xec\xc7\xdd\xea\xa4\x88\x41\x5e\x5b\xcb\x88\xa8\x86\x10\x5d\x59\xbd\x7e\x6f\x42\x05\x04\xcc\xc9\x4d\xf0\x8b\x7a\x28\x63\x81\xa5\x03\xdb\x32\xa0\xa4\x8d\x12\x44\x0b\xd6\x7a\xb5\xd4\xb3\x78\x7c\x70\x80\x44\x40\xb9\x3b\xae\x20\xfa\x71\x85\x2d\x7a\x24\x85\x2f\xde\xad\xa9\xf0\x04\x10\xdb\x50\x76\x15\xe0\xe1\xb9\xdf\xf4\x79\x95\x57\x62\x0a\xca\xe4\xc7\xf1\x79\x3f\xe2\x84\x91\xde\x98\x4f\x7d\x02\xd1\x18\xce\x04\x85\x5b\x1b\xdf\x15\xd8\x8e\x4f\x11\xa7\xf9\xeb\xb8\xdc\xeb\x09\x36\x7d\xf0\x60\xb4\xa0\xb2\x1b\xe7\xf0\x95\x41\x9d\x5d\x08\xa2\xe6\xd3\xa1\xae\x60\x3b\x2b\x3e\x33\x10\x91\x86\xe8\xfc\x06\xd2\x48\xea\xf1\x9d\x96\x64\x5a\x77\x39\x9f\x9b\xcd\x7b\xe4\x7a\x50\xf3\xce\xc3\xbe\xb3\xee\x6e\x1f\x02\x51\xb5\xc3\xfa\xc8\x26\x38\x6a\x0a\x20\x4d\x5f\x81\x0f\x3d\x75\x57\xeb\xf1\xaf\xea\x7f\x5b\x12\xc7\xd8\xe6\xb7\xec\x02\x18\x9b\xad\x43\x60\x68\x90\x12\x8c\x3e\xbc\x95\xc7\xd7\x52\xd3\x5b\xec\x19\xf9\xab\xc8\xc7\xba\x24\xcb\x4d\xc4\x54\xf9\x0b\x49\x9e\x32\xda\x5d\x9d\x3a\x99\x43\x11\x1f\xef\x4b\xcf\x31\x92\xa4\xd6\xc0\xc2\x0a\x05\x13\x91\x66\x0d\xf1\x56\x7f\x37\x8f\x67\x99\x9d\x07\x8b\xc0\xa5\x8c\xc2\x14\x76\x16\x26\x95\x2b\x45\x67\x70\x36\x48\xd3\x52\x5d\xea\xde\xb3\x5c\x29\x6f\x68\x6f\x13\xbd\x07\x17\xb3\x17\xbe\x23\xbd\xcd\x0d\x7e\xbc\x25\x1a\x71\xad\x8d\x75\x01\xcc\xa0\x5b\x6d\x85\x05\x3b\x80\x68\xd9\x87\xa8\x4e\x6b\x2c\x42\x47\x2f\xda\x6f\xf9\xe2\x44\xc2\x68\x22\x9d\xa8\x37\x29\xa1\xd6\x1d\x2a\x63\x0a\x01\x65\x56\x93\x16\xee\xf2\x9d\xa6\x98\xa2\x1f\xc4\xfc\x51\x3a\x4b\xd2\xbf\x6b\x76\x55\x72\x5b\x85\x17\x02\x72\x67\xbf\x15\x66\x8a\x22\xa1\x81\xc1\x2a\xe6\x08\xee\xad\x3f\xdd\x25\x1d\xf8\x4c\xa1\xb8\xba\x94\x46\x3d\x40\x12\x73\xa9\x27\xc2\x7a\x71\xe3\x81\x17\x41\x2b\x6f\x6d\xc7\xde\x7d\x45\x11\xf8\x66\x91\x3b\x3c\xda\x72\x76\x47\x4f\x05\xda\x27\x2c\x04\x18\xd6\x87\xb9\x1e\x67\x0a\x67\xff\x34\x4c\xda\xcc\x30\xf7\xd7\x7a\xd0\x4d\x98\x49\x8f\x0f\xec\x93\xde\x1c\xc2\x55\x2f\x03\x0e\x2e\x52\x1e\x8e\x4f\x57\xd3\x0f\x3e\xce\xd1\x39\xd8\x5f\x81\x09\x21\xce\xc1\xaa\x59\x79\x33\xf4\xf3\x72\xe1\xa8\x2d\x04\xe6\x55\x3c\xd8\x9f\xe1\x4b\xbf\xdc\xda\x7e\x4b\xd0\x8e\x96\x5e\xa2\x06\x47\x1c\x1a\x04\xfb\x5e\xd1\x95\x6f\x18\xb4\x40\xe1\x5c\x8d\x33\xdd\x87\x84\xbc\x61\x86\x57\x21\x98\x4c\x45\xcc\x71\xc1\x35\x96\x89\x20\x84\x3c\xda\x1b\x00\xe5\x85\xde\x2b\x6b\xc8\x09\x32\xc5\x54\x1f\x9f\x88\x7f\x6a\x46\xfd\x6e\xe1\xa4\x4d\x57\x67\x77\x57\x32\xcf\x23\xb8\x2f\x1d\x3e\x08\xfd\x65\x12\x28\x32\xd5\x8f\xa3\xdc\x44\xbb\x2f\x45\xf0\xd2\x0a\x2f\x96\x24\x00\x5f\x0d\x50\x86\xdc\xf7\x1a\x85\x84\x6c\x50\x26\x8d\xb5\xee\x7f\x3d\x07\x1d\xe8\xa8\x86\x3e\xb2\x83\x83\xc9\x8a\xbf\xd9\xde\xa3\x71\xce\x6e\xf5\xb2\x24\x0a\xf9\xdd\x89\xa4\x55\x62\xbb\x9e\xfa\xe1\x0e\x6c\xdd\xd6\xbf\x37\xec\x04\x63\x5c\x3e\x4b\xcc\x4e\xec\x95\xb9\x57\x9d\x5d\x90\x4b\xc0\x7d\xc4\xdd\x32\x29\x91\x8c\xce\x0f\x40\x53\x9e\x56\xf6\xdd\xe8\x84\x13\xcb\xa5\x63\xce\xc5\x1c\xf4\xf5\x30\xef\x62\xb4\xd0\x98\x79\x26\x6c\xea\x34\x62\xa2\x9e\x3f\x35\x32\xc6\x49\x56\x5f\x35\x31\xee\xbd\x38\x8e\x9b\x72\x1e\xf0\xb2\xda\x9f\xd9\xc5\x99\xcb\x2b\xa5\x91\xa0\x70\xdc\x61\xf2\x7a\xa5\x1d\xa3\x43\x1d\xa3\xa3\xf5\x0e\x1f\x28\x2b\xb8\x35\x03\x8a\x82\xea\xa4\x6f\x4c\xa3\xc9\x98\x7f\xda\x2f\x22\x56\x61\x9f\x5a\x35\x04\x74\xc7\xb2\xd5\x9d\x54\x09\xcb\xa6\xc5\x9c\x4a\x81\x3c\x62\xa0\x8b\x4e\xe5\x30\x44\x5a\x63\x55\xa6\xeb\x77\xd1\x2b\x16\x2e\xe6\xa1\x60\x0a\xa4\xf4\xfb\xb0\x9e\xe2\xf8\x2b\xd1\xc1\x50\xb2\x8c\xb6\x4b\x14\xde\x26\x53\x0e\xfb\x9e\x20\xd4\x2d\xb1\x07\x51\xbc\xc5\x83\xe7\x61\x51\x2a\x9a\x75\x31\xaa\xca\x74\x4c\x9f\xa2\xfd\x19\x3e\x39\xc9\xe7\x74\x2f\x8e\x7b\x8c\x51\x71\x5e\x82\xc3\xa2\xd4\x71\xc4\x5e\x38\x72\x88\xaf\xa9\x0d\x15\xb7\x78\xb4\xd1\x03\x04\x79\xc2\x13\x49\x89\xab\xa2\xc7\xea\x47\xaa\x1a\xff\x90\x5d\x4e\x9d\xb8\x0b\xd8\xf3\x6e\x67\x43\x95\x4f\xd5\x0d\x4a\x25\xab\xcf\xb0\x89\x26\x9d\x1c\xba\x32\xbd\xba\x5d\xe9\x6d\xce\x7d\x89\x50\x5c\x76\xcc\x7b\xb9\x1d\xc3\x74\x33\x9c\x06\xad\x8d\x89\x02\x06\x0d\xe0\x67\x5d\x78\x15\x9a\xf8\xdd\x20\x82\x00\xde\x52\xc5\x93\x86\x55\xb7\x16\x62\x26\xf6\x1b\x0e\xa2\xf5\x50\x75\x6a\xf0\xb4\x7a\xeb\xcb\xe9\x35\x9f\x93\xee\x92\xd3\x36\x7c\xba\xc3\x6a\x51\xb9\x3b\x36\x9b\x4c\x36\xf7\xa3\xdc\x9e\xb2\xb1\x42\xc2\x70\xbf\xb2\xba\xdc\xc4\x8e\x9d\x88\x29\x22\xca\xf4\xad\x5d\xc7\xab\x45\x64\x0a\x72\x13\x3d\xee\xa3\x6e\xd7\x11\x2f\x73\x28\x0c\x8e\x78\xb5\xfb\x63\x3a\x6a\x56\x76\x8c\xca\x7d\xf7\x4f\xaa\x04\x39\x6a\x7c\x59\xd5\xbd\xcf\xac\xce\xd2\xec\xaf\xaa\xd0\x61\x4e\x03\x6f\x64\xcd\x04\x76\x88\x9c\xb6\x7c\x6e\x6e\x50\x30\x98\x8a\x52\x15\xc5\xb5\x17\x34\x17\xf2\x97\xc8\xc7\xee\xfa\x48\xd9\x2e\x3a\x2a\x62\xd9\xcf\x2d\xd6\xe3\x5e\x24\xf0\x23\x12\xc9\xd6\xa7\x04\x61\x93\xdc\x40\x8e\x1f\x04\xfd\x84\x39\x5e\x16\xd0\xce\x0d\xa5\x37\xe3\xcd\x0c\xb4\x31\x5d\xa6\x93\xe6\xba\x8d\x6d\x14\x11\x0a\xe9\x59\x2b\x31\x7d\xc3\xe0\xb6\xb0\x41\x55\xaa\xc1\x18\xe5\x99\x43\x10\xbd\xfc\x68\xc9\xfe\x7a\x1f\x27\x09\x1e\xe6\xb0\xf3\x88\x96\x19\xb5\x3d\x38\x1a\xc2\x01\x3e\x8b\xe1\x52\xc2\x95\xcb\xad\xd3\xa3\x4b\x18\xec\xa2\x68\x65\xf0\xf0\x0c\x42\x2b\x2a\xf9\x0d\x93\x04\xee\x37\x7a\xd3\x02\xc2\xa9\x30\xdf\x8c\x15\x6e\x05\x9b\x1b\xbb\x1a\x49\xee\xca\x0f\xe9\x77\x2b\x39\xe7\x25\x36\xc1\xc6\x54\xfa\xc6\xcb\x34\x9d\x9f\x8b\xb6\x92\x15\xc6\xed\xd1\xd9\x4c\xbb\xb4\xf2\x6c\xa9\x06\x78\x92\x9b\x5f\x02\xd3\x66\xd3\x82\x5a\xe4\xa3\xfc\x37\x42\x7e\xe2\xc0\xcd\x53\x9a\x08\x40\x1b\x30\xee\x5e\x05\xbe\x6c\xe7\x1e\xd7\xa1\x56\xaf\x73\xb1\x0e\x3b\xeb\x78\x51\xae\x39\x2b\x70\x34\xc2\xe8\x1a\xb7\x36\x24\xa7\xa2\x7d\xd5\xaf\x92\x03\x03\x8f\x21\xaf\x50\xaa\x21\x9b\x30\x15\x50\x55\x8a\x61\x47\xf3\x1a\xc4\x1f\x87\xe8\x8b\xba\x00\x03\xee\xb9\xaa\x56\x5d\xef\x0e\xec\xac\x31\x8a\xfa\x67\xbd\x7e\xc6\x18\x81\x46\x87\xdf\xef\x87\x3d\x1d\x1d\xf0\xb6\x9b\x29\x53\x1d\xad\xcf\x3d\x93\x85\xb0\xec\xa9\x52\xe4\xb9\xf0\x90\xde\xda\xce\x18\x2a\x35\x2a\x8f\xbc\x09\x09\x93\x79\xc5\xa5\x12\x4e\x29\xc2\xab\x62\xf3\x49\xbd\x2c\x44\x55\x25\x05\x7b\xbc\x73\xbc\x40\x1c\x42\x97\x39\x6c\x52\x8a\xad\xbc\xfe\x20\x8d\x54\xff\x1a\x8e\x16\x5a\x99\xfc\x41\x6b\x60\xd2\x37\xb3\x79\x1a\x81\x2d\x82\x13\x26\xf4\xb7\x84\xbf\xd4\x6d\x4c\xf4\x9d\xcc\xa8\x15\x45\x4e\x96\x91\xcc\x44\x1d\xdf\x97\xb4\xa7\x08\x39\xd5\x65\x9f\x4a\x53\x02\xb0\x03\xc5\x4f\x0c\x69\x4e\x22\x7f\x43\xe8\xdc\x41\x0a\x7e\x7a\x1e\xf7\x8f\x12\xaf\x5f\x06\x0b\x56\x22\x46\xd5\x24\x7e\x8a\xed\xfa\x9f\x51\xa9\xe3\xfc\x71\xc3\x08\x54\xb1\x2c\x15\x15\xd0\x4a\x9c\x45\xdc\xd3\x2b\x4a\x93\x34\x31\xfd\xbc\x57\xee\xdc\xf7\x16\xb1\x6b\xd1\x07\x63\x63\xba\xe2\x96\xba\x0a\xa9\x90\x87\x5c\x98\x17\x0d\x82\xec\x73\xaf\x8f\xdf\x04\x7f\x93\xa7\xea\xcd\x69\x67\x5b\xb0\x44\x71\xd8\xf5\xf6\x1a\x64\x19\xac\x42\xa0\x66\x68\x2c\x58\x44\x64\xd9\xdc\x16\x5d\x58\x6a\x8f\x6d\xd0\xf5\xa1\x7c\xb4\xdb\x2e\xc6\xce\x4b\x7f\x7b\x9a\x52\x4f\x97\x93\x61\x76\x2e\x21\xf6\x8f\x68\xdf\x40\x28\x7e\x7a\xa5\x76\xe3\xb1\x6a\x62\x40\x30\x84\x56\xfa\x69\xd8\xd4\x8b\xdf\x18\xa1\xd4\x53\x74\xd9\xa0\xf2\x98\x74\x14\x3a\xb6\xb0\x18\xa7\xf8\x82\x65\xf4\x69\x98\xb0\x77\xb0\x03\x54\x0d\x3b\x20\xb2\xf2\xe3\x44\x8b\x4a\x87\xd0\x5d\x74\x4f\xa6\x94\x5c\xa4\xef\x75\xca\xed\xe7\x48\x7d\xa6\x18\xc8\xdf\xa4\xbc\xbf\x1d\x32\xe4\x2a\xcd\x41\x20\xcb\x8c\xd3\x84\x62\x47\x43\x4e\x0e\x62\x13\xcb\x15\x3d\x37\x61\xe4\xd2\x98\xa6\x97\x0e\x82\xaf\x07\x14\x1c\x6a\x5d\x66\xce\x21\xac\x86\xe4\x6e\xe9\x6e\x87\x0d\x23\x9d\x2d\xe3\xf8\x62\xac\x94\x92\xb6\x6d\x21\xd4\xa2\x5b\xbc\x70\x9b\x24\xd7\x39\xb5\xf1\x1d\x04\xf4\xfc\xd5\x96\xc4\x35\x35\xac\x8c\xac\xc5\x1f\x4d\x01\xdb\x92\x80\x28\x26\x12\x4c\x46\xbb\x95\x9a\x70\x37\xfc\x0e\x95\x3a\x71\xa7\xfd\xd8\xe4\x00\x45\x75\xc1\x6b\xe4\x28\xa5\x91\xe5\x63\x0a\xda\xbe\x48\xc7\x86\x3d\x06\x8b\xa9\x5f\x27\xe7\x66\x24\x3f\x4e\x0c\x24\xaf\x2f\xbe\x8f\x0f\xbb\xc3\x38\x83\x78\x62\xa5\xec\x60\x65\x08\x4a\x0b\x1e\xec\xaa\xe7\x03\xa6\xd4\x5e\x52\x36\xa8\x76\xfc\x27\x9d\x8d\x70\xd4\x31\xf1\xa6\x42\xf6\xd3\x08\xab\x00\x13\xa9\x5e\xc5\x80\xd2\xc1\xad\x00\x91\x87\x68\x8f\xc8\x52\x08\xfc\xb0\x2f\xa5\xce\x14\xec\x7c\x10\x73\x94\x40\x26\x99\x79\x6b\xbd\xed\x75\xee\x8a\xc2\x5d\x69\x26\x0e\xd8\xe5\x66\x03\xe9\x04\x80\x9e\xba\xf6\xca\x7e\x60\x3c\xb6\x2c\x45\xc6\x4e\xb8\x59\x8e\xd3\x75\x4a\xd2\x31\x41\xa3\x69\x42\xf3\x2d\xdd\x05\xd3\xcb\xa9\x1e\x40\xb8\x89\x69\x0f\x78\xe1\x43\xde\xdb\xf6\x5b\x11\x48\x3b\x8f\xea\x1a\x13\x19\xd3\x6e\x5b\x1c\x17\x44\xef\xda\xf5\xdd\xe0\xfe\xc6\xc1\x72\x58\x77\x5c\x98\x85\x9b\xd9\xb4\xa6\x70\x30\x7d\x55\x3a\x54\x32\x91\xdb\x90\x7e\x60\xf7\x94\x2e\x56\x8d\x6e\x4d\x9e\xb8\xd9\xa0\xbe\x98\x7a\x37\x34\x06\x97\xb3\x2d\x7d\xbe\x90\x3d\x28\xa0\x65\x82\x84\x75\x87\x7f\xe4\x08\x46\xfa\x60\xbe\x67\xda\x63\x6c\x70\x4e\x9c\x3b\xdc\x7c\xc3\x2c\xe8\xa4\x6b\xca\xcf\x01\x2a\x3f\xb1\x64\x6c\x64\x4e\x59\x24\x73\x86\x45\x88\x0d\xbe\x37\x8e\x1b\xe1\xa1\xb9\x6c\x0e\xcc\xc3\xfa\x93\xe1\x98\x7f\x20\xa3\x85\xb5\xf9\x95\xe9\xf6\xe3\xe5\xb3\xd1\xeb\x3a\xdc\x24\x07\x16\xdf\xc3\x83\xb0\x03\xf5\x97\xac\x6f\x41\x3e\xcc\xc9\x50\x0c\xdd\x28\xa5\x3d\xc6\xaa\x39\xcd\x0f\xe3\xb6\x22\xfb\x18\x0f\x15\x1f\x6c\x10\xd8\xdc\xb7\x23\xed\x9c\x39\xe5\xf7\x15\x9c\xcc\x1b\x73\xb3\x09\xb3\x55\x78\x99\xef\x2a\x9c\x93\xd8\x4f\x13\xab\xa7\xa3\x23\xb1\x89\xa3\x20\xcd\xe5\x93\x17\x71\xff\x46\x38\x78\xc8\x59\x6a\xea\x86\x2a\x99\x94\x28\x5a\xac\xe2\x8a\x68\x46\xc7\xd0\xd3\xeb\x10\xb3\xd6\x34\xbe\x1f\x69\x4e\x65\x23\x04\xed\xf9\xb6\xf7\xf7\x75\xba\x47\x8b\x79\x99\x73\x92\x89\x0b\x11\xf9\x40\xf1\xb6\xc6\x1d\xa2\x0c\x89\xad\x9d\x2c\x84\x25\x3f\xf7\x7e\x04\xc4\x25\x55\x3f\x4f\x18\xf7\xf0\x25\x02\x03\x50\x5c\x2d\xd5\x5c\x2b\x17\x78\xeb\x62\x02\xdd\x57\x14\x61\x2c\xec\xf3\x66\x9c\x49\xb3\x60\xc6\x17\xaa\xdf\x1e\x49\xab\x82\xc6\x6a\xda\x78\x36\x23\xd5\x1d\x0e\xdf\x53\x12\x19\x3c\xb3\xd6\x47\xba\xb0\x36\x22\x0f\x2b\x7c\xc2\x86\x0b\xf7\x6e\x12\xc2\x49\x99\x9d\x5e\x2a\xf7\x6c\x10\xe4\x86\x5c\x7a\xd5\x26\x4c\x3d\xda\xce\x5c\x9a\xa0\x70\xc8\x5d\x4a\x72\x5d\x1b\xab\x40\x41\x40\x5c\x92\x2d\x6c\x79\xb1\xcd\xf2\xf0\xbc\x43\xef\xb9\xed\xdd\x49\xa3\x4b\x91\x45\xc4\x76\x00\x4a\x57\x9c\x45\xbf\x4d\xfc\x4c\x97\x4e\x2c\x17\x36\xed\x6c\xe2\x1e\xe7\x43\xf5\x49\x0e\xcd\x2f\x87\x23\x1e\xdd\x9b\x80\xee\x8c\x04\xdf\x2e\xe5\x56\x3a\xb5\x59\xce\x89\x6d\xa9\x90\xdf\x67\x30\x93\xfb\xba\x39\x83\xf5\x3e\x8d\x99\x2b\x73\x8d\xe2\xf7\xa9\x7f\x4b\xf8\x15\xbe\xf0\x15\xdb\x13\x71\xa3\x11\x06\x8a\xd1\xaa\x9b\x99\x30\xbd\x69\x14\x8a\x4c\xea\x38\x8e\x44\x9a\x6b\x42\x0b\x52\xc3\xfb\x6d\xba\xfb\x93\xd1\xba\x04\x17\x31\xb4\x9c\xe3\x3d\x13\xf7\x3b\x53\xec\xcc\x77\xe8\x8e\x2e\x8f\xcb\x4f\x8c\xab\x37\x4d\x20\xa7\x81\x81\xa2\x46\x40\xb6\x5b\xc4\x8b\xeb\x71\x03\x96\x45\xb2\xc0\xd0\x56\x0c\xb3\x62\x88\x7a\xfc\xfa\x13\x20\x4d\x0a\x5a\xaf\x66\x25\x7e\x8c\x60\xae\x64\x6d\xf2\xba\xb2\xfc\xc6\x1c\x63\x83\x85\xb3\xc5\xa5\xff\xdd\xbe\x4e\x85\x96\x10\x2b\x85\xe9\x61\x1e\x96\x27\x74\x45\x12\x40\x34\xc8\xdf\x70\x7c\x54\x8d\x6f\x13\x4e\xdc\xbf\x01\xae\xb0\x4b\x6d\xda\x00\x03\x8e\x6f\x7e\x68\xba\x21\x05\x98\x76\xf7\xec\x47\x10\x63\x19\xd4\x73\x52\x5d\xe8\x13\xae\x56\x55\x52\xd1\x96\x5e\x29\x70\x96\xa3\x09\x95\xe6\x28\x8e\x96\x4e\x53\xf5\x47\xe6\xa7\x37\x00\x60\x36\x6c\xb2\xf2\xb6\xe1\x04\x7a\xdd\x32\xb5\x0d\xf5\xe3\x61\x62\xd0\x30\xe5\x73\x69\x5b\xdd\xcf\x1c\xa7\x4c\x9e\x36\x25\xbc\xb2\xf8\x74\xf4\x9e\xe4\x99\x9f\xcd\xcc\xb3\x19\x4f\x9a\x06\xa3\x69\x53\xc4\x10\x62\xc0\xbb\xc8\xc6\x29\x8b\x5d\x8f\xfe\x12\xa6\x74\xd5\xb3\x69\xa4\xc4\xdb\xea\x5b\x7f\xf8\xc6\x89\x8f\x00\x11\x33\x6f\x27\x72\x82\x29\x09\x74\x94\x79\x56\x28\xad\xd3\x7d\x1b\x88\x09\x5d\x75\xac\x3a\x49\xbc\xb6\xa7\xaa\xab\x9f\x53\x57\xd4\x1e\x06\x9c\x22\xf9\x6d\x0c\xa4\x81\x06\x9d\x14\xc2\x4b\x3a\x85\x27\xf0\x86\x59\x02\x50\x84\x50\x09\x47\xd8\xbd\x2e\xab\x3e\x0e\xaa\x3d\x9a\x27\x0f\x7f\x40\xee\x1c\x47\x78\x00\x5a\xe1\x92\xc2\xd3\xf9\x48\xcf\x08\xe5\x91\x44\x8f\x00\x35\x5e\x7b\x44\x48\x4f\x05\x88\x34\xb1\xe3\xba\x35\x7a\x62\x10\xb1\x15\x63\x02\xb5\x6b\x2d\xfb\xa0\xae\x66\x70\xbc\x38\xed\xdc\x07\xf8\xb2\xd5\x91\x10\x08\x02\xa7\xd7\x55\xc5\xa7\xf1\x84\x6b\xec\x5b\xca\x50\xdd\x7b\x1b\x25\x9e\xb8\xd7\x47\xc2\x50\xfe\x63\xbc\xc5\x39\xff\x56\x70\x1e\xac\xd5\xb1\xec\xfc\xeb\x23\x73\x34\x3d\xa8\x2a\xb3\x44\xed\x93\x75\x14\xde\x61\x16\x1a\x46\x07\xe1\x4b\x13\xac\xf5\xbe\x5a\xe5\xba\x6b\xdb\xde\x3d\x98\x82\x90\xdc\x62\xb1\x9d\xe7\x47\xf8\x9b\x29\x92\xf8\xad\xaa\x6a\xb2\x71\x18\x21\x32\xe0\x52\x3b\x81\x8e\xfb\xb6\x30\xbf\x4a\xb1\xe5\x5b\xbd\x56\x73\x2e\x32\x5b\x2b\x7f\x22\xa5\xd4\x88\xf0\xac\x83\xbf\x48\x5c\x6a\xf1\x3c\xe0\xc2\xd8\x42\x0c\x38\x21\x9d\x07\x31\x29\xaf\x81\xc6\x11\x6b\x2a\xc9\x16\x42\xe1\x38\x4e\x1f\x10\x2f\x95\x3b\x97\x2f\x3c\x0b\xe8\x22\xd1\x03\x02\x24\xd9\x22\x09\x67\x06\x0b\x7c\x7e\x09\xda\x04\x01\xa1\xf9\x29\x1a\xac\xa8\x82\x48\x02\x5e\xc4\x91\xb8\x27\x24\xd2\x46\x6e\x5b\xc1\xbe\x65\x33\x44\x3c\xc8\xc8\xb4\xfd\x85\xd2\x8b\x0c\xde\xdb\xbe\x59\xa3\x35\x72\x66\x3c\xc0\x20\x95\x04\x69\x93\xb5\xa8\xc7\x0e\x92\xd1\x68\x26\xfb\x74\x8e\xc2\x9a\x2f\xe3\x8e\x2f\x8b\x6a\xb4\x94\x72\x3a\x2d\xd5\xf0\x13\xa8\x15\x68\x23\xc7\xb1\xb1\xa2\x3e\xcf\xc3\x21\x24\x83\x1d\xed\xc9\xde\x73\xe8\x74\x4c\x48\xe8\xda\xb0\x3f\x1a\x01\x9a\xf4\x5d\xd6\x06\xc6\x74\xf2\xe9\x36\x5f\xa3\xa4\xd2\xda\xf1\x81\x42\xbf\x71\xa3\x20\x38\x9a\xc0\xce\xa5\x5a\x82\x29\x28\x5b\x60\xc5\x1d\xb2\x04\xb2\xc8\x3a\xdd\x5f\x3e\x12\x43\x69\xab\xd7\x4c\x5a\x56\x5f\x31\x98\xa1\x9a\xae\x31\xa7\x5f\xe4\xc7\x21\x21\x90\xdd\xa2\x5b\xb1\x5d\x1b\x22\x78\xcd\xc1\x55\x85\xe5\xaf\x80\x15\x52\x4e\x39\xa0\x2c\xc2\x53\xd5\x1f\xd8\xa7\x4e\x8f\xb3\x4e\x47\x86\x44\xef\x40\xe4\x05\x4b\x68\x90\xde\xe9\x77\xfd\xba\x20\xf3\x11\x83\x19\x66\x3d\xf6\x74\x7f\x1b\x28\x2f\xdc\x39\x26\xda\x59\xcd\x1a\x3b\xc6\xf4\x3b\x2a\x8e\x75\x69\xa9\x6e\x59\x16\x2c\x2c\x61\x3b\x2a\x13\xc1\x1c\x77\xee\xbd\xcf\xb4\x62\x7a\xdb\x2d\xee\xd0\x42\x3d\x8a\x41\x16\x68\xf0\x0a\x71\x40\x3f\x37\xa1\x90\x81\xf9\x55\x0e\xf3\x34\xee\x71\x9e\xad\xa4\x88\x76\x4f\x9d\x08\x1f\xf6\xf1\xc5\x9b\x78\x64\x9b\xe1\xef\x34\x56\xf7\x32\x3d\xe3\x9d\xa2\x79\x5d\xb9\x1f\xc5\xe7\x05\x05\x16\x8c\xc7\x61\xec\xcd\xbf\xc6\x69\xe3\xe6\x63\xbe\x9e\xe4\x1b\x5d\x3a\x6d\x3f\xdd\x3c\x19\xdd\x04\x09\x93\x31\x7a\x9a\xb9\xd4\x47\x0c\xf3\xcd\x5c\xa7\x41\xb9\x51\xbd\xc9\x98\x18\xbb\x1e\x69\xf2\x58\xb5\xaa\xf5\x16\x2d\xaf\x32\x44\xe2\xd1\xf9\xb9\xc1\xe0\xc8\xfe\x78\xfc\x3e\xa9\x43\x1d\x5a\x58\x7e\x92\xd7\x56\xc6\xe4\xaa\x8a\x7e\xa2\x81\x62\x52\xa5\x39\x8c\x28\x0e\x61\xdf\xdc\xcf\xc8\x13\xf7\x5d\xc4\xfb\xa3\x78\x2d\xe8\x89\xc5\xc2\x23\x8b\xf5\x91\x57\xfb\xa4\x73\x91\x72\xf4\xef\x72\xe4\xf0\x80\x2f\x13\x55\x68\x40\x69\x4c\x72\x88\xe2\xd9\xf9\xbc\xec\x9a\x13\x11\x4e\xd7\xe9\x6f\xb2\x5e\xf1\x0c\x4f\x38\x2e\x8a\x37\xe5\xe9\xdb\xb2\x95\x38\x97\x45\x5b\xda\xd1\x02\x35\x85\x9d\x1c\x87\x27\xd9\xf0\x9f\x80\x01\xe9\xeb\x81\x60\x4b\xb5\x36\x16\x1b\x7d\x9c\xdf\x27\x70\x2c\xe0\x06\xa4\xfe\xa0\x11\xbd\x0c\x91\x28\x3a\x52\xb3\x4d\x42\xc8\x00\x7f\xd8\x9c\x72\xb2\x89\x30\xf7\xa9\x89\xd8\x76\x68\xa7\xe0\xc4\xdf\x5b\x19\x3d\x5a\x40\x80\x29\x31\xee\xa8\x6e\x0b\xb4\x6d\xf8\x4a\xdd\xcc\x65\x70\xb7\x53\x40\x8f\xe3\x9f\x7e\x26\x6b\xc4\x1c\x4d\x81\x69\xe3\x50\x73\x40\x91\xb3\x79\xbe\x6f\x31\x0f\xe9\x25\x13\xce\xb0\x6f\x39\x82\xaa\xf0\xa4\x80\x39\xb1\x16\x6e\xaf\xb6\x6e\xd1\x0c\x30\x5a\x59\xcc\x0f\x20\x5e\x7d\x3f\x16\x4e\x16\x13\xf5\x4e\xeb\x33\x34\x98\xe0\x7a\x69\x33\x18\x9f\xb1\x7f\x4c\x6b\x2f\x1a\xbe\xe9\xe9\x13\x06\xd2\x92\x3f\xa5\x9a\xd0\xd6\xae\xe9\x7b\xb0\xda\x0a\x13\x87\x7e\x21\x09\x30\x10\x63\x5c\x7c\x3d\x20\x63\xec\x6a\x1b\xab\x8a\xbb\xb8\xb3\xa0\xbe\xbd\x44\xd9\xce\xf3\x36\xf5\x46\x59\x6f\x2a\x0e\xc6\x78\x71\x82\x8b\xf1\x9c\x7b\x40\xd6\x7a\x20\xa5\xec\x29\x62\xfe\xb2\xed\xb4\xa6\x1b\x8b\x15\xcf\x82\x43\x8d\xf9\x59\xfe\x30\x73\x3b\xa6\x17\x6a\x3e\x6b\x73\x55\x17\x65\xe0\x37\x08\xe5\x1c\x2f\x9e\x78\x97\x5e\xa9\xf5\x6d\x32\x0c\x84\x02\x20\xd1\x5c\xe0\x10\x9e\xce\x60\x3a\x52\x67\x90\x83\xcc\x1d\xb1\x0d\x58\xde\x93\x9a\x6f\xcc\x4f\x8d\xd5\x11\x10\xe9\xa2\x27\x9b\x20\xbc\x04\x97\x73\x45\x95\x90\x1c\x79\x86\xf0\x7b\x04\xe1\x15\xd2\xd6\x23\x15\x96\x2b\xbf\x6b\xa8\x42\xf3\xb3\x81\xc1\x1b\xd0\x8e\xf7\x37\xf6\xb1\x45\x7d\xa3\x68\x1c\xef\x92\x65\xdc\x62\x34\x02\xb1\x2c\xcd\xcb\xfe\x07\x89\x22\x80\x3b\xbe\x3a\xc2\x32\x72\xff\x0c\xa3\xff\x21\x88\x73\x92\x6d\x52\x58\x37\x8c\x49\x78\x1c\x18\xa2\xda\x7f\x64\xc0\x2f\xd1\xb5\xbc\x46\x09\xcc\x15\x82\xb3\x82\x28\xc9\x3a\x19\xe4\xb4\x19\x11\x07\xd3\x15\xd1\x5c\x17\x4e\x10\x67\xe8\x12\xb1\xb2\xc0\x80\x84\xca\xd9\x51\x64\x3b\xaa\xf7\xe8\x3e\xa4\x16\x52\xf0\x61\xca\x69\x8b\x3a\x43\x64\x46\xe0\x82\x7a\x38\x22\x3d\x13\xef\x83\x61\x69\xbf\x39\x0d\x95\x38\x2f\x47\x30\x29\x88\x0a\x6f\x99\xd9\xb0\x6b\x99\x9f\xfa\xa8\x8f\xb6\x7d\x2b\x3d\x8b\x5d\x0e\xf0\xa1\x51\xda\x4e\xe3\x90\x4a\xec\x4a\xd9\x5b\xaa\xd6\x69\x86\x6f\x16\x08\x6c\x7e\x94\x45\x0d\x33\x02\x80\x71\x40\xa8\x43\xf5\x2b\x2a\x5b\x03\x58\xbf\x51\xe7\x45\x2c\x98\x50\x37\x6d\x94\xd9\x49\xa3\x35\x6b\xed\x19\x3a\x4a\xba\xf6\x1f\x09\x7b\x02\x62\x10\xcb\xb3\x08\x9f\xbd\x91\xc9\xcf\xf9\xe5\x72\x37\xa8\xd2\xfe\xe5\x0f\x98\x5b\x31\xd8\x5b\x00\x56\xb0\xa0\x63\x3d\x34\xba\x7b\x58\xf5\x23\xf8\x0a\x2d\xb1\x92\x06\x1a\x67\x9f\xce\x5f\x53\x33\x31\x28\x40\x61\x6f\xe8\x56\xf0\x9c\xea\x61\x0c\x6a\x71\x9b\xb1\x1d\xfb\xda\x75\x23\x49\x9e\x7c\xf0\x10\xf2\xb5\xda\x77\x73\x72\xd4\xee\x17\x3b\x0d\xff\xce\x7c\xb1\x54\x45\x80\xaf\xc3\xe2\x55\x14\x92\x26\x86\x6a\x7b\xdf\xfe\xd1\x76\x64\xf6\xce\x44\x92\xe3\x58\x93\xed\x13\x1d\x05\xe3\xa0\xb9\xb6\x2e\x68\x1d\x6e\x8c\xff\x4e\x6e\xf6\x8c\x70\xca\x28\x92\x41\x82\xde\x7c\x47\x2a\x4c\x66\xd9\x8e\x1b\xfc\xb3\x9c\x85\xed\xd6\x03\xc6\xb2\x20\x51\x5f\xc5\x32\xbe\xd3\x89\x76\xa9\x84\x2b\x7a\xa3\xbb\xcf\x30\xe2\x30\x76\x3c\x05\xa8\x57\x2e\x09\xea\xcb\x7c\x98\x52\x75\x96\xc8\xdd\x74\x66\x9a\x93\xec\x57\x3e\xae\x90\x9f\xc0\x83\xee\xc5\xc7\x8c\xc4\x94\xde\x7d\x90\x7d\xaa\xaa\xd0\xb2\x16\x51\x39\xe4\xbd\x5f\x2e\xa6\x05\x19\x5a\x04\x59\xcc\x67\x63\x59\xd5\x93\x59\x26\x1c\xc3\xf7\xb9\x6a\x51\xbf\x6a\xe8\x42\x4d\xd3\x06\x03\x87\x86\xf7\x80\xb1\x93\x8a\x60\xc5\xaf\xa7\x01\x6f\x02\x4a\x3b\xb2\x4d\x14\x2a\x1c\x1c\x9f\x98\x63\x88\xea\x18\x9d\xb6\x79\x22\x1d\x2f\x36\x5f\xc7\x19\x76\xd2\xee\xde\xc6\x58\xb8\x5d\x19\x94\x5d\xb9\x52\x9b\x1b\xf2\xf3\x68\x3f\x05\xac\x1b\x7d\x1c\x8b\x59\xe6\x85\x1b\xf4\x1a\x9e\xca\xa1\x98\x87\x0e\xb6\x9d\x39\xb7\x78\xf5\x4f\x09\xbb\x44\xa6\x34\xf2\x81\x99\xb3\xc9\x9b\xd9\x44\x81\x22\x66\x25\x53\x0f\x21\xd1\x12\xa2\xac\xc4\xe6\x52\x1f\x87\xd1\x49\xa0\x22\x18\xd8\xe3\xa4\x84\x33\x86\x26\x8e\xc1\xca\xdc\xf5\x18\xa4\x97\x88\x9d\x2e\x59\x6c\x13\xcd\x77\xcc\x94\x1f\x99\x1c\x64\x26\x6e\x9d\x6f\x77\xe0\x0f\x19\xd5\xcf\xe2\x2b\x9e\x0c\xdd\x86\x33\xe4\x75\xcc\xb7\x11\xce\x70\x8b\x2b\x72\x40\x01\xa4\x13\xaf\x8d\x11\x93\xd1\x39\x27\x9d\xea\xe7\xe9\xc5\xd9\x83\x16\x70\xc9\xbd\xa1\x80\xeb\xee\xc1\x85\x70\x2f\x2e\xa5\xa8\xf7\x61\x69\xce\xd5\x61\x77\xa6\x0b\xcb\x7a\x01\x9a\xff\xd6\x2b\xbd\x9b\xdf\x0e\x5c\xc9\x45\x5d\xd5\x07\x02\xa8\x87\x4b\xd5\x12\xce\x83\xaa\x83\x82\x5f\x67\x6a\x84\x76\x00\x94\x4f\x2d\xcb\x58\xeb\x8f\x2c\x6c\x2f\x76\xed\x88\x22\x8b\x0b\x0e\x22\xdd\x23\xd3\x8b\x02\x3b\x31\x01\xf6\x71\x51\x81\x49\xe6\x2a\x90\x75\xc0\x76\xf1\xe7\x1e\x72\x4e\xfa\x3b\x9e\xf6\xba\xc7\x19\x33\x33\x5c\xf5\x93\xd5\xdf\xdd\x8a\xcc\x1f\x45\xc9\xc5\xf6\x83\xe2\xa8\x5e\xf1\x64\xcc\x2c\x38\x5e\x94\x36\x89\x05\xdf\x30\xbf\x3e\x21\xdc\xd8\xf1\x6c\x65\xfb\x31\x14\xa4\x5b\x03\x59\x4c\xb0\xd2\xdc\xf8\x8e\x35\xd2\x52\x32\x45\xa4\x33\x3c\xdc\x95\x06\xe0\x92\x53\x7d\xc2\x29\x84\xf2\xf2\xe8\x90\x0e\x48\x5d\x5f\x88\xb8\x0e\x23\xd9\x6c\x4c\x7d\xc6\xc8\x5e\x68\x54\xb4\xbd\x07\x1f\x25\x53\x2c\x4d\x9c\x26\x13\x83\x1f\x8b\x13\x1d\x8c\x09\x10\x09\x24\xb2\x91\x86\xa4\x17\xa1\x96\x5f\x28\xde\xb0\xe2\x4d\x4d\x92\x1e\x03\xa7\x10\xb2\xf7\x51\xe6\xdd\x5a\x6e\x3e\x3b\xb6\x47\x29\x22\x3e\x38\x1d\x4e\x88\x24\x3b\x98\xc8\xb8\x6c\xcf\x3e\x71\x3e\x95\x8b\x52\x1e\x2d\xad\x9d\xbb\xcd\x5e\xe5\xb3\xdb\x65\x9f\xe2\x13\x70\xc3\xd2\xad\xf5\x1b\x53\x48\x0c\x2e\x4b\x65\x6d\xd6\x16\x7c\xc4\xf2\xb6\x2a\xc6\xa4\xb9\x7f\x9f\x25\x8c\xf8\x9f\xa6\x66\x38\x75\xec\xd3\x26\x71\x2e\x05\x15\x4e\xeb\xe1\xf1\xa7\x38\xf4\x62\x2a\xf7\x98\x8b\xbe\x22\x73\xc6\x8f\x0d\xf5\x8e\x02\x5f\x96\x57\x67\x43\xb8\xed\xaf\x73\x6a\x0b\x98\xe5\x9f\x33\x70\x36\xfd\x43\x49\x92\xef\xf7\xa4\xd6\x9c\x37\xd1\xca\xdb\x79\x96\xbb\x47\xe3\xe6\xb2\xb6\x76\xe2\xee\x3e\x37\x23\xe3\x3d\x52\xc1\x8c\x3f\x7d\xa7\xd5\x91\xc8\xed\xe1\x87\xb5\xdf\xda\x54\x5d\x9a\x5f\x6b\xc0\x2c\xe9\x8f\x1b\xb0\x8f\xf3\xc7\x9f\x5e\xfa\x6f\xa9\x55\x5f\x33\x96\x41\x66\xc7\x1b\xc2\x92\x28\x75\x1e\x2c\xd5\xc2\x3b\x26\xde\xf4\x5a\x2e\xe5\xbb\xa4\x70\x0a\x50\x8d\xd0\xa3\xa4\x45\xa6\x9b\x1e\x50\x1d\x89\xca\x03\x1b\x15\xab\x06\xa8\xdf\x0f\x43\x47\xbc\x59\xb1\x52\x01\x50\xe4\xcb\x2f\x16\x0c\x7e\x08\xdf\xad\xf9\x76\xc8\x3a\xeb\x96\x9f\x23\x93\x29\xe0\xd9\x1d\xcc\x3f\x00\x8f\xa4\x8d\x08\xa1\x84\xe1\xf9\xe3\x49\x54\x94\x67\x1d\x28\x86\xe9\xec\x67\xb4\x74\xdc\x5e\x66\x4e\xd1\x0e\x34\x97\x74\x62\x50\xeb\x4b\x25\xa8\x48\x84\x63\x39\x18\x90\xb0\xfe\x50\x02\x76\x27\xe2\xb4\xae\x33\xb6\xb4\xf4\xa7\x20\x1e\x21\xd1\xb7\x97\xf6\x0a\x08\x58\x18\xf9\x09\x20\x84\x39\xee\x0b\x93\x30\x59\x39\x82\x42\x3d\xeb\xce\xf8\x56\x9e\x0a\xe3\x5c\x2a\xa8\xa6\x46\x06\x33\xaf\xbc\xd0\xfd\xb0\x0b\x7b\x47\x62\xcf\xd5\x17\x34\x00\x02\x5b\x9b\x49\xec\x2e\xd9\x97\x36\x69\x85\xcf\x35\xf7\x1b\x8e\x7b\x9c\x18\xb0\x01\xda\xfa\xcd\xc6\xd3\x99\xfe\xaf\x54\x47\x43\x10\xe7\xad\x69\x90\xed\x6a\xa3\x33\x5a\x90\x55\x25\x3f\x90\x22\xcf\xcc\x2d\x0f\x96\x04\x77\xde\x41\x13\xdd\x59\x13\x60\x7b\x59\xf8\x09\xf2\x16\xde\xb6\x05\xa0\x22\x9b\x12\x0d\xc1\xa4\xda\x8f\x3a\x02\x3a\x50\x71\x8e\x19\x50\x58\x56\x8a\xfb\x93\xd1\xf6\x88\xc5\xa0\xbd\x52\xc9\x73\xe4\x24\xd3\xa6\x3d\x9b\x9f\x22\x5a\x79\x17\x51\x76\x5f\x85\x09\x40\x5b\x6e\x43\x51\xf2\xb4\x38\xe5\x4d\x42\x9e\xc3\xa9\x41\xe5\xda\xa5\xd9\xe7\x7f\x8e\x47\x77\x42\x71\x1a\x91\x74\x3b\x33\x94\x26\xa7\x42\x37\xd7\x8d\xe8\x0e\x4c\x8c\x08\x9e\x57\x78\x0d\x22\x75\x47\x12\xa1\xe1\x95\xbe\x87\xac\xac\x2a\xa5\x09\x2d\xe3\x2b\x4f\xf5\x88\xb2\xf3\x3d\x94\xa0\xcf\xbb\x17\x82\xfc\x7b\xb1\x8b\x55\x61\x92\x4f\x02\xeb\x24\xad\x4d\x87\x0d\x91\xee\x4d\x0b\xda\x74\x7d\x8b\xd0\x6e\x04\x2a\x1f\xa0\x33\x1b\x49\xe9\xd6\x73\xda\x10\x3e\xac\xc6\x9c\xe5\x53\x20\x4c\xa0\x8b\x39\xb9\xb7\x72\x14\xee\xd9\x55\xb4\x88\xe3\x77\xdd\xbd\xcb\xb1\xe0\xc9\xa1\x93\x66\xaf\x72\x17\x90\xb5\xc3\x5e\xa5\x57\xbc\x30\x9f\x2e\x61\x5a\x00\xb3\x33\xdb\xec\xfa\xb3\x43\x86\x56\xb9\x07\xb9\x0e\xac\x42\x91\x0f\x59\x18\xb0\xaa\xfb\x28\xef\xb6\x26\x9c\x07\x2d\x9f\x30\xd1\x00\xcf\xce\xa3\x2f\x5a\x72\x28\x82\xf1\x1b\xd6\x87\x53\x55\x4d\x13\x6c\x53\x52\x1c\x00\x5e\x9a\x45\x0c\x18\x03\x4e\xf7\xfa\x3d\x1f\x38\x84\x42\xb1\x10\x09\xa9\xe9\x47\x47\xc1\x01\xff\x78\x56\xfc\xdd\x8c\x14\x18\xaa\x7b\xda\x92\x3b\x3f\xbf\x12\x8d\x17\x29\xee\xef\x95\x6c\x35\xcb\x51\x2b\x11\xa1\x30\x1f\xe7\x39\x59\x2a\xf9\x7c\x7e\xd9\x9f\x8e\xbd\x8a\x4e\xd7\xef\xcd\x73\x83\x2f\x4a\x47\x5f\xce\x8b\x39\x62\xd2\x89\xda\x59\x50\x5e\x81\xdf\xbf\xa8\x5c\x5b\x48\x99\x7e\xcc\xf5\x3d\x24\xb7\x8a\x63\x2e\xff\x10\x77\xa7\x79\x38\x79\x27\xda\x95\x37\x71\xf8\xe0\xe2\x11\xe7\x91\xe7\xd8\x7b\x3e\x25\x3b\xe7\x1b\x7a\xa9\xc9\xd8\x9a\x6e\xa5\x4b\xef\xca\x98\xf9\x9e\x8c\x81\x9e\x6b\xf1\xd2\x3d\xf3\x9a\xc7\xda\x75\x0e\x32\xe8\x7c\x6f\x31\x85\x81\x4f\xbe\x4b\x71\x02\x2f\x5b\xd3\xa4\x8c\xd5\xa7\x26\xc3\xd7\xfe\x65\x5c\x59\xc7\xce\x69\xee\x22\x9f\xa0\x6e\x5e\x25\x41\x2d\x97\x3e\xe4\x27\xfb\x11\x28\x54\x8a\x59\x62\xed\x0e\x2a\x01\x86\x27\x71\xea\xee\x8f\x3f\xd5\xe3\x87\x71\x52\xb6\xa3\xc5\xa0\x3e\x1a\x01\xd1\xd4\xef\xb3\x43\x6e\xca\x15\x95\xf9\x54\x3e\xfd\xfd\xfc\x4d\xdc\x26\x2a\x4b\x4d\x86\xc0\x7f\x1d\x48\xbc\x63\x6c\x85\xdc\xef\xca\x13\x5a\x32\x02\x76\xb6\x8e\x19\x77\xd8\x54\x7a\xda\xfe\x38\x77\x46\xef\x43\x7c\x2a\xa6\x53\x87\xda\x63\x4d\x44\x32\xa0\xe7\x4e\xd4\xb4\x5e\x46\x83\x6c\xaa\x10\xee\xc2\x1c\x6b\x37\xb3\x4d\xc7\xf8\x3a\x74\xe6\xea\x4a\x7c\x74\xad\xa9\x28\xba\xd9\x05\xda\x46\xc0\x96\x9b\x0b\x38\x94\x9e\x3c\xab\x2b\x9c\x7a\x55\x40\xc0\x33\xfc\xc9\xbe\xeb\x50\xfa\x32\xdf\xd6\x23\xda\x1a\x12\xde\x3c\x22\x36\x0e\x5d\x5a\x4b\xf5\x62\x2f\xa3\x1b\xa6\x86\x43\x95\xa7\xfb\x7c\x85\x00\x56\xc4\xbe\xf7\xf5\x66\x0f\x58\xbd\xa7\x67\x09\x99\x06\xaa\x94\x08\x51\xe3\x5a\x3d\x5e\x32\x69\xda\x96\x49\xd4\x21\x97\x1b\xb6\x01\xe6\x42\x56\x71\x55\x32\xfa\x77\x78\x16\xed\x34\x88\x7f\xce\xf2\x30\x9d\x8f\x88\x08\x06\x1a\x05\x96\xb9\xd7\x17\xc8\xd5\xc9\x46\xc9\x5f\x05\xb9\x61\x57\xb1\xb8\x86\x36\x00\x3d\x59\x0f\xac\xb3\xea\x96\x55\x78\x63\xac\x0d\x1f\x98\x33\x43\x9b\xcb\x46\xcf\xfe\x62\x27\xd6\x7e\x64\x06\x6f\x42\x30\x38\xa3\x1d\xd3\xe2\x03\x7d\x7e\x93\x00\x91\x8d\xf8\xac\x37\x0b\x41\x16\x72\xb0\x73\x6d\x2c\x93\x27\xf5\x02\x56\xb5\x08\xf8\xd4\xda\xf3\xc8\xa4\x21\xc3\x28\x82\x88\xac\xfc\x19\xf3\x9a\xd9\x89\xda\x5c\x54\x1d\x07\xcb\x93\x83\xef\x20\x5c\xf6\x0b\xf6\x92\xf2\x1e\xa3\x8e\xe2\x2a\x14\x30\x76\xe9\x68\x3c\x01\xed\xe0\xaa\x03\x67\x04\xb6\x76\x64\x77\xd2\xd6\xe5\x81\x49\x2c\xd6\x6e\xcf\x8c\x04\x30\xd7\xd7\xf7\xe9\x92\xd2\x7b\xbd\xff\x2e\x62\x50\x64\x05\xf4\x3a\x45\x1b\x23\x05\xd3\x79\xb4\xad\x14\xaa\xb5\x6f\x43\x6c\xad\x10\xdb\x38\x2c\x0b\x46\x46\x6f\x78\x2e\xf3\xd3\x13\x85\x1e\xd2\x88\x88\xd8\x75\x6d\x8c\xed\x28\x62\x07\x47\xc1\x2e\x1e\x30\xa2\xc0\xe1\xb7\xb5\x22\x2c\x85\x1e\x27\xef\xd2\xd0\xc1\x32\xb0\x16\xc4\x6a\xe1\x12\x39\x8d\x9e\x45\x88\xeb\xc0\x13\xce\xb2\x25\xbf\xa7\x6c\x5d\xfc\x87\xa4\x96\xfc\x62\xa0\x29\xa7\x02\x3c\x7c\xb2\x87\xc0\xf0\xeb\xc6\x1a\x22\xfa\xef\xcc\x3e\x3f\x1f\x05\xcc\xec\x2c\x9f\xf0\x60\xf7\xc9\x16\xc1\x99\xf1\x1d\x37\x04\xc1\xdc\x01\xdd\x5c\x86\x18\x8e\xf1\x85\xdc\xb3\xa4\x4d\x54\xdf\xf0\xdc\x97\xba\x78\x69\x81\x45\x58\x70\x54\x0a\x52\x00\x19\x13\x5e\xb3\x57\xcf\x52\x9b\x39\x1e\xe9\x39\x87\x94\xcc\x83\x85\x0d\x7c\x63\x4e\x86\x5e\xc6\x83\xd0\xb7\x66\x56\xed\x41\xc3\x12\x6e\x7c\x74\xf8\xd0\x6c\x48\x83\xca\x72\x5d\x1a\xad\x62\x58\xb6\x6b\xce\x1c\x43\x72\x5d\x97\x4a\xfc\x89\xd3\x49\xef\xa5\xe8\x33\x12\x84\xe1\x32\x1c\x4a\xfd\xed\xa9\x54\xa7\xa6\x19\x49\xc7\x29\xed\x29\xbf\x3c\x17\xac\xa4\xc4\x0b\x37\x72\x6c\x50\x5a\x1d\x2b\x54\x20\xbc\x97\x84\xb1\x39\x2d\xeb\x1a\x35\xa1\x1c\xd9\xc5\xad\x94\xf6\xcf\xba\x69\x64\x55\x09\x9e\x67\x05\x53\xd4\x51\x4e\x18\x64\x1b\x14\x14\x6a\xf9\xed\xbb\xd2\xa8\x79\x4d\x1f\x0e\x01\xa9\xd4\x63\xd4\x2c\xd6\x05\x96\xf2\x16\x44\xe7\x60\x36\x7b\x2b\x18\x36\x5f\xcb\x0a\x57\xc8\x88\xd5\xd1\x80\xcc\xfd\x58\xc2\x8e\x1c\x63\xc4\x66\x90\x09\x6b\x04\xe4\x00\xd2\xad\x2b\x53\xf1\x8c\xcd\x8d\xdd\x43\xd3\x66\xb5\xf9\xc0\x9a\x93\x86\x3f\x77\x07\xad\x96\xd7\xc9\x83\x20\xf6\x00\x60\x25\xfc\xfc\x74\xe7\x3d\x09\xb2\xea\xd1\x2c\xdf\x0c\x05\x65\x21\x43\x24\x83\x13\x39\x32\x40\x88\xd4\x0a\x59\xc5\xf6\x42\x03\xe0\x20\x9f\xb2\xe7\x45\x1a\xa9\xcf\x34\x4e\xbd\x40\x37\x9f\xf1\x90\x7f\x48\x66\x50\x0f\x6a\x05\x06\x2a\xc6\x88\xb3\x3b\x3e\xfa\x93\x62\xb4\x30\xcc\x79\xdf\x70\x26\x3d\x14\xdb\xab\x2c\xff\x2b\x54\x66\x5a\xac\x7b\xf8\x51\xe8\x4b\x2a\x7d\x78\x9d\x35\x0b\x98\x5d\x81\xbc\xab\x5d\xf6\x0a\x2a\x43\xb5\x03\xe4\xc8\xbb\x2b\x30\x62\x35\x59\xea\xc9\x98\xd4\xdc\x84\x15\x5d\x5d\x3c\x57\x0b\x8f\x34\x22\x38\x9a\xbb\x52\xe3\xe2\x62\x29\xc5\x63\xbd\xc8\xbd\xd0\xed\x65\x1d\x2a\x57\xeb\x7a\x9a\x59\x15\x95\xed\x3f\xcc\xe8\x5d\x37\x25\x05\x6f\xb3\xdf\xb3\x5d\xc0\x27\x90\xe0\x0c\xfa\x68\x51\x36\x11\xd8\x3b\x60\x82\xe3\x71\x70\x1e\xe8\x90\x99\x56\xb3\xb4\x87\x96\x41\xe2\xf1\xbe\x9d\x48\xdf\x94\x42\x22\x90\x78\x83\x96\x5a\x7e\x7a\xa7\x47\xaa\xd8\x87\xd6\xa5\x1e\x78\x9d\x9f\xba\x30\xd4\x85\xdd\xa1\xd0\x41\x9d\xc7\xc7\xeb\xd0\xa9\x8b\x0d\xdc\x01\x01\xa9\xf2\xea\x44\xd3\x00\x4e\xc2\xa7\x51\x32\x68\x37\x2a\x1f\x07\xc0\x01\xed\x77\x9f\x1c\x56\xe7\x2b\xfd\x83\xe0\xda\xfe\x6c\x96\xca\x55\x45\xaa\x4f\x16\xb4\xa9\x21\x44\x9a\x76\x2b\x4f\x6f\x8b\x16\x13\xef\xa0\xb7\x05\x24\x2a\xda\x84\xf2\xdd\xbb\xd2\x20\x11\x3b\x54\xed\x9d\xe5\x18\xe9\x7a\x15\x94\x49\xa8\x3b\x34\x24\x24\x13\x3f\x78\xa6\x2b\xfa\x27\xb4\x37\xbd\x63\x4d\x26\x22\xc9\x3a\x15\x49\xe7\x35\x9c\x52\xce\xc9\xca\xf1\xe3\x4f\xe1\x72\x53\xfb\xad\x28\x9a\xac\xf4\x66\x9a\xf8\xdc\xd6\x75\x03\xa3\x25\x81\xe7\xc5\x97\x63\x21\x7e\xfb\x98\xaf\x01\xb7\x2d\x44\x91\x9f\xb6\x89\x83\x76\xfa\xf0\x2e\x7d\x99\x97\xa8\x33\xb6\x2c\x4f\xde\x37\xed\xad\x43\x3d\x64\x18\x6e\x7f\x43\x02\x13\x2e\x23\xf1\x60\x15\x63\x87\x10\x6c\xf3\xeb\x8a\x38\xc8\x8c\x67\x56\x09\x8c\xc3\x21\x8e\x99\x42\xbb\x85\x5f\x12\x2a\x67\x98\x9e\x99\x24\x79\x95\x10\x65\x8e\x1f\x96\xb3\x15\xf3\x14\xaf\xda\x58\xbf\x8e\x49\xc5\xe5\x44\xeb\xec\x93\x04\xa8\xbb\x88\x10\x49\x95\xbb\xb4\x8f\x1f\x24\xa1\x8c\xcb\x10\xa9\x97\x70\x80\x38\x37\x62\x9d\x65\x82\x46\x0e\x30\xff\x22\xcb\xb4\x84\x16\x1d\x91\x1a\xbe\xa6\x72\x78\xba\xdd\x05\xf0\x33\xfd\x66\xba\xe2\x2f\xfe\x75\x97\x09\x19\x5b\x59\x3d\x29\x93\xb5\x71\x0e\xbf\x5e\x6d\xa9\xd0\x1d\x9e\x75\x15\x9d\x43\xc4\xb0\x26\xbf\xd5\x20\x63\x4d\xee\xe0\x47\x85\x95\xd5\xd3\xc7\x67\x79\x5a\x5b\x6b\x5b\xea\xc3\x0a\x83\xc5\xc5\xda\x94\x24\xb9\xeb\x0e\x41\xaf\x03\xd4\xc4\x84\x68\xa5\x4a\x60\x40\x30\xc9\xd9\x01\x29\x71\xd8\x35\x95\x14\x5b\x95\x56\x71\x24\x41\x12\x1d\x7d\x94\x38\xed\x94\x59\x93\x12\x48\xd8\x34\x83\x7a\xca\x85\x3b\x4a\x2f\x2a\x0c\x1b\xd1\x92\x60\x1c\xf5\x78\x78\xc5\x7c\x0f\x89\x84\x96\xa2\xf9\xb5\xc1\xe8\xd8\xe4\xa3\x2d\x3b\x39\xe7\xc9\x22\x18\x58\x14\xaa\xcc\x32\xef\x37\xb6\x98\xca\x99\x9b\xb6\x11\x46\x04\xc1\x3c\x17\x1e\x08\x31\x3a\xf9\xda\x7b\x31\xb5\x5f\xe2\xc6\x82\x7d\xcd\x90\x52\x59\xef\x31\x99\xaa\xb6\xb1\xb9\xf9\x3c\x0b\xcd\x5a\xd2\x7b\x42\x5d\x90\x35\x81\x0d\xdd\xc7\x47\xae\xa6\x8c\xf2\x0a\xaf\x30\xc3\x0f\xe3\xf6\xd4\x34\x51\xa8\x25\x8b\x88\x0f\x2d\x34\x1d\xcf\xd4\xc7\x5e\xc0\xae\xd4\x72\x45\x1f\x64\x91\x96\x6d\xc2\x61\x81\xca\xe5\x8d\xd1\x45\xde\x50\xc1\x0d\xc7\x8a\x7a\xf5\x67\x63\x24\xa7\xb3\xb5\x94\xd8\xd7\xe4\x95\xa4\x0f\x4d\x29\x38\xf6\x8c\xab\xd0\x54\x75\x19\x92\x08\xfe\xbc\x2a\x42\xa0\x9b\x63\xdd\x45\xc0\x68\xe0\xa5\xad\x6b\x57\x28\x48\x76\x81\xa6\x6b\x50\xb9\xaa\x24\xcc\x4e\x95\x20\x62\xcc\xc8\x2e\x1a\xb5\x37\x4c\xae\xce\xf3\x5d\x8f\x33\xe5\xf2\x55\x85\x75\xfc\x6a\x2a\x53\xda\xf1\x1d\x80\xd1\x06\x42\x8f\x06\x6d\xde\xc5\x85\x02\x9f\x65\x73\xbd\x96\x59\xdb\xc6\x93\x56\xf6\x89\x3a\x4a\x1e\x66\x85\xb5\x16\x1d\x64\xc7\x61\x79\xf0\xdb\x07\x1a\x9d\xad\xad\x0d\x83\x8d\xf2\x34\xa0\x33\x72\x41\x0d\x22\x7e\x6d\x4d\x10\xdc\xec\xb7\xba\x1f\x8a\x6b\x61\x2b\xa7\x8e\xdf\x9f\x93\xdd\x2f\xc4\x3a\xcd\x24\x0c\x46\xaa\x8e\xb9\x28\xcf\x0b\x3d\x88\x4a\xc9\x9a\x34\xed\x62\xb3\x96\xec\x18\x98\x0f\x26\xd6\x4f\xee\x8f\x1c\x15\xb8\x05\xf7\xdf\xc3\xc5\x54\x90\x45\x56\x5f\x60\xd8\x72\xfe\xe2\xa6\x53\x29\xad\xe5\x24\x03\x0f\x6e\x13\x87\x27\x79\x40\x60\x3f\x32\x36\x09\xbd\xee\x53\x85\xce\x8a\x8c\x78\xdf\xf5\x4a\x84\x1b\xa8\xdb\x0c\x07\x79\x9a\x18\x0a\xfb\xc0\xc5\xc5\xae\x81\x87\x67\xf1\x63\x17\xd7\xf6\x23\xf5\x3e\x30\x4c\xd1\xc9\x33\x5d\x13\xed\x81\x3b\xcc\x37\xc2\x15\xfc\x70\x55\x4f\x6c\xfa\xaa\x4a\x26\x3a\xf2\x76\xbf\x33\x56\x1a\xa4\xc3\x4d\x1b\x9b\x50\x4e\x44\x09\x92\x92\x9f\x8d\xb1\x9b\x19\x5d\x4a\xa8\xcb\x15\x37\x52\x3c\xda\x43\x87\x55\x17\x7f\x15\x31\x0c\xc4\x50\xc9\xc8\xd9\x9b\xcd\x69\x56\xc5\x08\x90\x93\xb1\xfb\x09\x3d\x43\x50\x0b\xe3\x7c\xfe\xac\x43\xe1\x9c\xa9\xe1\xf0\xd3\xc8\xae\xa0\x65\x8a\xd1\x1f\x55\xb9\x7e\xda\xdb\x01\x46\xfc\x3b\xf8\x18\xaf\xbd\x53\x6b\x31\x05\x99\x38\x2e\x99\xa0\x11\xbd\xa1\x76\x82\x76\xb7\x1b\xde\xd2\xed\x8e\xd7\xd6\x42\x68\x4f\x4e\xa4\x3e\x5c\x2f\x19\x5c\x70\x06\x10\x4d\xb3\x08\x77\x65\xc4\x52\x19\xf1\x0a\x6c\xfc\x3e\x0d\x84\x84\xe3\x15\xbd\xbb\xfa\x2e\x03\xc0\x31\x15\x90\x82\x11\xdc\xf4\x07\xcc\x9b\xe7\xbb\x1b\x7a\x9e\xb9\x24\x5f\xf6\x94\x2d\x15\xc3\x97\x5f\x01\x23\x7e\xb0\x6c\x2c\x8a\xa9\xa3\x47\x2e\xc6\x81\x72\xc2\x6c\xa6\x41\xa9\x44\xcc\xc3\x82\x7a\xa3\xf7\x56\xea\x5b\x67\xfe\x1c\x9e\x64\x1c\x7d\xa1\xc7\xf6\xda\x42\x29\x64\xc2\xed\x3e\x37\xb0\xce\xd8\x3e\x2a\xc9\xb9\x5f\xdb\x83\x30\xef\xdd\x71\x4e\x40\xf1\x3c\x2a\xfc\x10\x27\xd1\xf8\x76\xa7\xdf\x01\x71\x2f\xf9\x2f\x60\x07\xab\xd0\x62\xc7\x72\xdb\x5f\x50\x5f\xd9\xba\x52\x93\x9b\x12\x80\x55\x11\x26\x39\x0c\x05\x29\xe6\x33\x94\x65\x07\x48\x39\x28\x2f\x47\xf6\x12\x43\x9d\xed\x7a\x49\xa5\x61\xa8\x57\xe2\xbe\x9d\x6d\x7b\x4c\x0f\xf7\x71\xfb\x81\x15\xaf\x35\x55\xd0\x5c\x01\x04\x39\x57\x8a\x6c\xf0\x19\x2a\x94\x3e\xb9\x9c\xf9\x56\xc0\xd5\x46\xef\xfe\x30\x58\xc7\xac\x09\xaa\xce\xec\x69\xf5\x47\x85\xd8\xcd\x13\x61\x84\xc2\x7e\xc7\x20\xbd\xaf\xfc\x80\x10\x75\x04\x98\x81\x75\x7c\xcf\x7f\xfb\x9e\x9b\xed\xdc\xb8\x00\xc8\x9d\x23\x16\x54\xdb\x59\xfc\xf2\x12\x6a\x30\xa8\xbf\xeb\xd7\x46\xa4\xf8\x96\x13\xac\x10\xe1\xad\x3b\x45\x8f\x1d\x60\x39\x2e\x49\x41\xff\x98\x52\x96\xe8\x2e\x3c\x1a\x8d\xda\x7b\xc5\x2c\x27\xa8\x32\x6d\xd9\xe6\x53\x9a\xac\xd9\x4b\x94\xb8\x17\x35\xc5\x99\x00\x41\xf2\x5a\xb9\x10\xaf\xae\xb0\xc3\x35\x78\x5b\x85\xf0\x67\x89\x4a\x06\x98\x9e\x26\x94\x89\x0c\xc7\x17\x6c\x30\x98\xad\x32\xa8\xe1\x55\xbe\xed\xed\xa4\x4f\x6f\xee\x74\xbb\x01\xc3\xfa\x60\xfb\x04\xa2\xb6\x7d\x46\x74\x12\xa7\xa6\x00\x7b\x95\x2a\x7e\x25\x53\x79\xaf\xb5\x05\x79\x80\x45\x1e\xd0\x27\xc3\x43\x53\x60\x71\xbc\x7b\x0c\x13\x32\x4a\x25\xe6\xb3\x3c\x2c\x57\xb8\x57\xc4\x12\x2d\xd4\x95\xd9\xab\x5a\x0a\x84\x4e\xc6\xda\x55\x58\xec\x4f\xa2\xc7\x3b\xc1\xe0\xc0\x30\x43\xcd\xaa\x37\x99\x1b\xe4\xac\xee\x6e\xc9\xa6\xed\x55\x84\xfa\x84\x0c\xaf\x4e\xcf\xac\xa1\x0a\xd7\xa6\xfe\x6e\xe5\xb3\x9c\x16\x2c\x53\xe1\x43\xdf\x40\xc6\x2f\x2b\xfa\x74\x1e\x0d\xaa\x6b\x08\xe1\x59\x47\xa6\x55\x75\xce\xaf\x09\x83\xa7\x6b\x23\xe9\xf5\x3c\x97\x80\x75\xfe\x5a\x17\x9f\xf8\x38\x6e\x71\xf5\x95\x9b\x07\x85\xfe\xbc\x85\xdd\x3b\x66\x9c\x53\xaa\x04\x8a\xef\xfe\x81\x40\xd0\xac\x65\x5e\xd1\x1a\x68\xfb\xe4\x49\xda\x43\x4d\xe8\x9e\x5f\x1d\x1f\xf0\xc3\xb7\x5f\x7a\x47\x79\x3a\xc6\x3f\x2c\x2c\xe6\x5e\xa9\xeb\xe3\x75\x2c\x81\xec\xe7\xe1\xd5\xc9\x2a\xe8\xac\x95\xca\x2c\xcb\x6a\x89\x35\x51\x03\xca\xca\x52\x1c\x36\x41\x2d\x52\xaf\x98\x87\x5b\x99\xd9\xe0\xfe\x18\xee\x75\x6a\x79\x94\xf8\xc1\x57\x93\xb0\xd4\x73\xb0\xd3\x0c\xa1\x47\xc5\xe5\x27\x22\x37\xb8\x3e\x2e\xcb\x8c\xf6\x81\x02\xa6\xe0\x52\x94\x60\x25\x72\xbe\xc7\xae\x35\xab\xd9\xf3\x91\xce\x52\xbd\x8d\xbb\x26\xa0\x0c\x51\x03\x89\xdb\xc2\x7e\x31\xde\x9c\x34\x02\x4f\x3c\x45\x66\x00\x9c\x03\xb2\xf2\xec\x59\x1b\x03\x3e\x12\x7b\x87\xfc\x28\x49\x00\x9d\x94\x0c\x56\x63\x2c\x3f\xf9\x29\x54\x38\xe7\x99\xa0\xc4\xc4\x6f\x03\x2e\xe1\xb3\x21\x18\x3a\x71\x48\x7f\xc3\x61\xdd\x6b\x06\x40\xb3\x44\xac\x1e\x22\xb6\x90\x2f\x2e\x64\x75\xa6\xe5\xc9\xf8\x21\xcb\x9b\xdb\x6f\x21\x94\x82\xa0\xd5\xc0\xad\x42\xa5\x60\xb5\x11\xcd\xb5\x6b\x35\x31\x74\x16\x32\x1b\x23\x70\x56\xe5\x45\xee\xbc\xfd\x56\x9a\x4d\x28\x80\xc9\x94\x53\x7a\xa5\xc0\xae\xe0\x41\x55\xa7\x82\xc1\xda\x7b\xa3\x8b\x64\x2d\xd5\x76\xc6\x4a\x5a\x94\x8f\xef\x21\x43\xe2\xb1\x8b\x20\x32\xcb\xa5\x3a\x8e\xd0\x3c\x0c\x02\xeb\xc0\x27\x46\xa0\xc2\x14\x0a\x60\x2b\xb4\xca\x84\x7e\x27\x05\x16\x4c\x9c\x7d\x92\x94\x29\x90\xe3\x66\x20\xfb\xb8\x0a\x07\x93\x65\xbe\xa6\xaa\x11\x55\x90\x3d\xc9\x7a\x5e\x0a\x65\xfd\xa3\x17\x6a\x1c\x51\x7b\xe0\x86\xca\x3f\x48\xb6\x88\xae\xf7\xdc\xee\x39\xe7\xd0\x6e\xc3\x95\xb4\xab\x0d\xc9\x7b\x38\x63\x65\x0d\x56\x68\x1d\xee\x82\x12\x2d\x6c\x36\xc0\x6c\x82\x46\x48\x79\x9a\xae\x2f\xf9\xd8\x10\x89\x05\xb2\x83\xd5\x02\x64\xb9\x45\x2b\xee\x7e\x39\x46\x2f\x48\xc2\xd2\x64\x0d\x39\xbd\x78\x30\x9f\xd3\x67\xa9\x84\xec\xf0\x41\x1e\xdf\xb4\x73\x4f\x14\xba\x14\x57\xf1\xc5\x42\xfb\x2f\xc4\xc5\xef\x2f\xd2\xf7\xd2\x9d\xa9\x81\x13\xb2\x51\xf7\xdc\x70\x0d\xee\xd8\x83\xac\xb0\x00\x2e\x30\x83\xbc\xb5\xa5\x64\x82\xb8\x55\xff\x33\x28\xdb\x75\x72\x7b\x76\xb9\xbb\xac\xa3\x8d\x0c\x8c\xb9\x2a\xd2\x10\x35\x97\x22\x6a\x25\x32\x6c\x7b\x4f\xe8\x6f\x1b\xbb\x42\xf6\x87\x8c\xee\xf0\xa6\xc9\xb5\x93\x71\xf2\x70\xbc\x4b\xf1\x0e\x0d\xc3\x3e\x37\x4d\xb4\xfc\x91\x49\x4f\x4d\xaa\xea\x25\xe6\x99\x7e\x31\xa5\x46\x3b\x59\x12\x4b\xa4\x45\x3f\x0a\xf2\x63\x28\x34\x15\xc4\x3c\xb1\xdb\xfa\x44\xbe\x60\xdc\xfc\xf8\x21\x45\x18\xc2\x56\x0d\xca\x3a\x81\xb7\x94\xd5\xa5\xbf\xf9\x19\x61\xd1\x39\x25\xc0\x8c\x04\xfa\x33\xc8\x91\xf1\x44\x10\xdc\x3f\x71\xff\x49\xa1\xfd\x8e\xe6\xc9\x6b\x80\xd2\x09\x03\xb2\x49\xfe\x87\x97\xc1\xa2\x03\xb1\x6a\x24\x48\x02\xcb\xaf\x85\x73\x8d\x42\x2a\x62\x32\x5f\x51\x6c\xf7\xb2\x3b\x72\xe9\xfc\xa3\x79\x63\xb8\xf7\x4b\x5c\x19\x3f\x9b\x2c\xe4\x03\xed\xa1\x59\x1c\x0c\xa3\x4f\x53\xe4\xd7\xf3\xc2\xa6\xe7\x80\x91\x80\x0b\x66\xb0\x39\x85\x22\xa0\xb3\x2b\x14\x6b\x7f\x61\xc9\xb3\x99\x00\x58\x52\x9f\x0b\x9c\x84\xa5\xc0\x81\xd2\xb5\x88\x54\x21\x92\xb8\xd7\x6f\xe6\xfc\x52\xa0\x3b\xc7\x6f\x00\x71\x5b\xb4\x01\x04\x14\x65\x65\x05\x17\x92\x9d\xcf\x02\x9a\xdf\x4a\x88\x36\x04\x5f\x20\x61\xcb\x0c\x8b\x46\xba\xd3\xcf\x58\x10\x88\x25\xb9\xa9\x37\x49\x5e\xa8\x57\x6a\x5c\xa5\xcc\xd9\x1f\x0d\xfe\xff\x6a\x7d\xf6\x63\x44\xc5\x86\x13\xe0\x1c\x56\x80\x4c\x26\x53\xcc\x4b\xca\x41\xa5\x2e\x76\x61\x62\x99\xa2\x1c\x93\x21\x63\x31\xe1\x05\x42\xc3\x2a\x48\x62\x20\x96\xab\xfb\xcc\xf2\x27\x45\x5c\xe4\xa2\x37\x6c\xee\x9d\xf7\xa6\xb9\x55\x3e\x60\x7d\xee\x68\x67\x50\xf3\x60\x4e\x49\xc6\x40\x06\x68\xa8\xf3\x22\xae\x5d\x9c\xf6\x30\xa3\x55\x84\x79\x0e\x36\x8c\xc4\x38\xfe\xca\x6b\xc0\xf7\xdd\x0f\x52\x7a\xdc\x50\x1e\x84\x17\x08\xb1\x2b\x54\x58\xfa\x1d\x75\x84\x7d\x58\x1c\x0d\x08\xb0\xd8\xce\xaa\x3d\x79\xad\xe3\xab\xc9\x0c\x03\xb1\xdb\x09\xce\x70\xea\x64\xbc\x6b\x20\xb4\x0f\x8e\x46\x1d\xbf\x8a\xb1\x4f\x3e\x14\xea\x05\xe7\x65\xdd\xe8\xfa\x3d\xe0\x01\x3d\xa0\x89\xd2\xfc\x79\x5e\x24\x32\x39\x6f\x2e\x18\x87\x71\x80\xd0\x7b\x5d\x7b\x8c\x9c\x19\x29\xfe\x44\x2f\x72\x57\xf2\x03\x27\x8f\xfd\x66\xb1\x6f\xba\xfa\x14\x6d\x36\xe7\xfc\x56\x70\x00\x68\x95\x2c\x33\xbe\x5c\xee\xf6\x59\x6b\xc8\x85\xcf\x6b\xbf\x38\x82\x04\xee\x1c\x7f\x3b\xf6\xd5\xe2\xfd\x2e\x5e\x26\x07\x17\xb7\xca\x3c\x47\x68\xb7\x30\x6d\xd5\x4e\xca\x30\x44\x69\x3f\x7e\x65\x19\x29\xa2\xd7\xb5\x54\xf2\x68\xaa\x01\x5d\x85\xaa\xf2\x43\xb9\x46\x2d\xfb\x85\x3f\x2d\x54\x45\x97\x11\xee\xc9\x42\x2b\xd7\x75\xf1\x06\xc3\xfe\x5d\xa4\xfe\xf6\x0b\x94\xb0\x22\x83\xb1\x29\x48\x96\xa1\xa1\x17\x45\x1d\xb9\x00\xd7\x47\xf2\x7d\x1d\xb0\xa5\x45\x78\x8d\xef\xe4\xbc\x99\x2d\x90\xb5\x4f\x61\x1f\x4c\x3c\xb3\xde\xb4\xf3\xcd\x34\xf0\x4a\xa1\xa0\x7c\xf1\x4b\xfd\x61\x60\xa7\x89\x77\xa1\xf2\x18\xc3\x58\xeb\x17\x48\xab\xb5\x10\x9f\x2d\x4c\x8c\x90\x2c\xe0\xc5\x4f\x3a\x66\x52\xa5\xdd\xe7\xe7\xbb\x70\x3c\x56\x1c\xa4\x76\x32\xe2\x7a\x73\xb5\xfc\xae\xbb\x76\x90\x99\xe3\xd0\xde\xc7\x25\x02\x13\x18\xbd\x2b\x6b\xde\xd4\xfa\x2d\x1a\xfa\x52\xa5\x27\x63\x90\x0f\x72\x1c\x7e\xae\xea\x85\x34\x6d\x11\x6f\x45\x25\x42\x6b\x3d\xf9\xa0\x1d\xe0\xc1\x7b\x5d\xb2\xe0\x7a\x02\x9c\x73\x56\x1a\x5e\x16\x1f\x5e\xf1\x53\xaa\xf8\xaf\x54\x13\x9a\xe1\x78\x40\x58\x5f\x83\xc8\x6b\x14\x05\x9e\x7b\x51\x62\x7a\x9f\x93\x5a\x39\x58\xf1\xbb\xdb\xf0\xb7\xbe\x6c\x31\x85\xcf\xde\x60\x75\x80\x67\x7d\x98\x8b\x1d\xb4\x36\x1f\x7a\xb8\xa6\x14\xfb\x04\x72\xe1\x5a\x63\x1c\x38\x4d\x95\xf0\xe6\x41\x8e\x5a\x41\x95\x09\x6d\xab\xff\x05\x55\x67\x15\xb9\xd4\xdc\x4b\x8b\xd6\xa5\xe1\x22\x1e\xb8\x26\x2a\xb5\x1a\x58\x3a\xb9\x13\x4c\x18\xd1\xaf\xc4\xf4\xeb\xb8\x3b\xbe\xbf\xba\x35\x17\xd1\x30\xba\xa2\x61\x61\x44\x67\x4c\xd3\x04\x97\x10\x2c\x0c\xf0\x73\x11\xa4\xb2\x70\xcc\x42\x9c\x38\xd6\x62\xe5\x5c\xea\x07\x2a\xcf\x0f\x32\x48\x10\x54\x5f\x57\xac\x43\xf5\x8d\x95\xdd\x9a\x42\x7e\xa4\x7b\xf8\x7a\x4d\xe4\x14\xe8\x44\x2c\x24\xe2\xd4\xc2\x29\xd2\x52\x74\x61\x9c\x4a\x87\xa2\x0e\xd8\x23\xd3\xc8\x62\x4c\xcb\xca\x29\x05\x57\xec\x1d\x78\xa4\x6c\x48\x9c\xca\x8d\x38\xb9\x4f\xe8\xa3\x2e\x3e\x92\xb2\xbe\x26\x42\xee\xb9\xdc\x74\xb8\x5c\xf1\x00\x61\x05\xc0\x90\x08\xcd\xe9\xb8\xe1\x80\x63\xd5\x55\xb8\x6c\xa2\x44\xe2\x86\x84\xee\x9c\x13\x26\x2f\xa6\x3d\x42\xc3\x54\x03\x61\x4b\xfb\xb2\x02\x9f\x26\x41\x0b\x4a\xbc\x9f\x3d\xbf\x57\xcc\xa1\x02\x0e\xa5\x32\xb9\x95\x7d\x45\xde\xec\x9b\x53\xba\x16\x98\x6f\x21\x4d\x98\x79\x0b\x97\x92\x65\xfb\x82\xd0\xf1\xa3\xa8\x26\xcc\xe0\xe6\xce\xe3\xbd\xfb\xf1\x66\x86\xd0\x8f\xc8\x34\xd2\xe2\x37\xc9\x77\x31\x8a\x06\xd5\x61\xcb\xc9\x31\x59\x04\x23\x54\xb2\x11\x41\x71\x1f\x66\x3c\x0f\xc4\x36\x0f\xb7\xbf\x3e\xe1\x9e\x06\x0d\x16\x46\x73\xee\x3b\x96\xb9\xdf\xe3\x10\xd5\xa1\x2b\xac\x20\x2d\x25\xf4\x6e\x6f\xce\x68\x1f\x04\xb9\xe1\x5b\x81\x9e\x61\x2e\x14\x80\x2f\xec\xe7\x6e\xc5\x03\x7d\xb0\x02\x3a\x77\x3f\xac\x36\xf1\x41\xa3\xdb\x49\xd2\xaf\x1a\xf0\x39\xc5\x24\x95\x05\x35\xba\xa8\x17\x3e\xd3\xf9\xa7\xf7\x33\x1a\x59\xb7\x33\x28\xc8\x2c\x47\xe8\xc9\x8b\x38\xd4\x13\x30\x22\x16\x35\xb7\x67\x0e\xd3\x62\xb4\x56\xc4\xc7\xfd\x37\x91\x90\x97\xd2\xf7\x47\xe6\x83\xa6\x3a\x36\xeb\x44\x27\x0c\xbd\x9f\x2f\x6e\x0e\x62\xab\x7f\x89\x84\x83\xad\x1c\x4b\x95\x20\xd9\x0f\xfe\x02\xb0\xcb\xc5\x88\xca\x43\x3a\x7d\x54\x84\x8f\x45\x92\xbe\x95\x34\x9f\x63\xba\xbc\x54\x0e\xb3\x7d\x12\x3a\x60\xad\xdf\xaa\xab\x9a\x06\x07\xae\xeb\x8a\x9d\x8f\x83\xeb\x4b\xaa\x9a\x7c\x0b\x31\xd6\xe4\x2b\xba\x7e\xc4\xca\xb2\xf0\x02\x48\x24\x5c\xfc\xa8\x67\xbc\x46\xdb\x16\x37\x5c\x6e\x6a\xce\x51\xd9\x74\x5b\x1b\x55\x3c\xcc\xfb\x85\xa3\x20\x8f\x23\xfc\xc0\x4d\xb1\xc6\x1c\x78\xd9\x57\xfc\xe1\x86\x08\x42\x4d\x75\xbd\xd3\x37\x98\x8e\x8d\x4b\xec\x1d\xba\x81\xd9\x44\xe0\x88\xbe\xa5\xbf\xed\x5a\x78\x24\xbf\x30\xb9\x8c\xea\x0f\xa9\xfa\xd1\x2e\xac\xb0\x39\x28\x2d\x47\xea\x59\x59\xf7\xe0\x70\x59\xe5\xb5\xeb\x5b\x4b\x6e\x31\x71\x1b\x43\xd7\x31\xfd\xb3\xc0\x46\x5e\xe4\x6a\x3b\x40\x4e\x98\x46\xf8\xfd\x28\xbe\x7d\xdf\x35\x4f\xd2\x18\xc0\xd6\x10\x9c\x72\x20\x45\xaa\x7e\x0d\xdf\x26\xdf\xf7\xbe\x2e\x1a\x35\x11\x2f\xad\xe2\x8d\x49\x69\x78\x35\xcc\xeb\x9d\x49\xee\xf9\x62\x7f\xe7\x2f\x33\xb4\x42\xa4\x38\xf8\xa6\x00\x7e\xe0\x9f\xce\x9f\x87\xdf\x03\x60\x28\xfa\x29\xc7\x6d\xcb\x55\xae\x88\x63\x0f\xd7\x44\x5d\xc2\x45\xfa\xe3\x2f\xe0\xd0\xd2\xbe\x1c\xd8\x40\x17\x73\x85\xca\x54\x04\x64\xa8\x1d\x23\x66\x2d\x60\x2e\x06\x75\x5b\xc8\xb9\x1d\x38\x39\xa6\xa0\x7c\xb2\xb5\x10\x5c\xeb\x67\xa7\xdb\x9c\x40\xcb\xb0\x49\x22\x62\x17\x49\xe7\x65\x7b\xcc\x16\xf1\x7d\x98\x7b\xdf\x1a\x7d\x37\x8e\xd9\x96\xf4\xbf\x96\x41\x1b\xa0\x85\x0e\xe9\x14\x06\x60\xbb\x12\xb8\x99\x28\x40\x57\x04\x89\xe8\x2a\xd4\xbd\xed\x4c\x75\xf4\x27\x49\xf0\xac\x1b\x27\x67\xad\x85\xa9\x42\x7b\x5f\xdc\xb1\xb8\xa0\xc4\x26\x88\xad\x75\x2f\x57\x8c\xc8\x39\x49\x33\x79\x29\x0a\xb0\x63\xd6\xf1\x94\x7d\xa1\xf3\xec\x34\x46\xf5\xdd\x15\xbf\x9f\xce\x9e\x45\x9d\x0e\xa3\xef\x41\x79\x97\xb1\xd2\x45\x21\xe9\x49\x92\x73\x30\xe9\x45\xb7\x1f\x1b\x04\xa8\x94\xcf\x7c\x4b\x36\x19\xf9\x4f\xda\xc6\x93\x68\x0d\xe8\x6c\x78\x82\x25\x6c\xb3\x3d\xe2\x0f\xf8\xde\xc9\x74\xf4\xa7\x5e\x1b\x85\x59\x02\x32\x14\x13\xd9\x3b\x2e\x9e\x05\x54\x97\xe7\xdc\xa0\xef\x69\x2a\xb0\xa9\x86\xa3\x56\x76\x59\x00\x35\xbe\x04\x89\x7c\x71\x7d\x14\xe1\x0c\xe7\xa4\x9b\xfe\xd5\x44\x72\x0c\x27\x7b\x8c\xc0\x7c\xea\x68\x4e\xa1\x36\xb5\xdc\xdf\xf3\x39\x62\x08\xd6\x6c\x93\x01\x0e\xef\x65\x5a\xd0\x24\xe9\x3b\x7e\xc7\xf0\xc8\xd5\x6a\x71\xa3\x65\x73\xcc\x29\x82\x1e\x3d\x86\xa2\xd0\x37\x56\x2b\xf2\xc2\x35\x7f\x96\xf6\xed\x44\x6a\xf3\xef\x1d\x9a\xda\x82\x50\x6b\x6a\x92\x45\x3c\x52\x0f\x63\x82\xfc\x88\x49\x7a\xd5\xec\x45\xf3\x96\x40\xf5\xc2\x5c\x78\xac\x81\x7a\xb2\xd3\x2d\x1b\x31\xbb\x81\xd4\x5d\x62\x49\x5f\x1f\x9c\x38\x6f\x77\xa8\xaf\xf9\x1a\x82\xcf\x17\x17\xb3\xd0\x49\x3d\xd9\xe3\x5d\xaa\xb0\xee\x5d\x7e\x53\xfd\xe8\xe6\x2d\xa5\x28\x31\x1d\x80\xb1\x15\x86\x32\x5f\x6d\x6b\x1f\x08\xf1\xb4\x3f\xbc\x25\x63\x1d\x67\x39\xe5\xe2\x67\x83\x7e\x45\xef\x92\xd9\xba\x35\xdd\xfd\x7b\x41\x10\x9c\x47\xfc\xdb\xfe\xf9\xf3\xe7\xdf\xff\x02\x00\x00\xff\xff\xc5\xfa\x3c\x15\x52\x88\x00\x00")
@@ -31,11 +31,11 @@ func Asset(path string) ([]byte, string, string, error) {
 	case "/css/bootstrap.min.css":
 		return cssbootstrapMinCss, "7a09b77e8305939cc4e5bd59da1c6ead", "text/css; charset=utf-8", nil
 	case "/css/style.css":
-		return cssstyleCss, "0a5b418e0fa055db34e6208c9a4afbff", "text/css; charset=utf-8", nil
+		return cssstyleCss, "c4b0fc1ce7a3beaa62c0e0a8329dbcaa", "text/css; charset=utf-8", nil
 	case "/", "/index.html":
-		return indexHtml, "37b728c64c0b807a15131769d3ed24f7", "text/html; charset=utf-8", nil
+		return indexHtml, "b7d693dc50444970f4cf97e2fdf5196b", "text/html; charset=utf-8", nil
 	case "/js/auxiliary.js":
-		return jsauxiliaryJs, "464c7bbd8d8d2654abaebe6265e3d7e9", "application/javascript", nil
+		return jsauxiliaryJs, "abf5c9081cfb9cba416b5d495c22f319", "application/javascript", nil
 	case "/js/hprose-html5.min.js":
 		return jshproseHtml5MinJs, "0bacd238d0dd6c0f16a9975682b59995", "application/javascript", nil
 	default:
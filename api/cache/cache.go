@@ -2,13 +2,12 @@ package cache
 
 import (
 	"errors"
+	"fmt"
+	"hash/maphash"
+	"math/rand/v2"
 	"sync"
 	"sync/atomic"
 	"time"
-
-	"github.com/dgryski/go-farm"
-
-	"video-chat/api/rtc"
 )
 
 const (
@@ -18,81 +17,137 @@ const (
 	DefaultExpiration time.Duration = 0
 )
 
-type Session = rtc.Session
-type OnEvictHandler func(value *Session)
+type OnEvictHandler[V any] func(value V)
+
+// EvictionPolicy chooses the item evicted when the cache size limit is hit. The policies are approximate:
+// the victim is the best one of a sample of evictionSamples items taken from a random bucket onwards,
+// not of all items, so e.g. LRU never evicts the evictionSamples-1 most recently used items but does not
+// necessarily evict the least recently used one. Expired items are evicted first
+type EvictionPolicy int
+
+const (
+	// Reject refuses new items, MaxSizeExceed is returned
+	Reject EvictionPolicy = iota
+	// LRU evicts the least recently used item of the sample
+	LRU
+	// LFU evicts the least frequently used item of the sample
+	LFU
+	// OldestExpiry evicts the item of the sample which expires first, items without expiration are evicted last
+	OldestExpiry
+)
+
+const (
+	// number of items compared to choose the evicted one, the whole cache is compared if it is not larger
+	evictionSamples = 16
+	// attempts to evict an item while the cache is concurrently modified
+	evictionAttempts = 3
+)
 
 var (
 	NotFoundError = errors.New("key not found")
-	CloseError = errors.New("cache has been closed")
+	CloseError    = errors.New("cache has been closed")
 	MaxSizeExceed = errors.New("cache max size exceed")
 )
 
-type storedValue struct {
-	object     *Session
+type storedValue[V any] struct {
+	object V
+	// updated atomically
 	expiration int64
+	// logical time of the last access, updated atomically
+	accessed uint64
+	// number of accesses, updated atomically
+	hits uint64
 }
 
-type Bucket struct {
+type Bucket[K comparable, V any] struct {
 	sync.RWMutex
-	values map[uint64]storedValue
+	values map[K]*storedValue[V]
 }
 
-type evictEvent struct {
+type evictEvent[K comparable] struct {
 	bucketIdx int
-	hash      uint64
+	key       K
 }
 
-//Cache typed cache
-type Cache struct {
+// Cache generic cache sharded into buckets by the key hash
+type Cache[K comparable, V any] struct {
 	cacheSize  int
 	numBuckets int
 
 	defaultExpiration time.Duration
+	policy            EvictionPolicy
 
 	ticker     *time.Ticker
-	evictionCh chan evictEvent
+	evictionCh chan evictEvent[K]
 
 	size   int32
 	closed int32
+	clock  uint64
+
+	seed    maphash.Seed
+	storage []Bucket[K, V]
+	onEvict OnEvictHandler[V]
+}
 
-	storage []Bucket
-	onEvict OnEvictHandler
+func (item *storedValue[V]) getExpiration() int64 {
+	return atomic.LoadInt64(&item.expiration)
 }
 
-func (item *storedValue) isExpired() bool {
-	return item.expiration > 0 && time.Now().UnixNano() > item.expiration
+func (item *storedValue[V]) isExpired() bool {
+	expiration := item.getExpiration()
+	return expiration > 0 && time.Now().UnixNano() > expiration
 }
 
-//New typed cache constructor
-func New(cacheSize int, numBuckets int, defaultExpiration, cleanupInterval time.Duration, onEvict OnEvictHandler) *Cache {
+// New generic cache constructor, the policy is applied when the cache size limit is hit
+func New[K comparable, V any](cacheSize int, numBuckets int, defaultExpiration, cleanupInterval time.Duration, policy EvictionPolicy, onEvict OnEvictHandler[V]) *Cache[K, V] {
 	if cacheSize <= 0 {
 		cacheSize = DefaultCacheSize
 	}
 	if numBuckets <= 0 {
 		numBuckets = DefaultNumBuckets
 	}
-	c := &Cache{
+	c := &Cache[K, V]{
 		cacheSize:         cacheSize,
 		numBuckets:        numBuckets,
 		defaultExpiration: defaultExpiration,
+		policy:            policy,
 		ticker:            time.NewTicker(cleanupInterval),
-		evictionCh:        make(chan evictEvent, cacheSize),
+		evictionCh:        make(chan evictEvent[K], cacheSize),
+		seed:              maphash.MakeSeed(),
 		onEvict:           onEvict,
 	}
-	c.storage = make([]Bucket, numBuckets)
+	c.storage = make([]Bucket[K, V], numBuckets)
 	for i := 0; i < numBuckets; i++ {
-		c.storage[i].values = make(map[uint64]storedValue)
+		c.storage[i].values = make(map[K]*storedValue[V])
 	}
 	go c.cacheEvict()
 	return c
 }
 
-func (c *Cache) getBucketIdx(key string) (uint64, int) {
-	hash := farm.Hash64([]byte(key))
-	return hash, int(hash % uint64(c.numBuckets))
+func (c *Cache[K, V]) getBucket(key K) *Bucket[K, V] {
+	hash := maphash.Comparable(c.seed, key)
+	return &c.storage[hash%uint64(c.numBuckets)]
+}
+
+func keyString[K comparable](key K) string {
+	return fmt.Sprint(key)
+}
+
+func (c *Cache[K, V]) newValue(value V, d time.Duration) *storedValue[V] {
+	return &storedValue[V]{object: value, expiration: c.getExpiration(d), accessed: c.tick(), hits: 1}
+}
+
+// tick advances the logical clock of accesses
+func (c *Cache[K, V]) tick() uint64 {
+	return atomic.AddUint64(&c.clock, 1)
+}
+
+func (c *Cache[K, V]) touch(item *storedValue[V]) {
+	atomic.StoreUint64(&item.accessed, c.tick())
+	atomic.AddUint64(&item.hits, 1)
 }
 
-func (c *Cache) getExpiration(d time.Duration) int64 {
+func (c *Cache[K, V]) getExpiration(d time.Duration) int64 {
 	if d == DefaultExpiration {
 		d = c.defaultExpiration
 	}
@@ -102,106 +157,113 @@ func (c *Cache) getExpiration(d time.Duration) int64 {
 	return 0
 }
 
-//Set value by key
-func (c *Cache) Set(key string, value *Session, d time.Duration) (previous *Session, err error) {
+// Set value by key
+func (c *Cache[K, V]) Set(key K, value V, d time.Duration) (previous V, err error) {
 	if c.isClosed() {
 		err = CloseError
 		return
 	}
-	h, bucketIdx := c.getBucketIdx(key)
-	bucket := &c.storage[bucketIdx]
+	bucket := c.getBucket(key)
 	bucket.Lock()
-	item, ok := bucket.values[h]
+	item, ok := bucket.values[key]
+	for !ok && c.getSize() >= c.cacheSize {
+		bucket.Unlock()
+		if !c.evict() {
+			err = MaxSizeExceed
+			return
+		}
+		bucket.Lock()
+		item, ok = bucket.values[key]
+	}
 	if ok {
 		if !item.isExpired() {
 			previous = item.object
 		}
 	} else {
-		if c.cacheSize == c.getSize() {
-			bucket.Unlock()
-			err = MaxSizeExceed
-			return
-		}
 		c.incSize()
 	}
-	bucket.values[h] = storedValue{value, c.getExpiration(d)}
+	bucket.values[key] = c.newValue(value, d)
 	bucket.Unlock()
 	return
 }
 
-func (c *Cache) Add(key string, value *Session, d time.Duration) (err error) {
+func (c *Cache[K, V]) Add(key K, value V, d time.Duration) (err error) {
 	if c.isClosed() {
 		err = CloseError
 		return
 	}
-	if c.cacheSize == c.getSize() {
-		err = MaxSizeExceed
-		return
-	}
-	h, bucketIdx := c.getBucketIdx(key)
-	bucket := &c.storage[bucketIdx]
+	bucket := c.getBucket(key)
 	bucket.Lock()
-	item, ok := bucket.values[h]
+	item, ok := bucket.values[key]
+	for !ok && c.getSize() >= c.cacheSize {
+		bucket.Unlock()
+		if !c.evict() {
+			err = MaxSizeExceed
+			return
+		}
+		bucket.Lock()
+		item, ok = bucket.values[key]
+	}
 	if ok && !item.isExpired() {
 		bucket.Unlock()
-		err = errors.New("item '" + key + "' already exists")
+		err = errors.New("item '" + keyString(key) + "' already exists")
 		return
 	}
-	c.incSize()
-	bucket.values[h] = storedValue{value, c.getExpiration(d)}
+	if !ok {
+		c.incSize()
+	}
+	bucket.values[key] = c.newValue(value, d)
 	bucket.Unlock()
 	return
 }
 
-func (c *Cache) Replace(key string, value *Session, d time.Duration) (previous *Session, err error) {
+func (c *Cache[K, V]) Replace(key K, value V, d time.Duration) (previous V, err error) {
 	if c.isClosed() {
 		err = CloseError
 		return
 	}
-	h, bucketIdx := c.getBucketIdx(key)
-	bucket := &c.storage[bucketIdx]
+	bucket := c.getBucket(key)
 	bucket.Lock()
-	item, ok := bucket.values[h]
+	item, ok := bucket.values[key]
 	if !ok || item.isExpired() {
 		bucket.Unlock()
-		err = errors.New("item '" + key + "' doesn't exist")
+		err = errors.New("item '" + keyString(key) + "' doesn't exist")
 		return
 	}
 	previous = item.object
-	bucket.values[h] = storedValue{value, c.getExpiration(d)}
+	bucket.values[key] = c.newValue(value, d)
 	bucket.Unlock()
 	return
 }
 
-//Get value by key
-func (c *Cache) Get(key string) (value *Session, err error) {
+// Get value by key
+func (c *Cache[K, V]) Get(key K) (value V, err error) {
 	if c.isClosed() {
 		err = CloseError
 		return
 	}
-	h, bucketIdx := c.getBucketIdx(key)
-	bucket := &c.storage[bucketIdx]
+	bucket := c.getBucket(key)
 	bucket.RLock()
-	item, ok := bucket.values[h]
-	bucket.RUnlock()
+	defer bucket.RUnlock()
+	item, ok := bucket.values[key]
 	if !ok || item.isExpired() {
 		err = NotFoundError
 		return
 	}
+	c.touch(item)
 	value = item.object
 	return
 }
 
-//Delete value by key
-func (c *Cache) Delete(key string) (err error) {
+// Delete value by key
+func (c *Cache[K, V]) Delete(key K) (err error) {
 	if c.isClosed() {
 		err = CloseError
 		return
 	}
-	h, bucketIdx := c.getBucketIdx(key)
-	bucket := &c.storage[bucketIdx]
+	bucket := c.getBucket(key)
 	bucket.Lock()
-	item, ok := bucket.values[h]
+	item, ok := bucket.values[key]
 	if !ok {
 		bucket.Unlock()
 		err = NotFoundError
@@ -211,12 +273,12 @@ func (c *Cache) Delete(key string) (err error) {
 		err = NotFoundError
 	}
 	c.decSize()
-	delete(bucket.values, h)
+	delete(bucket.values, key)
 	bucket.Unlock()
 	return
 }
 
-func (c *Cache) cacheEvict() {
+func (c *Cache[K, V]) cacheEvict() {
 	bucketIdx := 0
 
 	for !c.isClosed() {
@@ -224,13 +286,13 @@ func (c *Cache) cacheEvict() {
 		case <-c.ticker.C:
 			bucket := &c.storage[bucketIdx]
 			bucket.RLock()
-			for hash, value := range bucket.values {
+			for key, value := range bucket.values {
 				if value.isExpired() {
-					c.evictionCh <- evictEvent{bucketIdx, hash}
+					c.evictionCh <- evictEvent[K]{bucketIdx, key}
 				}
 			}
 			bucket.RUnlock()
-			if bucketIdx < c.numBuckets - 1 {
+			if bucketIdx < c.numBuckets-1 {
 				bucketIdx++
 			} else {
 				bucketIdx = 0
@@ -238,10 +300,11 @@ func (c *Cache) cacheEvict() {
 		case e := <-c.evictionCh:
 			bucket := &c.storage[e.bucketIdx]
 			bucket.Lock()
-			item, ok := bucket.values[e.hash]
-			if ok {
+			item, ok := bucket.values[e.key]
+			// the item could have been replaced since
+			if ok && item.isExpired() {
 				c.decSize()
-				delete(bucket.values, e.hash)
+				delete(bucket.values, e.key)
 				if c.onEvict != nil {
 					go c.onEvict(item.object)
 				}
@@ -251,27 +314,96 @@ func (c *Cache) cacheEvict() {
 	}
 }
 
-//Len get stored elements count
-func (c *Cache) Size() int {
+// evict removes the item chosen by the policy, it reports whether an item has been evicted
+func (c *Cache[K, V]) evict() bool {
+	if c.policy == Reject {
+		return false
+	}
+	for attempt := 0; attempt < evictionAttempts; attempt++ {
+		key, victim, bucketIdx := c.sample()
+		if victim == nil {
+			return false
+		}
+		bucket := &c.storage[bucketIdx]
+		bucket.Lock()
+		// the victim could have been replaced or removed while sampling
+		if bucket.values[key] == victim {
+			c.decSize()
+			delete(bucket.values, key)
+			bucket.Unlock()
+			if c.onEvict != nil {
+				go c.onEvict(victim.object)
+			}
+			return true
+		}
+		bucket.Unlock()
+	}
+	return false
+}
+
+// sample compares a number of items starting from a random bucket and returns the best victim
+func (c *Cache[K, V]) sample() (key K, victim *storedValue[V], bucketIdx int) {
+	start := rand.IntN(c.numBuckets)
+	sampled := 0
+	for i := 0; i < c.numBuckets && sampled < evictionSamples; i++ {
+		idx := (start + i) % c.numBuckets
+		bucket := &c.storage[idx]
+		bucket.RLock()
+		for k, item := range bucket.values {
+			if victim == nil || c.isBetterVictim(item, victim) {
+				key, victim, bucketIdx = k, item, idx
+			}
+			sampled++
+			if sampled >= evictionSamples {
+				break
+			}
+		}
+		bucket.RUnlock()
+	}
+	return
+}
+
+// isBetterVictim reports whether the item should be evicted rather than the other, expired items go first
+func (c *Cache[K, V]) isBetterVictim(item, other *storedValue[V]) bool {
+	if expired, otherExpired := item.isExpired(), other.isExpired(); expired != otherExpired {
+		return expired
+	}
+	accessed, otherAccessed := atomic.LoadUint64(&item.accessed), atomic.LoadUint64(&other.accessed)
+	switch c.policy {
+	case LFU:
+		if hits, otherHits := atomic.LoadUint64(&item.hits), atomic.LoadUint64(&other.hits); hits != otherHits {
+			return hits < otherHits
+		}
+	case OldestExpiry:
+		if expiration, otherExpiration := item.getExpiration(), other.getExpiration(); expiration != otherExpiration {
+			// no expiration is the latest one
+			return otherExpiration == 0 || expiration != 0 && expiration < otherExpiration
+		}
+	}
+	return accessed < otherAccessed
+}
+
+// Len get stored elements count
+func (c *Cache[K, V]) Size() int {
 	if c.isClosed() {
 		return 0
 	}
 	return c.getSize()
 }
 
-func (c *Cache) getSize() int {
+func (c *Cache[K, V]) getSize() int {
 	return int(atomic.LoadInt32(&c.size))
 }
 
-func (c *Cache) incSize() int {
+func (c *Cache[K, V]) incSize() int {
 	return int(atomic.AddInt32(&c.size, 1))
 }
 
-func (c *Cache) decSize() int {
+func (c *Cache[K, V]) decSize() int {
 	return int(atomic.AddInt32(&c.size, -1))
 }
 
-func (c *Cache) Close() {
+func (c *Cache[K, V]) Close() {
 	if c.isClosed() {
 		return
 	}
@@ -281,34 +413,33 @@ func (c *Cache) Close() {
 	for i := 0; i < c.numBuckets; i++ {
 		bucket := &c.storage[i]
 		bucket.Lock()
-		bucket.values = make(map[uint64]storedValue)
+		bucket.values = make(map[K]*storedValue[V])
 		bucket.Unlock()
 	}
 	atomic.StoreInt32(&c.size, 0)
 }
 
-//Keep alive and return the element
-func (c *Cache) KeepAlive(key string, d time.Duration) (value *Session, err error) {
+// Keep alive and return the element
+func (c *Cache[K, V]) KeepAlive(key K, d time.Duration) (value V, err error) {
 	if c.isClosed() {
 		err = CloseError
 		return
 	}
-	h, bucketIdx := c.getBucketIdx(key)
-	bucket := &c.storage[bucketIdx]
+	bucket := c.getBucket(key)
 	bucket.Lock()
-	item, ok := bucket.values[h]
+	item, ok := bucket.values[key]
 	if !ok || item.isExpired() {
 		bucket.Unlock()
 		err = NotFoundError
 		return
 	}
-	item.expiration = c.getExpiration(d)
-	bucket.values[h] = item
+	atomic.StoreInt64(&item.expiration, c.getExpiration(d))
+	c.touch(item)
 	bucket.Unlock()
 	value = item.object
 	return
 }
 
-func (c *Cache) isClosed() bool {
+func (c *Cache[K, V]) isClosed() bool {
 	return atomic.LoadInt32(&c.closed) != 0
 }
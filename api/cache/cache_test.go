@@ -15,10 +15,14 @@ var (
 	shortTime = time.Second * 1
 )
 
-var testValues = []cache.Session{{Id: "session-1"}, {Id: "session-2"}}
+type Session struct {
+	Id string
+}
+
+var testValues = []Session{{Id: "session-1"}, {Id: "session-2"}}
 
 func TestCache_Get_OneKeyExists(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -44,7 +48,7 @@ func TestCache_Get_OneKeyExists(t *testing.T) {
 }
 
 func TestCache_Get_OneKey_NotExists(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -69,7 +73,7 @@ func TestCache_Get_OneKey_NotExists(t *testing.T) {
 }
 
 func TestCache_Get_OneKey_Repeat(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -106,7 +110,7 @@ func TestCache_Get_OneKey_Repeat(t *testing.T) {
 }
 
 func TestCache_Get_OneKey_TTL_ClearByGC(t *testing.T) {
-	c := cache.New(50000, 5, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](50000, 5, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -151,7 +155,7 @@ func TestCache_Get_OneKey_TTL_ClearByGC(t *testing.T) {
 }
 
 func TestCache_Get_OneKey_TTL_ClearByGC_OneKeyLive(t *testing.T) {
-	c := cache.New(50000, 5, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](50000, 5, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -236,7 +240,7 @@ func TestCache_Get_OneKey_TTL_ClearByGC_OneKeyLive(t *testing.T) {
 }
 
 func TestCache_Get_Close(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	c.Close()
 
 	_, err := c.Get(uniuri.NewLen(8))
@@ -246,7 +250,7 @@ func TestCache_Get_Close(t *testing.T) {
 }
 
 func TestCache_Delete(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -276,7 +280,7 @@ func TestCache_Delete(t *testing.T) {
 }
 
 func TestCache_Delete_Close(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	c.Close()
 
 	err := c.Delete(uniuri.NewLen(8))
@@ -286,7 +290,7 @@ func TestCache_Delete_Close(t *testing.T) {
 }
 
 func TestCache_Delete_Repeat(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -331,7 +335,7 @@ func TestCache_Delete_Repeat(t *testing.T) {
 }
 
 func TestCache_Size_Zero(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	if n := c.Size(); n != 0 {
@@ -340,7 +344,7 @@ func TestCache_Size_Zero(t *testing.T) {
 }
 
 func TestCache_Size_One(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -360,7 +364,7 @@ func TestCache_Size_One(t *testing.T) {
 }
 
 func TestCache_Size_Close(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 
 	key := uniuri.NewLen(8)
 	toStore := &testValues[0]
@@ -385,7 +389,7 @@ func TestCache_Size_Close(t *testing.T) {
 }
 
 func TestCache_Size_Many(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	for i := 0; i < 2; i++ {
@@ -407,7 +411,7 @@ func TestCache_Size_Many(t *testing.T) {
 }
 
 func TestCache_Close(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 
 	key := uniuri.NewLen(8)
 	toStore := &testValues[0]
@@ -436,7 +440,7 @@ func TestCache_Close(t *testing.T) {
 }
 
 func TestCache_Close_Repeat(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 
 	key := uniuri.NewLen(8)
 	toStore := &testValues[0]
@@ -466,7 +470,7 @@ func TestCache_Close_Repeat(t *testing.T) {
 }
 
 func TestCache_Set_One_Repeat(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -525,7 +529,7 @@ func TestCache_Set_One_Repeat(t *testing.T) {
 
 func TestCache_Set_Overflow(t *testing.T) {
 	cacheSize := 50_000
-	c := cache.New(cacheSize, 5, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](cacheSize, 5, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	k := uniuri.NewLen(8)
@@ -624,7 +628,7 @@ func TestCache_Set_Overflow(t *testing.T) {
 }
 
 func TestCache_Add_One_Repeat(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -659,7 +663,7 @@ func TestCache_Add_One_Repeat(t *testing.T) {
 }
 
 func TestCache_Replace_One_Repeat(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -710,7 +714,7 @@ func TestCache_Replace_One_Repeat(t *testing.T) {
 }
 
 func TestCache_KeepAlive_One_Repeat(t *testing.T) {
-	c := cache.New(0, 0, time.Minute, time.Second * 1, nil)
+	c := cache.New[string, *Session](0, 0, time.Minute, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -765,7 +769,7 @@ func TestCache_OnEvict(t *testing.T) {
 	key := uniuri.NewLen(8)
 	toStore := &testValues[0]
 
-	c := cache.New(50_000, 5, time.Second * 1, time.Second * 1, func(value *cache.Session) {
+	c := cache.New[string, *Session](50_000, 5, time.Second * 1, time.Second * 1, cache.Reject, func(value *Session) {
 		diff := pretty.DiffMessage(value, toStore)
 		if len(diff) != 0 {
 			t.Fatal(diff)
@@ -796,7 +800,7 @@ func TestCache_OnEvict(t *testing.T) {
 }
 
 func TestCache_Set_Persistent(t *testing.T) {
-	c := cache.New(50_000, 5, time.Second * 5, time.Second * 1, nil)
+	c := cache.New[string, *Session](50_000, 5, time.Second * 5, time.Second * 1, cache.Reject, nil)
 	defer c.Close()
 
 	key := uniuri.NewLen(8)
@@ -840,3 +844,125 @@ func TestCache_Set_Persistent(t *testing.T) {
 		t.Fatal("incorrect c size")
 	}
 }
+
+func TestCache_Evict_LRU(t *testing.T) {
+	evicted := make(chan *Session, 1)
+	c := cache.New[string, *Session](2, 1, time.Minute, time.Second * 1, cache.LRU, func(value *Session) {
+		evicted <- value
+	})
+	defer c.Close()
+
+	if err := c.Add("key-1", &testValues[0], cache.DefaultExpiration); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("key-2", &testValues[1], cache.DefaultExpiration); err != nil {
+		t.Fatal(err)
+	}
+	// key-2 becomes the least recently used
+	if _, err := c.Get("key-1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("key-3", &testValues[0], cache.DefaultExpiration); err != nil {
+		t.Fatal(err)
+	}
+	if c.Size() != 2 {
+		t.Fatal("incorrect c size")
+	}
+	if _, err := c.Get("key-2"); err != cache.NotFoundError {
+		t.Fatal("key-2 has not been evicted")
+	}
+	if value := <-evicted; value != &testValues[1] {
+		t.Fatal("incorrect evicted value")
+	}
+}
+
+func TestCache_Evict_LFU(t *testing.T) {
+	c := cache.New[string, *Session](2, 1, time.Minute, time.Second * 1, cache.LFU, nil)
+	defer c.Close()
+
+	if err := c.Add("key-1", &testValues[0], cache.DefaultExpiration); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("key-2", &testValues[1], cache.DefaultExpiration); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := c.Get("key-1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// key-2 is used more recently but less frequently
+	if _, err := c.Get("key-2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Set("key-3", &testValues[0], cache.DefaultExpiration); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get("key-2"); err != cache.NotFoundError {
+		t.Fatal("key-2 has not been evicted")
+	}
+	if _, err := c.Get("key-1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCache_Evict_OldestExpiry(t *testing.T) {
+	c := cache.New[string, *Session](2, 1, time.Minute, time.Second * 1, cache.OldestExpiry, nil)
+	defer c.Close()
+
+	if err := c.Add("key-1", &testValues[0], cache.NoExpiration); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("key-2", &testValues[1], longTime); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("key-3", &testValues[0], longTime); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get("key-2"); err != cache.NotFoundError {
+		t.Fatal("key-2 has not been evicted")
+	}
+	if _, err := c.Get("key-1"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCache_Evict_Reject(t *testing.T) {
+	c := cache.New[string, *Session](1, 1, time.Minute, time.Second * 1, cache.Reject, nil)
+	defer c.Close()
+
+	if err := c.Add("key-1", &testValues[0], cache.DefaultExpiration); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Add("key-2", &testValues[1], cache.DefaultExpiration); err != cache.MaxSizeExceed {
+		t.Fatal("incorrect error")
+	}
+}
+
+func TestCache_Evict_LRU_Sampled(t *testing.T) {
+	// the cache is larger than the sample, so the victims are chosen from the samples only
+	const size = 256
+	const recent = 15
+	c := cache.New[int, *Session](size, 4, time.Minute, time.Second*1, cache.LRU, nil)
+	defer c.Close()
+
+	for key := 0; key < size; key++ {
+		if err := c.Add(key, &testValues[0], cache.DefaultExpiration); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for key := size; key < 4*size; key++ {
+		// the recently used items are newer than any other item of a sample
+		for recentKey := 0; recentKey < recent; recentKey++ {
+			if _, err := c.Get(recentKey); err != nil {
+				t.Fatal("recently used item has been evicted", recentKey)
+			}
+		}
+		if err := c.Add(key, &testValues[0], cache.DefaultExpiration); err != nil {
+			t.Fatal(err)
+		}
+		if c.Size() != size {
+			t.Fatal("incorrect c size")
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package cache
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Config of a cache
+type Config struct {
+	// Maximal number of items
+	Size int `yaml:"size"`
+	// Number of buckets the items are sharded into
+	Buckets int `yaml:"buckets"`
+	// Interval of the expired items cleanup, a bucket is cleaned per interval
+	CleanupInterval time.Duration `yaml:"cleanupInterval"`
+	// Policy applied when the size limit is hit
+	Policy EvictionPolicy `yaml:"policy"`
+}
+
+func (c *Config) Validate() error {
+	if c.Size <= 0 || c.Buckets <= 0 {
+		return errors.New("cache size and number of buckets must be positive")
+	}
+	if c.Buckets > c.Size {
+		return errors.New("cache number of buckets must not exceed the size")
+	}
+	if c.CleanupInterval <= 0 {
+		return errors.New("cache cleanup interval must be positive")
+	}
+	return nil
+}
+
+var policyNames = map[EvictionPolicy]string{
+	Reject:       "reject",
+	LRU:          "lru",
+	LFU:          "lfu",
+	OldestExpiry: "oldest-expiry",
+}
+
+func (p EvictionPolicy) String() string {
+	return policyNames[p]
+}
+
+func (p EvictionPolicy) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText parses the policy name: reject, lru, lfu or oldest-expiry
+func (p *EvictionPolicy) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for policy, policyName := range policyNames {
+		if policyName == name {
+			*p = policy
+			return nil
+		}
+	}
+	return errors.New("unknown eviction policy '" + string(text) + "'")
+}
@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var sessionsBucket = []byte("sessions")
+
+// RoomSettings are the settings of a session room chosen by the owner
+type RoomSettings struct {
+	// Locked room refuses new participants
+	Locked bool
+}
+
+// SessionInfo is the metadata of a session which survives a restart of the server,
+// the media state of the session is rebuilt when the participants join again
+type SessionInfo struct {
+	Id string
+	// OwnerId is the client moderating the session
+	OwnerId string
+	// OwnerKey is the hash of the key issued to the creator, the creator regains the moderation by the key
+	// when the client id has changed, e.g. after a restart of the server
+	OwnerKey   string
+	Created    time.Time
+	Expiration time.Time
+	Settings   RoomSettings
+}
+
+func (info *SessionInfo) isExpired() bool {
+	return !info.Expiration.IsZero() && time.Now().After(info.Expiration)
+}
+
+// IsOwnerKey reports whether the key is the key issued to the creator of the session
+func (info *SessionInfo) IsOwnerKey(key string) bool {
+	return info.OwnerKey != "" && subtle.ConstantTimeCompare([]byte(OwnerKeyHash(key)), []byte(info.OwnerKey)) == 1
+}
+
+// OwnerKeyHash returns the hash of the owner key stored in the registry, the key itself is known to the owner only
+func OwnerKeyHash(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// Registry persistent store of session metadata
+type Registry struct {
+	db *bolt.DB
+}
+
+// OpenRegistry opens or creates the registry file, expired sessions are purged
+func OpenRegistry(path string) (*Registry, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	r := &Registry{db: db}
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(sessionsBucket)
+		if err != nil {
+			return err
+		}
+		return r.purge(bucket)
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Registry) purge(bucket *bolt.Bucket) error {
+	var expired [][]byte
+	err := bucket.ForEach(func(key, data []byte) error {
+		var info SessionInfo
+		if err := json.Unmarshal(data, &info); err != nil || info.isExpired() {
+			expired = append(expired, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range expired {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Put stores the session metadata
+func (r *Registry) Put(info SessionInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Put([]byte(info.Id), data)
+	})
+}
+
+// Get returns the session metadata, an expired session is not found
+func (r *Registry) Get(id string) (info SessionInfo, err error) {
+	err = r.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(sessionsBucket).Get([]byte(id))
+		if data == nil {
+			return NotFoundError
+		}
+		return json.Unmarshal(data, &info)
+	})
+	if err == nil && info.isExpired() {
+		_ = r.Delete(id)
+		err = NotFoundError
+	}
+	return
+}
+
+// Delete removes the session metadata
+func (r *Registry) Delete(id string) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(sessionsBucket).Delete([]byte(id))
+	})
+}
+
+func (r *Registry) Close() error {
+	return r.db.Close()
+}
@@ -0,0 +1,51 @@
+package cache_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"video-chat/api/cache"
+)
+
+func TestRegistry_Reopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.db")
+	r, err := cache.OpenRegistry(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	err = r.Put(cache.SessionInfo{Id: "session-1", OwnerId: "owner-1", Created: now, Expiration: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Put(cache.SessionInfo{Id: "session-2", OwnerId: "owner-2", Created: now, Expiration: now.Add(-time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = r.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = cache.OpenRegistry(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	info, err := r.Get("session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.OwnerId != "owner-1" || !info.Created.Equal(now) {
+		t.Fatal("unexpected session info", info)
+	}
+	if _, err = r.Get("session-2"); err != cache.NotFoundError {
+		t.Fatal("expired session is found", err)
+	}
+	if err = r.Delete("session-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = r.Get("session-1"); err != cache.NotFoundError {
+		t.Fatal("deleted session is found", err)
+	}
+}
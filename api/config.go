@@ -0,0 +1,49 @@
+package api
+
+import (
+	"errors"
+	"time"
+
+	"video-chat/api/cache"
+)
+
+// Config of the Sessions API
+type Config struct {
+	// Directory the recordings are written to
+	RecordDir string `yaml:"records"`
+	// File of the persistent session registry, sessions are not persisted if empty
+	Registry string `yaml:"registry"`
+	// Lifetime of a session record in the registry since the last join or media activity of the session
+	RegistryExpiration time.Duration `yaml:"registryExpiration"`
+	// Sessions without media or signaling activity for the time are closed
+	IdleTimeout time.Duration `yaml:"idleTimeout"`
+	// Cache of the active sessions
+	Cache cache.Config `yaml:"cache"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		RecordDir:          "records",
+		RegistryExpiration: 24 * time.Hour,
+		IdleTimeout:        5 * time.Minute,
+		Cache: cache.Config{
+			Size:            100_000,
+			Buckets:         10,
+			CleanupInterval: time.Second,
+			Policy:          cache.Reject,
+		},
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.RecordDir == "" {
+		return errors.New("records directory must be set")
+	}
+	if c.RegistryExpiration <= 0 {
+		return errors.New("registry expiration must be positive")
+	}
+	if c.IdleTimeout <= 0 {
+		return errors.New("idle timeout must be positive")
+	}
+	return c.Cache.Validate()
+}
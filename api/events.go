@@ -0,0 +1,41 @@
+package api
+
+const (
+	ParticipantJoinedEvent = "participant-joined"
+	ParticipantLeftEvent   = "participant-left"
+	SessionClosedEvent     = "session-closed"
+	// the skipped are the tracks of the codecs which cannot be recorded, "<client id> <mime type>"
+	RecordingStartedEvent = "recording-started"
+	RecordingStoppedEvent = "recording-stopped"
+	// the client id is the speaker, the stream id is the stream of the speaker received by the notified client
+	DominantSpeakerChangedEvent = "dominant-speaker-changed"
+	// the kind is the muted track kind, audio or video
+	ParticipantMutedEvent   = "participant-muted"
+	ParticipantUnmutedEvent = "participant-unmuted"
+	ParticipantKickedEvent  = "participant-kicked"
+	SessionLockedEvent      = "session-locked"
+	SessionUnlockedEvent    = "session-unlocked"
+	// the client id is the new moderator
+	ModeratorChangedEvent = "moderator-changed"
+	ErrorEvent            = "error"
+)
+
+// Event is pushed to the clients subscribed to the event topic
+type Event struct {
+	Type      string
+	SessionId string
+	ClientId  string
+	StreamId  string
+	Kind      string
+	Skipped   []string
+	Error     string
+}
+
+// notify pushes the event to the clients except the excluded one
+func (signaling *signaling) notify(event Event, excluded string, clientIDs []string) {
+	for _, clientID := range clientIDs {
+		if clientID != excluded {
+			signaling.service.Notify(eventTopic, event, clientID)
+		}
+	}
+}
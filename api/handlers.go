@@ -8,6 +8,7 @@ import (
 	"github.com/hprose/hprose-golang/rpc"
 	"github.com/valyala/fasthttp"
 
+	"video-chat/api/cache"
 	"video-chat/api/rpc/websocket"
 )
 
@@ -42,8 +43,14 @@ func serveStaticAssets(ctx *fasthttp.RequestCtx) {
 	}
 }
 
-func NewRpcService() *websocket.Service {
+// NewRpcService creates the service of the Sessions API, the sessions are persisted in the registry unless it is nil
+func NewRpcService(config Config, registry *cache.Registry) *websocket.Service {
+	initSessions(config, registry)
 	service := websocket.NewService(serveStaticAssets, onClientDisconnect)
+	service.Publish(candidateTopic, 0, 0)
+	service.Publish(offerTopic, 0, 0)
+	service.Publish(eventTopic, 0, 0)
 	service.AddAllMethods(API(), rpc.Options{Simple: true, NameSpace: "Sessions"})
+	_sessions.signaling.service = service
 	return service
 }
@@ -0,0 +1,68 @@
+package relay
+
+import (
+	"errors"
+	"net"
+	"strconv"
+)
+
+// Config of the embedded STUN/TURN server
+type Config struct {
+	Enabled bool `yaml:"enabled"`
+	// UDP address the server listens on
+	Listen string `yaml:"listen"`
+	// Listen on the same TCP address as well
+	TCP   bool   `yaml:"tcp"`
+	Realm string `yaml:"realm"`
+	// Public IP address advertised for the relayed transport addresses
+	PublicIP string `yaml:"publicIp"`
+	// Local address the relay sockets are bound to
+	RelayAddress string `yaml:"relayAddress"`
+	// Range of the relay ports
+	RelayMinPort int `yaml:"relayMinPort"`
+	RelayMaxPort int `yaml:"relayMaxPort"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Listen:       "0.0.0.0:3478",
+		Realm:        "vichat",
+		RelayAddress: "0.0.0.0",
+		RelayMinPort: 49152,
+		RelayMaxPort: 65535,
+	}
+}
+
+func (c *Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if _, _, err := net.SplitHostPort(c.Listen); err != nil {
+		return err
+	}
+	if c.Realm == "" {
+		return errors.New("realm must be set")
+	}
+	if net.ParseIP(c.PublicIP) == nil {
+		return errors.New("invalid public IP '" + c.PublicIP + "'")
+	}
+	if net.ParseIP(c.RelayAddress) == nil {
+		return errors.New("invalid relay address '" + c.RelayAddress + "'")
+	}
+	if c.RelayMinPort <= 0 || c.RelayMinPort > c.RelayMaxPort || c.RelayMaxPort > 65535 {
+		return errors.New("invalid relay port range " + strconv.Itoa(c.RelayMinPort) + "-" + strconv.Itoa(c.RelayMaxPort))
+	}
+	return nil
+}
+
+// URLs returns the STUN and TURN URLs of the server reachable at the public IP
+func (c *Config) URLs() (stunURLs []string, turnURLs []string) {
+	_, port, _ := net.SplitHostPort(c.Listen)
+	address := net.JoinHostPort(c.PublicIP, port)
+	stunURLs = []string{"stun:" + address}
+	turnURLs = []string{"turn:" + address + "?transport=udp"}
+	if c.TCP {
+		turnURLs = append(turnURLs, "turn:"+address+"?transport=tcp")
+	}
+	return
+}
@@ -0,0 +1,77 @@
+package relay
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pion/turn/v2"
+	"github.com/rs/zerolog/log"
+
+	"video-chat/api/rtc"
+)
+
+// Authorizer reports whether the user is allowed to allocate relays
+type Authorizer func(userId string) bool
+
+// Server is the embedded STUN/TURN server, the users authenticate with the credentials issued by rtc.ICEServers
+type Server struct {
+	*turn.Server
+}
+
+func NewServer(config Config, secret string, authorize Authorizer) (*Server, error) {
+	relayAddressGenerator := &turn.RelayAddressGeneratorPortRange{
+		RelayAddress: net.ParseIP(config.PublicIP),
+		Address:      config.RelayAddress,
+		MinPort:      uint16(config.RelayMinPort),
+		MaxPort:      uint16(config.RelayMaxPort),
+	}
+	udpListener, err := net.ListenPacket("udp4", config.Listen)
+	if err != nil {
+		return nil, err
+	}
+	serverConfig := turn.ServerConfig{
+		Realm:             config.Realm,
+		AuthHandler:       authHandler(secret, authorize),
+		PacketConnConfigs: []turn.PacketConnConfig{{PacketConn: udpListener, RelayAddressGenerator: relayAddressGenerator}},
+	}
+	if config.TCP {
+		tcpListener, err := net.Listen("tcp4", config.Listen)
+		if err != nil {
+			_ = udpListener.Close()
+			return nil, err
+		}
+		serverConfig.ListenerConfigs = []turn.ListenerConfig{{Listener: tcpListener, RelayAddressGenerator: relayAddressGenerator}}
+	}
+	server, err := turn.NewServer(serverConfig)
+	if err != nil {
+		_ = udpListener.Close()
+		for _, listener := range serverConfig.ListenerConfigs {
+			_ = listener.Listener.Close()
+		}
+		return nil, err
+	}
+	return &Server{server}, nil
+}
+
+// authHandler checks the time-limited credentials of the TURN REST API scheme and authorizes their user
+func authHandler(secret string, authorize Authorizer) turn.AuthHandler {
+	return func(username string, realm string, srcAddr net.Addr) (key []byte, ok bool) {
+		expiration, userId, found := strings.Cut(username, ":")
+		if !found {
+			log.Debug().Str("username", username).Str("address", srcAddr.String()).Msg("TURN Invalid Username")
+			return nil, false
+		}
+		t, err := strconv.ParseInt(expiration, 10, 64)
+		if err != nil || t < time.Now().Unix() {
+			log.Debug().Str("username", username).Str("address", srcAddr.String()).Msg("TURN Expired Credentials")
+			return nil, false
+		}
+		if !authorize(userId) {
+			log.Debug().Str("username", username).Str("address", srcAddr.String()).Msg("TURN Unauthorized User")
+			return nil, false
+		}
+		return turn.GenerateAuthKey(username, realm, rtc.TURNPassword(secret, username)), true
+	}
+}
@@ -0,0 +1,43 @@
+package relay
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pion/turn/v2"
+
+	"video-chat/api/rtc"
+)
+
+func TestAuthHandler(t *testing.T) {
+	const secret, realm = "secret", "vichat"
+	valid, validCredential := rtc.TURNCredentials(secret, "user", time.Hour)
+	stranger, strangerCredential := rtc.TURNCredentials(secret, "stranger", time.Hour)
+	forged, forgedCredential := rtc.TURNCredentials("other secret", "user", time.Hour)
+	expired := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10) + ":user"
+	tests := []struct {
+		name       string
+		username   string
+		credential string
+		want       bool
+	}{
+		{"valid", valid, validCredential, true},
+		{"expired", expired, rtc.TURNPassword(secret, expired), false},
+		{"no user", "1234567890", rtc.TURNPassword(secret, "1234567890"), false},
+		{"malformed expiration", "tomorrow:user", rtc.TURNPassword(secret, "tomorrow:user"), false},
+		{"unauthorized user", stranger, strangerCredential, false},
+		{"wrong secret", forged, forgedCredential, false},
+	}
+	handler := authHandler(secret, func(userId string) bool { return userId == "user" })
+	for _, test := range tests {
+		key, ok := handler(test.username, realm, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 50000})
+		// the server accepts the request signed by the credential only if the keys match
+		authenticated := ok && bytes.Equal(key, turn.GenerateAuthKey(test.username, realm, test.credential))
+		if authenticated != test.want {
+			t.Fatalf("%s: authenticated %v", test.name, authenticated)
+		}
+	}
+}
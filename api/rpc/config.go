@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"net"
+
+	"video-chat/api/rpc/websocket"
+)
+
+// Config of the RPC server
+type Config struct {
+	// Address the server listens on
+	Bind string `yaml:"bind"`
+	// Authentication of the clients by signed tokens
+	Auth websocket.AuthConfig `yaml:"auth"`
+	// Origins of the web pages allowed to connect
+	Origins websocket.OriginConfig `yaml:"origins"`
+	// Certificate of HTTPS, the browsers allow the camera on secure pages only
+	TLS TLSConfig `yaml:"tls"`
+}
+
+func DefaultConfig() Config {
+	return Config{Bind: "0.0.0.0:8080"}
+}
+
+func (c *Config) Validate() error {
+	if _, _, err := net.SplitHostPort(c.Bind); err != nil {
+		return err
+	}
+	if err := c.Auth.Validate(); err != nil {
+		return err
+	}
+	if err := c.Origins.Validate(); err != nil {
+		return err
+	}
+	return c.TLS.Validate()
+}
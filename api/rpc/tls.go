@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// interval of the checks of the certificate files for changes
+const certificateCheckInterval = 10 * time.Second
+
+// TLSConfig of the HTTPS server, the server serves plain HTTP if no certificate is set
+type TLSConfig struct {
+	// PEM file of the certificate chain
+	Cert string `yaml:"cert"`
+	// PEM file of the private key
+	Key string `yaml:"key"`
+}
+
+// Enabled reports whether the server serves HTTPS
+func (c *TLSConfig) Enabled() bool {
+	return c.Cert != "" || c.Key != ""
+}
+
+func (c *TLSConfig) Validate() error {
+	if c.Enabled() && (c.Cert == "" || c.Key == "") {
+		return errors.New("tls cert and key must be set both")
+	}
+	return nil
+}
+
+// certificateLoader serves the certificate to the TLS handshakes and reloads it on SIGHUP or on a change of
+// the files; the established connections are kept, the reloaded certificate is used by the new ones only
+type certificateLoader struct {
+	config      TLSConfig
+	mutex       sync.RWMutex
+	certificate *tls.Certificate
+	// modification times of the loaded files
+	certModified time.Time
+	keyModified  time.Time
+}
+
+func newCertificateLoader(config TLSConfig) (*certificateLoader, error) {
+	loader := &certificateLoader{config: config}
+	if err := loader.load(); err != nil {
+		return nil, err
+	}
+	return loader, nil
+}
+
+// load replaces the certificate by the files, the certificate is kept if the files are not valid
+func (l *certificateLoader) load() error {
+	certModified, keyModified, err := l.modified()
+	if err != nil {
+		return err
+	}
+	certificate, err := tls.LoadX509KeyPair(l.config.Cert, l.config.Key)
+	if err != nil {
+		return errors.New("certificate '" + l.config.Cert + "': " + err.Error())
+	}
+	l.mutex.Lock()
+	l.certificate = &certificate
+	l.certModified, l.keyModified = certModified, keyModified
+	l.mutex.Unlock()
+	return nil
+}
+
+func (l *certificateLoader) modified() (cert time.Time, key time.Time, err error) {
+	info, err := os.Stat(l.config.Cert)
+	if err != nil {
+		return
+	}
+	cert = info.ModTime()
+	if info, err = os.Stat(l.config.Key); err != nil {
+		return
+	}
+	key = info.ModTime()
+	return
+}
+
+// changed reports whether a file has been modified since the load
+func (l *certificateLoader) changed() bool {
+	cert, key, err := l.modified()
+	if err != nil {
+		// the files are being replaced
+		return false
+	}
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return !cert.Equal(l.certModified) || !key.Equal(l.keyModified)
+}
+
+func (l *certificateLoader) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.certificate, nil
+}
+
+func (l *certificateLoader) tlsConfig() *tls.Config {
+	return &tls.Config{GetCertificate: l.getCertificate, MinVersion: tls.VersionTLS12}
+}
+
+func (l *certificateLoader) reload(reason string) {
+	if err := l.load(); err != nil {
+		log.Error().Err(err).Str("reason", reason).Msg("Certificate Reload")
+		return
+	}
+	log.Info().Str("reason", reason).Msg("Certificate Reload")
+}
+
+// watch reloads the certificate on SIGHUP or on a change of the files until the stop channel is closed
+func (l *certificateLoader) watch(stop <-chan struct{}) {
+	signalsChannel := make(chan os.Signal, 1)
+	signal.Notify(signalsChannel, syscall.SIGHUP)
+	defer signal.Stop(signalsChannel)
+	ticker := time.NewTicker(certificateCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-signalsChannel:
+			l.reload("signal")
+		case <-ticker.C:
+			if l.changed() {
+				l.reload("file change")
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeCertificate(t *testing.T, config TLSConfig, name string, modified time.Time) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(config.Cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(config.Key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// the modification times of the files are not precise enough to detect quick changes
+	for _, path := range []string{config.Cert, config.Key} {
+		if err = os.Chtimes(path, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func commonName(t *testing.T, l *certificateLoader) string {
+	t.Helper()
+	certificate, err := l.getCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Subject.CommonName
+}
+
+func TestCertificateLoader_Reload(t *testing.T) {
+	dir := t.TempDir()
+	config := TLSConfig{Cert: filepath.Join(dir, "cert.pem"), Key: filepath.Join(dir, "key.pem")}
+	now := time.Now()
+	writeCertificate(t, config, "first", now.Add(-time.Minute))
+	l, err := newCertificateLoader(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.changed() || commonName(t, l) != "first" {
+		t.Fatal("unexpected certificate")
+	}
+	writeCertificate(t, config, "second", now)
+	if !l.changed() {
+		t.Fatal("change not detected")
+	}
+	if err = l.load(); err != nil {
+		t.Fatal(err)
+	}
+	if l.changed() || commonName(t, l) != "second" {
+		t.Fatal("certificate not reloaded")
+	}
+	// the certificate is kept if the files are not valid
+	if err = os.WriteFile(config.Key, []byte("invalid"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if l.load() == nil || commonName(t, l) != "second" {
+		t.Fatal("invalid certificate loaded")
+	}
+}
@@ -0,0 +1,271 @@
+package websocket
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// HostRole may create sessions and join any allowed session
+	HostRole = "host"
+	// GuestRole may only join the allowed sessions
+	GuestRole = "guest"
+	// query parameter of the token
+	tokenParameter = "token"
+	// prefix of the Sec-WebSocket-Protocol entry carrying the token, e.g. "access-token.<token>"
+	tokenProtocolPrefix = "access-token."
+	// tolerated clock difference of the token issuer
+	tokenLeeway = 30 * time.Second
+	// minimal length of the HMAC secret, shorter secrets are guessable
+	minSecretLength = 32
+)
+
+var (
+	MissingTokenError          = errors.New("token is missing")
+	MalformedTokenError        = errors.New("token is malformed")
+	InvalidTokenSignatureError = errors.New("token signature is invalid")
+	ExpiredTokenError          = errors.New("token has expired")
+)
+
+// AuthConfig of the token authentication, the clients are not authenticated if no key is set
+type AuthConfig struct {
+	// Secret of the HMAC signed tokens: HS256, HS384 or HS512
+	Secret string `yaml:"secret"`
+	// PEM file of the RSA public key of the RSA signed tokens: RS256, RS384 or RS512
+	PublicKey string `yaml:"publicKey"`
+	// Issuer of the tokens, any if empty
+	Issuer string `yaml:"issuer"`
+	// Audience the tokens must be issued for, any if empty
+	Audience string `yaml:"audience"`
+}
+
+// Enabled reports whether the clients must present a token
+func (c *AuthConfig) Enabled() bool {
+	return c.Secret != "" || c.PublicKey != ""
+}
+
+func (c *AuthConfig) Validate() error {
+	if c.Secret != "" && len(c.Secret) < minSecretLength {
+		return errors.New("auth secret must be at least 32 bytes long")
+	}
+	return nil
+}
+
+// Claims of the token presented by the client on the upgrade of the connection
+type Claims struct {
+	// identity of the client, the id of the client connected with the token
+	Subject  string   `json:"sub"`
+	Issuer   string   `json:"iss"`
+	Audience audience `json:"aud"`
+	// unix times in seconds
+	ExpiresAt int64 `json:"exp"`
+	NotBefore int64 `json:"nbf"`
+	// ids of the sessions the client may create or join, any session if empty
+	Rooms []string `json:"rooms"`
+	// HostRole or GuestRole
+	Role string `json:"role"`
+}
+
+// audience is either a single string or an array of strings
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(a))
+}
+
+// IsExpired reports whether the token is no longer valid at the time
+func (c *Claims) IsExpired(now time.Time) bool {
+	return now.After(time.Unix(c.ExpiresAt, 0).Add(tokenLeeway))
+}
+
+// AllowsRoom reports whether the client may create or join the session
+func (c *Claims) AllowsRoom(id string) bool {
+	return len(c.Rooms) == 0 || slices.Contains(c.Rooms, id)
+}
+
+// IsHost reports whether the client may create sessions
+func (c *Claims) IsHost() bool {
+	return c.Role == HostRole
+}
+
+// Authenticator verifies the signed JSON Web Tokens of the clients
+type Authenticator struct {
+	secret    []byte
+	publicKey *rsa.PublicKey
+	issuer    string
+	audience  string
+}
+
+// NewAuthenticator loads the keys of the configuration
+func NewAuthenticator(config AuthConfig) (*Authenticator, error) {
+	authenticator := &Authenticator{secret: []byte(config.Secret), issuer: config.Issuer, audience: config.Audience}
+	if config.PublicKey != "" {
+		data, err := os.ReadFile(config.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if authenticator.publicKey, err = parsePublicKey(data); err != nil {
+			return nil, errors.New("public key '" + config.PublicKey + "': " + err.Error())
+		}
+	}
+	return authenticator, nil
+}
+
+// parsePublicKey parses the PEM encoded PKIX or PKCS #1 RSA public key
+func parsePublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+	return publicKey, nil
+}
+
+// Verify checks the signature and the validity of the token and returns its claims,
+// the algorithms without a configured key are refused
+func (a *Authenticator) Verify(token string, now time.Time) (*Claims, error) {
+	if token == "" {
+		return nil, MissingTokenError
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, MalformedTokenError
+	}
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, MalformedTokenError
+	}
+	if err = a.verifySignature(header.Algorithm, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+	claims := &Claims{}
+	if err = decodeSegment(parts[1], claims); err != nil {
+		return nil, err
+	}
+	if err = a.validate(claims, now); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// verifySignature checks the signature of the HS256, HS384, HS512, RS256, RS384 or RS512 algorithm
+func (a *Authenticator) verifySignature(algorithm string, signed string, signature []byte) error {
+	unsupported := errors.New("unsupported token algorithm '" + algorithm + "'")
+	if len(algorithm) != 5 {
+		return unsupported
+	}
+	var hash crypto.Hash
+	switch algorithm[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return unsupported
+	}
+	switch {
+	case algorithm[:2] == "HS" && len(a.secret) > 0:
+		mac := hmac.New(hash.New, a.secret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return InvalidTokenSignatureError
+		}
+	case algorithm[:2] == "RS" && a.publicKey != nil:
+		digest := hash.New()
+		digest.Write([]byte(signed))
+		if rsa.VerifyPKCS1v15(a.publicKey, hash, digest.Sum(nil), signature) != nil {
+			return InvalidTokenSignatureError
+		}
+	default:
+		return unsupported
+	}
+	return nil
+}
+
+func (a *Authenticator) validate(claims *Claims, now time.Time) error {
+	if claims.ExpiresAt == 0 {
+		return errors.New("token has no expiration")
+	}
+	if claims.Subject == "" {
+		return errors.New("token has no subject")
+	}
+	if claims.IsExpired(now) {
+		return ExpiredTokenError
+	}
+	if claims.NotBefore != 0 && now.Add(tokenLeeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return errors.New("token is not valid yet")
+	}
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return errors.New("token issuer '" + claims.Issuer + "' is not trusted")
+	}
+	if a.audience != "" && !slices.Contains(claims.Audience, a.audience) {
+		return errors.New("token is not issued for '" + a.audience + "'")
+	}
+	if claims.Role != HostRole && claims.Role != GuestRole {
+		return errors.New("unknown token role '" + claims.Role + "'")
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return MalformedTokenError
+	}
+	if err = json.Unmarshal(data, v); err != nil {
+		return MalformedTokenError
+	}
+	return nil
+}
+
+// requestToken returns the token of the upgrade request, the protocol is the Sec-WebSocket-Protocol entry
+// carrying the token which must be confirmed by the response, empty if the token is a query parameter
+func requestToken(ctx *fasthttp.RequestCtx) (token string, protocol string) {
+	if token = string(ctx.QueryArgs().Peek(tokenParameter)); token != "" {
+		return
+	}
+	for _, entry := range strings.Split(string(ctx.Request.Header.Peek("Sec-WebSocket-Protocol")), ",") {
+		entry = strings.TrimSpace(entry)
+		if strings.HasPrefix(entry, tokenProtocolPrefix) {
+			return strings.TrimPrefix(entry, tokenProtocolPrefix), entry
+		}
+	}
+	return
+}
@@ -0,0 +1,120 @@
+package websocket_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"video-chat/api/rpc/websocket"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func hmacToken(t *testing.T, alg string, secret string, claims map[string]interface{}) string {
+	signed := encodeSegment(t, map[string]string{"alg": alg, "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func rsaToken(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	signed := encodeSegment(t, map[string]string{"alg": "RS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestAuthenticator_HMAC(t *testing.T) {
+	a, err := websocket.NewAuthenticator(websocket.AuthConfig{Secret: testSecret, Issuer: "app"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	claims := map[string]interface{}{"sub": "user-1", "iss": "app", "exp": now.Add(time.Hour).Unix(), "role": "guest", "rooms": []string{"room-1"}}
+	verified, err := a.Verify(hmacToken(t, "HS256", testSecret, claims), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verified.Subject != "user-1" || verified.IsHost() || !verified.AllowsRoom("room-1") || verified.AllowsRoom("room-2") {
+		t.Fatal("unexpected claims", verified)
+	}
+	if _, err = a.Verify(hmacToken(t, "HS256", testSecret+"x", claims), now); err != websocket.InvalidTokenSignatureError {
+		t.Fatal("forged token accepted", err)
+	}
+	if _, err = a.Verify(hmacToken(t, "none", testSecret, claims), now); err == nil {
+		t.Fatal("unsigned token accepted")
+	}
+	if _, err = a.Verify(hmacToken(t, "HS256", testSecret, claims), now.Add(2*time.Hour)); err != websocket.ExpiredTokenError {
+		t.Fatal("expired token accepted", err)
+	}
+	claims["iss"] = "other"
+	if _, err = a.Verify(hmacToken(t, "HS256", testSecret, claims), now); err == nil {
+		t.Fatal("untrusted issuer accepted")
+	}
+	claims["iss"] = "app"
+	delete(claims, "sub")
+	if _, err = a.Verify(hmacToken(t, "HS256", testSecret, claims), now); err == nil {
+		t.Fatal("token without a subject accepted")
+	}
+	if _, err = a.Verify("", now); err != websocket.MissingTokenError {
+		t.Fatal("missing token accepted", err)
+	}
+}
+
+func TestAuthenticator_RSA(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.pem")
+	if err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := websocket.NewAuthenticator(websocket.AuthConfig{PublicKey: path, Audience: "vichat"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	claims := map[string]interface{}{"sub": "user-1", "aud": []string{"vichat"}, "exp": now.Add(time.Hour).Unix(), "role": "host"}
+	verified, err := a.Verify(rsaToken(t, key, claims), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !verified.IsHost() || !verified.AllowsRoom("any") {
+		t.Fatal("unexpected claims", verified)
+	}
+	// the public key must not be usable as an HMAC secret
+	if _, err = a.Verify(hmacToken(t, "HS256", string(der), claims), now); err == nil {
+		t.Fatal("HMAC token accepted without a secret")
+	}
+	claims["aud"] = "other"
+	if _, err = a.Verify(rsaToken(t, key, claims), now); err == nil {
+		t.Fatal("token of another audience accepted")
+	}
+}
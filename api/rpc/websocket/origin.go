@@ -0,0 +1,122 @@
+package websocket
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+// OriginConfig of the web pages allowed to open the connections, the pages of the server itself are always allowed
+type OriginConfig struct {
+	// Allowed origins, exact, e.g. https://app.example.com, or of any subdomain, e.g. https://*.example.com;
+	// an origin without the scheme matches both http and https
+	Allowed []string `yaml:"allowed"`
+	// Development allows the pages served from localhost on any port only
+	Development bool `yaml:"development"`
+}
+
+func (c *OriginConfig) Validate() error {
+	if c.Development && len(c.Allowed) != 0 {
+		return errors.New("allowed origins are not used in the development mode")
+	}
+	for _, origin := range c.Allowed {
+		if _, err := parseOriginPattern(origin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OriginPolicy decides whether the page of the origin may open a connection, the browsers send the origin
+// of the page on the upgrade so a page of another site cannot use the session of the user
+type OriginPolicy struct {
+	patterns    []originPattern
+	development bool
+}
+
+type originPattern struct {
+	// any if empty
+	scheme string
+	// host with the optional port, the suffix of the subdomains if wildcard, e.g. ".example.com"
+	host     string
+	wildcard bool
+}
+
+// NewOriginPolicy creates the policy of the configuration
+func NewOriginPolicy(config OriginConfig) (*OriginPolicy, error) {
+	policy := &OriginPolicy{development: config.Development}
+	for _, origin := range config.Allowed {
+		pattern, err := parseOriginPattern(origin)
+		if err != nil {
+			return nil, err
+		}
+		policy.patterns = append(policy.patterns, pattern)
+	}
+	return policy, nil
+}
+
+func parseOriginPattern(origin string) (originPattern, error) {
+	pattern := originPattern{}
+	host := strings.ToLower(strings.TrimSpace(origin))
+	if scheme, rest, ok := strings.Cut(host, "://"); ok {
+		pattern.scheme, host = scheme, rest
+	}
+	if strings.HasPrefix(host, "*.") {
+		pattern.wildcard, host = true, host[1:]
+	}
+	if host == "" || host == "." || strings.ContainsAny(host, "*/?#@") {
+		return pattern, errors.New("invalid origin '" + origin + "'")
+	}
+	if pattern.scheme != "" && pattern.scheme != "http" && pattern.scheme != "https" {
+		return pattern, errors.New("invalid origin scheme '" + origin + "'")
+	}
+	pattern.host = host
+	return pattern, nil
+}
+
+func (p *originPattern) matches(scheme string, host string) bool {
+	if p.scheme != "" && p.scheme != scheme {
+		return false
+	}
+	if p.wildcard {
+		return strings.HasSuffix(host, p.host)
+	}
+	return host == p.host
+}
+
+// Allows reports whether the origin may connect to the host of the request, the requests without an origin
+// are not sent by browsers and are allowed
+func (p *OriginPolicy) Allows(origin string, host string) bool {
+	if origin == "" {
+		return true
+	}
+	scheme, originHost, ok := strings.Cut(strings.ToLower(origin), "://")
+	if !ok || originHost == "" {
+		// e.g. "null" of the sandboxed pages
+		return false
+	}
+	if p.development {
+		return isLocalhost(originHost)
+	}
+	if originHost == strings.ToLower(host) {
+		return true
+	}
+	for i := range p.patterns {
+		if p.patterns[i].matches(scheme, originHost) {
+			return true
+		}
+	}
+	return false
+}
+
+func isLocalhost(host string) bool {
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+	host = strings.Trim(host, "[]")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,67 @@
+package websocket_test
+
+import (
+	"testing"
+
+	"video-chat/api/rpc/websocket"
+)
+
+func TestOriginPolicy_Allows(t *testing.T) {
+	policy, err := websocket.NewOriginPolicy(websocket.OriginConfig{Allowed: []string{
+		"https://app.example.com", "https://*.example.org", "partner.example.net:8443",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		origin  string
+		allowed bool
+	}{
+		{"", true},
+		{"https://vichat.example.com", true},
+		{"https://app.example.com", true},
+		{"http://app.example.com", false},
+		{"https://APP.example.com", true},
+		{"https://app.example.com.evil.com", false},
+		{"https://a.example.org", true},
+		{"https://a.b.example.org", true},
+		{"https://example.org", false},
+		{"https://evilexample.org", false},
+		{"http://partner.example.net:8443", true},
+		{"https://partner.example.net", false},
+		{"null", false},
+	}
+	for _, test := range tests {
+		if policy.Allows(test.origin, "vichat.example.com") != test.allowed {
+			t.Fatal("unexpected result of the origin", test.origin)
+		}
+	}
+}
+
+func TestOriginPolicy_Development(t *testing.T) {
+	policy, err := websocket.NewOriginPolicy(websocket.OriginConfig{Development: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, origin := range []string{"http://localhost:3000", "http://127.0.0.1:8080", "http://[::1]:8080", "http://app.localhost"} {
+		if !policy.Allows(origin, "localhost:8080") {
+			t.Fatal("localhost origin refused", origin)
+		}
+	}
+	if policy.Allows("https://example.com", "example.com") {
+		t.Fatal("remote origin allowed in the development mode")
+	}
+}
+
+func TestOriginConfig_Validate(t *testing.T) {
+	for _, origin := range []string{"*", "https://", "https://app.example.com/path", "ftp://example.com", "https://a.*.example.com"} {
+		config := websocket.OriginConfig{Allowed: []string{origin}}
+		if config.Validate() == nil {
+			t.Fatal("invalid origin accepted", origin)
+		}
+	}
+	config := websocket.OriginConfig{Allowed: []string{"https://app.example.com"}, Development: true}
+	if config.Validate() == nil {
+		t.Fatal("allowed origins accepted in the development mode")
+	}
+}
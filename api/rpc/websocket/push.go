@@ -0,0 +1,30 @@
+package websocket
+
+// Context returns the context of the connected client
+func (service *Service) Context(clientID string) (context *Context, ok bool) {
+	value, ok := service.contexts.Load(clientID)
+	if ok {
+		context = value.(*Context)
+	}
+	return
+}
+
+// Notify pushes the result to the connected clients which have subscribed to the topic,
+// unlike Push nobody is notified if no client is given
+func (service *Service) Notify(topic string, result interface{}, clientIDs ...string) {
+	for _, clientID := range clientIDs {
+		if context, ok := service.Context(clientID); ok {
+			context.Push(topic, result)
+		}
+	}
+}
+
+// Push sends the result to the client if it has subscribed to the topic
+func (context *Context) Push(topic string, result interface{}) {
+	context.Clients().Push(topic, result, context.ClientID)
+}
+
+// IsSubscribed checks whether the client has subscribed to the topic
+func (context *Context) IsSubscribed(topic string) bool {
+	return context.Clients().Exist(topic, context.ClientID)
+}
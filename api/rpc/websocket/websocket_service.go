@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/fasthttp/websocket"
 	"github.com/hprose/hprose-golang/rpc"
@@ -20,8 +21,11 @@ type ClientDisconnectHandler = func(clientID string)
 type Context struct {
 	rpc.BaseServiceContext
 	WebSocket *websocket.Conn
-	ClientID  string
-	mutex     sync.Mutex
+	// subject of the token, a random id if the authentication is disabled
+	ClientID string
+	// claims of the token presented on the upgrade, nil if the authentication is disabled
+	Claims *Claims
+	mutex  sync.Mutex
 }
 
 type Service struct {
@@ -29,6 +33,12 @@ type Service struct {
 	fallback           fasthttp.RequestHandler
 	upgrader           websocket.FastHTTPUpgrader
 	onClientDisconnect ClientDisconnectHandler
+	// verifies the tokens of the clients, nil if the authentication is disabled
+	authenticator *Authenticator
+	// origins of the pages allowed to connect
+	origins *OriginPolicy
+	// ClientID -> *Context of connected clients
+	contexts sync.Map
 }
 
 func websocketFixArguments(args []reflect.Value, context rpc.ServiceContext) {
@@ -43,6 +53,7 @@ func websocketFixArguments(args []reflect.Value, context rpc.ServiceContext) {
 	}
 }
 
+// checkOrigin of the upgrader passes the requests, the origin is checked before the authentication
 func checkOrigin(_ *fasthttp.RequestCtx) bool {
 	return true
 }
@@ -53,7 +64,7 @@ func clientID(context *Context ) string {
 
 // NewService is the constructor of Service
 func NewService(fallback fasthttp.RequestHandler, onClientDisconnect ClientDisconnectHandler) *Service {
-	service := Service{fallback: fallback, onClientDisconnect: onClientDisconnect}
+	service := Service{fallback: fallback, onClientDisconnect: onClientDisconnect, origins: &OriginPolicy{}}
 	service.InitBaseService()
 	service.AddFunction("#", clientID, rpc.Options{Simple: true})
 	service.upgrader.CheckOrigin = checkOrigin
@@ -61,6 +72,16 @@ func NewService(fallback fasthttp.RequestHandler, onClientDisconnect ClientDisco
 	return &service
 }
 
+// SetAuthenticator requires the clients to present a token verified by the authenticator on the upgrade
+func (service *Service) SetAuthenticator(authenticator *Authenticator) {
+	service.authenticator = authenticator
+}
+
+// SetOriginPolicy allows the origins of the policy besides the origin of the server
+func (service *Service) SetOriginPolicy(origins *OriginPolicy) {
+	service.origins = origins
+}
+
 func hasUpgradeHeader(headers *fasthttp.RequestHeader) bool {
 	header := headers.Peek("Upgrade")
 	if header == nil {
@@ -82,13 +103,31 @@ func (service *Service) ServeWS(ctx *fasthttp.RequestCtx) {
 }
 
 func (service *Service) serve(ctx *fasthttp.RequestCtx) {
+	if !service.allowOrigin(ctx) {
+		return
+	}
+	claims, ok := service.authenticate(ctx)
+	if !ok {
+		return
+	}
 	_ = service.upgrader.Upgrade(ctx, func(conn *websocket.Conn) {
-		context := service.newContext(conn)
+		context := service.newContext(conn, claims)
+		// the connection of the same token subject is taken for a stale one, e.g. half-open after a network change;
+		// it is stopped and its client is disconnected before the new connection is served
+		if previous, loaded := service.contexts.Swap(context.ClientID, context); loaded {
+			log.Warn().Str("client", context.ClientID).Str("remote", conn.RemoteAddr().String()).Msg("Websocket Service Serve; Connection Replaced")
+			previous.(*Context).replace()
+			if service.onClientDisconnect != nil {
+				service.onClientDisconnect(context.ClientID)
+			}
+		}
 		for {
 			msgType, data, err := context.read()
 			if err != nil {
 				log.Debug().Err(err).Msg("Websocket Service Serve")
-				if _, ok := err.(*websocket.CloseError); ok {
+				// a replaced connection does not disconnect the client of the new one
+				current := service.contexts.CompareAndDelete(context.ClientID, context)
+				if _, ok := err.(*websocket.CloseError); ok && current {
 					if service.onClientDisconnect != nil {
 						go service.onClientDisconnect(context.ClientID)
 					}
@@ -103,11 +142,45 @@ func (service *Service) serve(ctx *fasthttp.RequestCtx) {
 	})
 }
 
-func (service *Service) newContext(conn *websocket.Conn) *Context {
+// allowOrigin refuses the upgrade request of a page of a not allowed origin with 403
+func (service *Service) allowOrigin(ctx *fasthttp.RequestCtx) bool {
+	origin := string(ctx.Request.Header.Peek("Origin"))
+	if service.origins.Allows(origin, string(ctx.Host())) {
+		return true
+	}
+	log.Warn().Str("origin", origin).Str("remote", ctx.RemoteAddr().String()).Msg("Websocket Service Check Origin")
+	ctx.Error(fasthttp.StatusMessage(fasthttp.StatusForbidden), fasthttp.StatusForbidden)
+	return false
+}
+
+// authenticate verifies the token of the upgrade request, the request is refused with 401 if the token is not valid
+func (service *Service) authenticate(ctx *fasthttp.RequestCtx) (claims *Claims, ok bool) {
+	if service.authenticator == nil {
+		return nil, true
+	}
+	token, protocol := requestToken(ctx)
+	claims, err := service.authenticator.Verify(token, time.Now())
+	if err != nil {
+		log.Warn().Err(err).Str("remote", ctx.RemoteAddr().String()).Msg("Websocket Service Authenticate")
+		ctx.Error(fasthttp.StatusMessage(fasthttp.StatusUnauthorized), fasthttp.StatusUnauthorized)
+		return nil, false
+	}
+	// the browser fails the connection unless the offered protocol is confirmed
+	if protocol != "" {
+		ctx.Response.Header.Set("Sec-WebSocket-Protocol", protocol)
+	}
+	return claims, true
+}
+
+func (service *Service) newContext(conn *websocket.Conn, claims *Claims) *Context {
 	context := Context{}
 	context.InitServiceContext(service)
 	context.WebSocket = conn
 	context.ClientID = util.UUIDv4()
+	if claims != nil {
+		context.ClientID = claims.Subject
+	}
+	context.Claims = claims
 	return &context
 }
 
@@ -138,6 +211,14 @@ func (context *Context) close() {
 	_ = context.WebSocket.Close()
 }
 
+// replace tells the client its connection has been replaced and stops its reading, the hijacked connection
+// is not closed until its upgrade handler returns
+func (context *Context) replace() {
+	message := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "replaced by another connection")
+	_ = context.WebSocket.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second))
+	_ = context.WebSocket.SetReadDeadline(time.Now())
+}
+
 func (service *Service) handle(data []byte, context *Context) {
 	err := context.write(data[0:4], service.Handle(data[4:], context))
 	if err != nil {
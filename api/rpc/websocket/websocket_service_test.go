@@ -0,0 +1,75 @@
+package websocket_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	fastws "github.com/fasthttp/websocket"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttputil"
+
+	"video-chat/api/rpc/websocket"
+)
+
+func TestService_ClientOfTokenSubject(t *testing.T) {
+	disconnected := make(chan string, 2)
+	service := websocket.NewService(nil, func(clientID string) { disconnected <- clientID })
+	a, err := websocket.NewAuthenticator(websocket.AuthConfig{Secret: testSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.SetAuthenticator(a)
+	listener := fasthttputil.NewInmemoryListener()
+	defer listener.Close()
+	go func() { _ = fasthttp.Serve(listener, service.ServeWS) }()
+	dialer := fastws.Dialer{NetDial: func(string, string) (net.Conn, error) { return listener.Dial() }}
+	claims := map[string]interface{}{"sub": "user-1", "exp": time.Now().Add(time.Hour).Unix(), "role": "guest"}
+	url := "ws://vichat.example.com/?token=" + hmacToken(t, "HS256", testSecret, claims)
+
+	conn, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	// the upgrade handler stores the client after the response has been sent
+	first := waitContext(t, service, "user-1", nil)
+
+	// the connection of the subject is replaced, e.g. after the first one has become half-open
+	second, _, err := dialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal("second connection of the subject refused", err)
+	}
+	defer second.Close()
+	waitContext(t, service, "user-1", first)
+	select {
+	case clientID := <-disconnected:
+		if clientID != "user-1" {
+			t.Fatal("unexpected client disconnected", clientID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("client of the replaced connection not disconnected")
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, _, err = conn.ReadMessage(); !fastws.IsCloseError(err, fastws.ClosePolicyViolation) {
+		t.Fatal("replaced connection not closed", err)
+	}
+	select {
+	case clientID := <-disconnected:
+		t.Fatal("client disconnected by the replaced connection", clientID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// waitContext waits until the client is served by another context than the previous one
+func waitContext(t *testing.T, service *websocket.Service, clientID string, previous *websocket.Context) *websocket.Context {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if context, ok := service.Context(clientID); ok && context != previous {
+			return context
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("client is not identified by the token subject")
+	return nil
+}
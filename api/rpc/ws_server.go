@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"crypto/tls"
+	"net"
 	"os"
 	"os/signal"
 	"sync"
@@ -15,6 +17,9 @@ import (
 type Server struct {
 	*fasthttp.Server
 	service *websocket.Service
+	config  Config
+	// certificate of the HTTPS server, nil if the server serves plain HTTP
+	certificates *certificateLoader
 }
 
 func onShutdown(f func()) {
@@ -27,13 +32,50 @@ func onShutdown(f func()) {
 	}()
 }
 
-func NewServer(service *websocket.Service) Server {
-	s := Server{&fasthttp.Server{Handler: service.ServeWS, NoDefaultServerHeader: true, NoDefaultContentType: true}, service}
+// NewServer creates the server of the service, the clients are authenticated and HTTPS is served if configured
+func NewServer(service *websocket.Service, config Config) (Server, error) {
+	origins, err := websocket.NewOriginPolicy(config.Origins)
+	if err != nil {
+		return Server{}, err
+	}
+	if config.Origins.Development {
+		log.Warn().Msg("New Ws Server; Development Mode, Localhost Origins Only")
+	}
+	service.SetOriginPolicy(origins)
+	if config.Auth.Enabled() {
+		authenticator, err := websocket.NewAuthenticator(config.Auth)
+		if err != nil {
+			return Server{}, err
+		}
+		service.SetAuthenticator(authenticator)
+	}
+	var certificates *certificateLoader
+	if config.TLS.Enabled() {
+		if certificates, err = newCertificateLoader(config.TLS); err != nil {
+			return Server{}, err
+		}
+	}
+	s := Server{&fasthttp.Server{Handler: service.ServeWS, NoDefaultServerHeader: true, NoDefaultContentType: true}, service, config, certificates}
 	onShutdown(func() {
 		err := s.Shutdown()
 		if err != nil {
 			log.Error().Err(err).Msg("New Ws Server")
 		}
 	})
-	return s
+	return s, nil
+}
+
+// ListenAndServe serves the RPC service on the configured address, over TLS if the certificate is configured
+func (s Server) ListenAndServe() error {
+	if s.certificates == nil {
+		return s.Server.ListenAndServe(s.config.Bind)
+	}
+	ln, err := net.Listen("tcp4", s.config.Bind)
+	if err != nil {
+		return err
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go s.certificates.watch(stop)
+	return s.Server.Serve(tls.NewListener(ln, s.certificates.tlsConfig()))
 }
@@ -0,0 +1,42 @@
+package rtc
+
+import (
+	"github.com/pion/rtcp"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// bits per second assumed towards a subscriber until its feedback is received
+	initialBandwidth = 1_000_000
+	// the estimate does not drop below the bitrate of the lowest simulcast layer
+	minBandwidth = 100_000
+)
+
+// sendBandwidthEstimate sends a REMB of the lowest bandwidth share of the subscribers to the publisher,
+// so the publisher does not encode more than the slowest subscriber is able to receive. A simulcast track
+// forwarded to several subscribers is not limited, its lower layers serve the slower subscribers.
+func (t *UpTrack) sendBandwidthEstimate() {
+	t.mutex.RLock()
+	if len(t.layers) > 1 && len(t.downTracks) > 1 {
+		t.mutex.RUnlock()
+		return
+	}
+	var bitrate uint64
+	for _, track := range t.downTracks {
+		if budget := track.subscriber.videoBudget(); budget > 0 && (bitrate == 0 || budget < bitrate) {
+			bitrate = budget
+		}
+	}
+	t.mutex.RUnlock()
+	if bitrate == 0 {
+		return
+	}
+	var ssrcs []uint32
+	for _, ssrc := range t.ssrcs() {
+		ssrcs = append(ssrcs, uint32(ssrc))
+	}
+	err := t.publisher.WriteRTCP([]rtcp.Packet{&rtcp.ReceiverEstimatedMaximumBitrate{Bitrate: float32(bitrate), SSRCs: ssrcs}})
+	if err != nil {
+		log.Debug().Err(err).Msg("Track REMB")
+	}
+}
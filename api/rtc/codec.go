@@ -0,0 +1,131 @@
+package rtc
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v3"
+)
+
+// offeredCodec is a codec of a media section of the offer
+type offeredCodec struct {
+	mimeType  string
+	clockRate uint32
+	fmtp      map[string]string
+}
+
+// offeredCodecs returns the codecs of the active media sections of the kind, ok is false if there is no such section
+func offeredCodecs(desc *sdp.SessionDescription, kind webrtc.RTPCodecType) (codecs []offeredCodec, ok bool) {
+	for _, media := range desc.MediaDescriptions {
+		// a rejected section has the port zero
+		if media.MediaName.Media != kind.String() || media.MediaName.Port.Value == 0 {
+			continue
+		}
+		ok = true
+		rtpMaps := make(map[string]string)
+		fmtps := make(map[string]string)
+		for _, attribute := range media.Attributes {
+			payloadType, value, _ := strings.Cut(attribute.Value, " ")
+			switch attribute.Key {
+			case "rtpmap":
+				rtpMaps[payloadType] = value
+			case "fmtp":
+				fmtps[payloadType] = value
+			}
+		}
+		for _, payloadType := range media.MediaName.Formats {
+			rtpMap, found := rtpMaps[payloadType]
+			if !found {
+				continue
+			}
+			// encoding name/clock rate[/channels]
+			parts := strings.Split(rtpMap, "/")
+			if len(parts) < 2 {
+				continue
+			}
+			clockRate, err := strconv.ParseUint(parts[1], 10, 32)
+			if err != nil {
+				continue
+			}
+			codecs = append(codecs, offeredCodec{
+				mimeType:  kind.String() + "/" + parts[0],
+				clockRate: uint32(clockRate),
+				fmtp:      parseFmtp(fmtps[payloadType]),
+			})
+		}
+	}
+	return
+}
+
+func parseFmtp(line string) map[string]string {
+	parameters := make(map[string]string)
+	for _, parameter := range strings.Split(line, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(parameter), "=")
+		if key != "" {
+			parameters[strings.ToLower(key)] = value
+		}
+	}
+	return parameters
+}
+
+// matches reports whether the offered codec is able to carry the codec, the parameters which must be used
+// symmetrically are compared: the profile of H.264 and VP9 and the packetization mode of H.264
+func (c offeredCodec) matches(codec webrtc.RTPCodecCapability) bool {
+	if !strings.EqualFold(c.mimeType, codec.MimeType) || c.clockRate != codec.ClockRate {
+		return false
+	}
+	fmtp := parseFmtp(codec.SDPFmtpLine)
+	switch strings.ToLower(codec.MimeType) {
+	case strings.ToLower(webrtc.MimeTypeH264):
+		return c.fmtp["packetization-mode"] == fmtp["packetization-mode"] &&
+			sameH264Profile(c.fmtp["profile-level-id"], fmtp["profile-level-id"])
+	case strings.ToLower(webrtc.MimeTypeVP9):
+		return profileId(c.fmtp) == profileId(fmtp)
+	}
+	return true
+}
+
+// sameH264Profile compares the profile_idc and profile-iop bytes of the profile-level-id, the level may differ
+func sameH264Profile(a, b string) bool {
+	aa, err := hex.DecodeString(a)
+	if err != nil || len(aa) < 2 {
+		return false
+	}
+	bb, err := hex.DecodeString(b)
+	if err != nil || len(bb) < 2 {
+		return false
+	}
+	return aa[0] == bb[0] && aa[1] == bb[1]
+}
+
+// profileId returns the VP9 profile, the profile 0 is implied if missing
+func profileId(fmtp map[string]string) string {
+	if id, ok := fmtp["profile-id"]; ok {
+		return id
+	}
+	return "0"
+}
+
+// negotiateCodec returns the first codec carried by the offered ones
+func negotiateCodec(candidates []webrtc.RTPCodecParameters, offered []offeredCodec) (webrtc.RTPCodecCapability, bool) {
+	for _, candidate := range candidates {
+		for _, codec := range offered {
+			if codec.matches(candidate.RTPCodecCapability) {
+				return candidate.RTPCodecCapability, true
+			}
+		}
+	}
+	return webrtc.RTPCodecCapability{}, false
+}
+
+// noCommonCodecError explains which codecs the participant is expected to support
+func noCommonCodecError(kind webrtc.RTPCodecType, expected []webrtc.RTPCodecParameters) error {
+	mimeTypes := make([]string, 0, len(expected))
+	for _, codec := range expected {
+		mimeTypes = append(mimeTypes, codec.MimeType)
+	}
+	return errors.New("no common " + kind.String() + " codec; one of " + strings.Join(mimeTypes, ", ") + " is required")
+}
@@ -0,0 +1,171 @@
+package rtc
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v3"
+)
+
+// testOffer returns an offer with a media section of each kind, a codec is "rtpmap[;fmtp]" of a dynamic payload type
+func testOffer(t *testing.T, media map[string][]string) *sdp.SessionDescription {
+	t.Helper()
+	raw := "v=0\r\no=- 0 0 IN IP4 127.0.0.1\r\ns=-\r\nt=0 0\r\n"
+	for _, kind := range []string{"audio", "video"} {
+		codecs, ok := media[kind]
+		if !ok {
+			continue
+		}
+		formats, attributes := "", ""
+		for i, codec := range codecs {
+			payloadType := strconv.Itoa(96 + i)
+			rtpMap, fmtp, found := strings.Cut(codec, ";")
+			formats += " " + payloadType
+			attributes += "a=rtpmap:" + payloadType + " " + rtpMap + "\r\n"
+			if found {
+				attributes += "a=fmtp:" + payloadType + " " + fmtp + "\r\n"
+			}
+		}
+		raw += "m=" + kind + " 9 UDP/TLS/RTP/SAVPF" + formats + "\r\nc=IN IP4 0.0.0.0\r\n" + attributes
+	}
+	desc := &sdp.SessionDescription{}
+	if err := desc.Unmarshal([]byte(raw)); err != nil {
+		t.Fatal(err)
+	}
+	return desc
+}
+
+func TestSession_NegotiateCodecs_RefusedOfferChoosesNothing(t *testing.T) {
+	s := NewSession("session", nil)
+	// the audio codec is supported, the video one is not
+	offer := testOffer(t, map[string][]string{"audio": {"opus/48000/2"}, "video": {"H264/90000;packetization-mode=1;profile-level-id=42e01f"}})
+	if _, err := s.negotiateCodecs(offer); err == nil {
+		t.Fatal("unsupported video codec accepted")
+	}
+	if len(s.codecs) != 0 {
+		t.Fatal("codec chosen by a refused offer", s.codecs)
+	}
+
+	codecs, err := s.negotiateCodecs(testOffer(t, map[string][]string{"audio": {"opus/48000/2"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := codecs[webrtc.RTPCodecTypeVideo]; ok || codecs[webrtc.RTPCodecTypeAudio].MimeType != webrtc.MimeTypeOpus {
+		t.Fatal("unexpected codecs", codecs)
+	}
+	if len(s.codecs) != 0 {
+		t.Fatal("codec chosen before the participant has joined", s.codecs)
+	}
+}
+
+func TestSession_Join_FailureReleasesCodecs(t *testing.T) {
+	s := NewSession("session", nil)
+	opus := audioCodecs["opus"].RTPCodecCapability
+	s.codecs[webrtc.RTPCodecTypeAudio] = opus
+	s.peers["a"] = &Peer{Id: "a", codecs: map[webrtc.RTPCodecType]webrtc.RTPCodecCapability{webrtc.RTPCodecTypeAudio: opus}}
+
+	// the media sections of the offer have no mid, so it is refused once the codecs are chosen
+	raw, err := testOffer(t, map[string][]string{"audio": {"opus/48000/2"}, "video": {"VP8/90000"}}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Join("b", &webrtc.SessionDescription{Type: webrtc.SDPTypeOffer, SDP: string(raw)}); err == nil {
+		t.Fatal("offer without mids accepted")
+	}
+	if s.HasPeer("b") {
+		t.Fatal("refused participant joined")
+	}
+	if _, ok := s.codecs[webrtc.RTPCodecTypeVideo]; ok || len(s.codecs) != 1 {
+		t.Fatal("codec of the refused participant kept", s.codecs)
+	}
+}
+
+func TestParseFmtp(t *testing.T) {
+	tests := []struct {
+		line string
+		want map[string]string
+	}{
+		{"", map[string]string{}},
+		{"profile-id=2", map[string]string{"profile-id": "2"}},
+		{"level-asymmetry-allowed=1; Packetization-Mode=1;profile-level-id=42e01f",
+			map[string]string{"level-asymmetry-allowed": "1", "packetization-mode": "1", "profile-level-id": "42e01f"}},
+		{"useinbandfec;;minptime=10", map[string]string{"useinbandfec": "", "minptime": "10"}},
+	}
+	for _, test := range tests {
+		got := parseFmtp(test.line)
+		if len(got) != len(test.want) {
+			t.Fatalf("%q parsed to %v", test.line, got)
+		}
+		for key, value := range test.want {
+			if v, ok := got[key]; !ok || v != value {
+				t.Fatalf("%q parsed to %v", test.line, got)
+			}
+		}
+	}
+}
+
+func TestOfferedCodec_Matches(t *testing.T) {
+	h264 := videoCodecs["h264"].RTPCodecCapability
+	vp9 := videoCodecs["vp9"].RTPCodecCapability
+	tests := []struct {
+		name    string
+		offered offeredCodec
+		codec   webrtc.RTPCodecCapability
+		want    bool
+	}{
+		{"h264 same", offeredCodec{"video/H264", 90000, parseFmtp("packetization-mode=1;profile-level-id=42e01f")}, h264, true},
+		{"h264 other level", offeredCodec{"video/h264", 90000, parseFmtp("packetization-mode=1;profile-level-id=42e034")}, h264, true},
+		{"h264 other packetization", offeredCodec{"video/H264", 90000, parseFmtp("packetization-mode=0;profile-level-id=42e01f")}, h264, false},
+		{"h264 no packetization", offeredCodec{"video/H264", 90000, parseFmtp("profile-level-id=42e01f")}, h264, false},
+		{"h264 high profile", offeredCodec{"video/H264", 90000, parseFmtp("packetization-mode=1;profile-level-id=640c1f")}, h264, false},
+		{"h264 baseline", offeredCodec{"video/H264", 90000, parseFmtp("packetization-mode=1;profile-level-id=42001f")}, h264, false},
+		{"h264 malformed profile", offeredCodec{"video/H264", 90000, parseFmtp("packetization-mode=1;profile-level-id=4")}, h264, false},
+		{"vp9 profile 0", offeredCodec{"video/VP9", 90000, parseFmtp("profile-id=0")}, vp9, true},
+		{"vp9 implied profile 0", offeredCodec{"video/VP9", 90000, parseFmtp("")}, vp9, true},
+		{"vp9 profile 2", offeredCodec{"video/VP9", 90000, parseFmtp("profile-id=2")}, vp9, false},
+		{"other clock rate", offeredCodec{"video/VP8", 48000, nil}, videoCodecs["vp8"].RTPCodecCapability, false},
+		{"other mime type", offeredCodec{"video/VP8", 90000, nil}, vp9, false},
+	}
+	for _, test := range tests {
+		if got := test.offered.matches(test.codec); got != test.want {
+			t.Fatalf("%s: got %v", test.name, got)
+		}
+	}
+}
+
+func TestNegotiateCodec(t *testing.T) {
+	candidates := []webrtc.RTPCodecParameters{videoCodecs["vp9"], videoCodecs["h264"], videoCodecs["vp8"]}
+	tests := []struct {
+		name    string
+		offered []offeredCodec
+		want    string
+	}{
+		{"server preference", []offeredCodec{{"video/VP8", 90000, nil}, {"video/VP9", 90000, parseFmtp("profile-id=0")}}, webrtc.MimeTypeVP9},
+		{"unsupported profile skipped", []offeredCodec{{"video/VP9", 90000, parseFmtp("profile-id=2")},
+			{"video/H264", 90000, parseFmtp("packetization-mode=1;profile-level-id=42e01f")}}, webrtc.MimeTypeH264},
+		{"last candidate", []offeredCodec{{"video/AV1", 90000, nil}, {"video/VP8", 90000, nil}}, webrtc.MimeTypeVP8},
+		{"nothing in common", []offeredCodec{{"video/AV1", 90000, nil}}, ""},
+	}
+	for _, test := range tests {
+		codec, ok := negotiateCodec(candidates, test.offered)
+		if ok != (test.want != "") || codec.MimeType != test.want {
+			t.Fatalf("%s: got %q, %v", test.name, codec.MimeType, ok)
+		}
+	}
+}
+
+func TestOfferedCodecs(t *testing.T) {
+	offer := testOffer(t, map[string][]string{"video": {"VP8/90000", "VP9/90000;profile-id=2", "rtx/90000;apt=96"}})
+	if _, ok := offeredCodecs(offer, webrtc.RTPCodecTypeAudio); ok {
+		t.Fatal("audio offered")
+	}
+	codecs, ok := offeredCodecs(offer, webrtc.RTPCodecTypeVideo)
+	if !ok || len(codecs) != 3 {
+		t.Fatal("unexpected codecs", codecs)
+	}
+	if codecs[1].mimeType != "video/VP9" || codecs[1].clockRate != 90000 || codecs[1].fmtp["profile-id"] != "2" {
+		t.Fatal("unexpected codec", codecs[1])
+	}
+}
@@ -0,0 +1,166 @@
+package rtc
+
+import (
+	"errors"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pion/webrtc/v3"
+)
+
+// Config of the WebRTC media server
+type Config struct {
+	// URLs of the STUN servers used to gather the server reflexive candidates
+	STUNServers []string `yaml:"stun"`
+	// TURN servers relaying the media of the participants behind restrictive NATs and firewalls
+	TURN TURNConfig `yaml:"turn"`
+	// ICE transport of the server
+	ICE ICEConfig `yaml:"ice"`
+	// Audio codecs offered to the participants in the order of preference
+	AudioCodecs []string `yaml:"audioCodecs"`
+	// Video codecs offered to the participants in the order of preference
+	VideoCodecs []string `yaml:"videoCodecs"`
+	// Interval of the periodic keyframe requests sent to the publishers as a fallback of the requests
+	// driven by the subscribers, disabled if zero
+	PLIInterval time.Duration `yaml:"pliInterval"`
+	// Minimal interval between keyframe requests of a track sent to its publisher
+	KeyFrameMinInterval time.Duration `yaml:"keyFrameMinInterval"`
+	// Size of the buffer a received RTP packet is read into
+	RTPBufferSize int `yaml:"rtpBufferSize"`
+}
+
+// TURNConfig of the TURN servers sharing the secret of the TURN REST API credentials
+type TURNConfig struct {
+	URLs []string `yaml:"urls"`
+	// Secret shared with the TURN servers
+	Secret string `yaml:"secret"`
+	// Lifetime of the issued credentials
+	CredentialTTL time.Duration `yaml:"credentialTtl"`
+}
+
+// ICEConfig restricts the ICE candidates gathered by the server
+type ICEConfig struct {
+	// Range of the ephemeral UDP ports, any port is used if zero
+	PortMin int `yaml:"portMin"`
+	PortMax int `yaml:"portMax"`
+	// Single UDP port shared by all peer connections, disabled if zero
+	UDPMuxPort int `yaml:"udpMuxPort"`
+	// Single TCP port of ICE-TCP shared by all peer connections, disabled if zero
+	TCPMuxPort int `yaml:"tcpMuxPort"`
+	// Public IPs of the host behind a 1:1 NAT, either "public" or "public/private" mappings
+	NAT1To1IPs []string `yaml:"nat1to1Ips"`
+	// Candidate type the public IPs are advertised with: host or srflx
+	NAT1To1CandidateType string `yaml:"nat1to1CandidateType"`
+	// Allowed network types: udp4, udp6, tcp4 and tcp6, UDP and the TCP of the TCP mux are allowed if empty
+	NetworkTypes []string `yaml:"networkTypes"`
+	// Network interfaces the candidates are gathered on, every interface is used if empty
+	Interfaces []string `yaml:"interfaces"`
+	// Network interfaces never used, e.g. docker0
+	ExcludedInterfaces []string `yaml:"excludedInterfaces"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		STUNServers:         []string{"stun:stun.l.google.com:19302"},
+		TURN:                TURNConfig{CredentialTTL: 24 * time.Hour},
+		ICE:                 ICEConfig{NAT1To1CandidateType: "host"},
+		AudioCodecs:         []string{"opus"},
+		VideoCodecs:         []string{"vp8"},
+		KeyFrameMinInterval: 500 * time.Millisecond,
+		RTPBufferSize:       1460,
+	}
+}
+
+func (c *Config) Validate() error {
+	for _, url := range c.STUNServers {
+		if !strings.HasPrefix(url, "stun:") && !strings.HasPrefix(url, "stuns:") {
+			return errors.New("invalid STUN server URL '" + url + "'")
+		}
+	}
+	for _, url := range c.TURN.URLs {
+		if !strings.HasPrefix(url, "turn:") && !strings.HasPrefix(url, "turns:") {
+			return errors.New("invalid TURN server URL '" + url + "'")
+		}
+	}
+	if len(c.TURN.URLs) > 0 && c.TURN.Secret == "" {
+		return errors.New("TURN secret must be set")
+	}
+	if c.TURN.CredentialTTL <= 0 {
+		return errors.New("TURN credential TTL must be positive")
+	}
+	if err := c.ICE.Validate(); err != nil {
+		return err
+	}
+	if len(c.AudioCodecs) == 0 || len(c.VideoCodecs) == 0 {
+		return errors.New("no audio or video codec is configured")
+	}
+	if err := validateCodecs("audio", c.AudioCodecs, audioCodecs); err != nil {
+		return err
+	}
+	if err := validateCodecs("video", c.VideoCodecs, videoCodecs); err != nil {
+		return err
+	}
+	if c.PLIInterval < 0 {
+		return errors.New("PLI interval must not be negative")
+	}
+	if c.KeyFrameMinInterval <= 0 {
+		return errors.New("keyframe minimal interval must be positive")
+	}
+	// the buffer must fit a packet of the usual MTU
+	if c.RTPBufferSize < 1200 || c.RTPBufferSize > 65535 {
+		return errors.New("RTP buffer size must be between 1200 and 65535")
+	}
+	return nil
+}
+
+func validateCodecs(kind string, names []string, supported map[string]webrtc.RTPCodecParameters) error {
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = strings.ToLower(name)
+		if _, ok := supported[name]; !ok {
+			return errors.New("unsupported " + kind + " codec '" + name + "'")
+		}
+		if seen[name] {
+			return errors.New("duplicate " + kind + " codec '" + name + "'")
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+func (c *ICEConfig) Validate() error {
+	if c.PortMin != 0 || c.PortMax != 0 {
+		if c.PortMin <= 0 || c.PortMin > c.PortMax || c.PortMax > 65535 {
+			return errors.New("invalid ICE port range " + strconv.Itoa(c.PortMin) + "-" + strconv.Itoa(c.PortMax))
+		}
+	}
+	if c.UDPMuxPort != 0 && c.PortMin != 0 {
+		return errors.New("ICE port range and UDP mux port are exclusive")
+	}
+	if c.UDPMuxPort < 0 || c.UDPMuxPort > 65535 || c.TCPMuxPort < 0 || c.TCPMuxPort > 65535 {
+		return errors.New("invalid ICE mux port")
+	}
+	if c.TCPMuxPort != 0 && len(c.NetworkTypes) > 0 && !slices.Contains(c.NetworkTypes, "tcp4") && !slices.Contains(c.NetworkTypes, "tcp6") {
+		return errors.New("ICE TCP mux requires a TCP network type")
+	}
+	for _, mapping := range c.NAT1To1IPs {
+		for _, ip := range strings.Split(mapping, "/") {
+			if net.ParseIP(ip) == nil {
+				return errors.New("invalid NAT 1:1 IP mapping '" + mapping + "'")
+			}
+		}
+	}
+	candidateType, err := webrtc.NewICECandidateType(c.NAT1To1CandidateType)
+	if err != nil || candidateType != webrtc.ICECandidateTypeHost && candidateType != webrtc.ICECandidateTypeSrflx {
+		return errors.New("NAT 1:1 candidate type must be host or srflx")
+	}
+	for _, networkType := range c.NetworkTypes {
+		if _, err := webrtc.NewNetworkType(networkType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package rtc
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+
+	"github.com/pion/webrtc/v3/pkg/media"
+)
+
+const (
+	ivfFileHeaderSize  = 32
+	ivfFrameHeaderSize = 12
+)
+
+// ivfWriter writes VP8 frames into an IVF file, unlike the pion writer the frame timestamps are
+// taken from the RTP clock so the recording keeps the real timing of a variable frame rate
+type ivfWriter struct {
+	file       *os.File
+	clockRate  uint32
+	frames     uint32
+	started    bool
+	firstStamp uint32
+	// a frame has been lost and the decoder is not able to continue until the next keyframe
+	broken bool
+}
+
+func newIVFWriter(fileName string, clockRate uint32) (*ivfWriter, error) {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return &ivfWriter{file: file, clockRate: clockRate}, nil
+}
+
+// isVP8KeyFrame checks the inverse key frame flag of the VP8 frame tag
+func isVP8KeyFrame(frame []byte) bool {
+	return len(frame) >= 10 && frame[0]&0x01 == 0
+}
+
+func (w *ivfWriter) writeHeader(keyFrame []byte) error {
+	header := make([]byte, ivfFileHeaderSize)
+	copy(header[0:], "DKIF")
+	binary.LittleEndian.PutUint16(header[4:], 0)  // version
+	binary.LittleEndian.PutUint16(header[6:], 32) // header size
+	copy(header[8:], "VP80")
+	// frame dimensions follow the start code of the key frame
+	binary.LittleEndian.PutUint16(header[12:], binary.LittleEndian.Uint16(keyFrame[6:])&0x3fff)
+	binary.LittleEndian.PutUint16(header[14:], binary.LittleEndian.Uint16(keyFrame[8:])&0x3fff)
+	binary.LittleEndian.PutUint32(header[16:], w.clockRate) // timebase denominator
+	binary.LittleEndian.PutUint32(header[20:], 1)           // timebase numerator
+	_, err := w.file.Write(header)
+	return err
+}
+
+// WriteSample writes the frame, the frames following a loss are skipped until the next keyframe
+func (w *ivfWriter) WriteSample(sample *media.Sample) error {
+	if w.file == nil {
+		return errors.New("ivf writer has been closed")
+	}
+	keyFrame := isVP8KeyFrame(sample.Data)
+	if sample.PrevDroppedPackets > 0 {
+		w.broken = true
+	}
+	if !keyFrame && (!w.started || w.broken) {
+		return nil
+	}
+	w.broken = false
+	if !w.started {
+		if err := w.writeHeader(sample.Data); err != nil {
+			return err
+		}
+		w.started = true
+		w.firstStamp = sample.PacketTimestamp
+	}
+	header := make([]byte, ivfFrameHeaderSize)
+	binary.LittleEndian.PutUint32(header[0:], uint32(len(sample.Data)))
+	binary.LittleEndian.PutUint64(header[4:], uint64(sample.PacketTimestamp-w.firstStamp))
+	if _, err := w.file.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.file.Write(sample.Data); err != nil {
+		return err
+	}
+	w.frames++
+	return nil
+}
+
+// NeedsKeyFrame reports whether the writer waits for a keyframe
+func (w *ivfWriter) NeedsKeyFrame() bool {
+	return !w.started || w.broken
+}
+
+func (w *ivfWriter) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	defer func() {
+		w.file = nil
+	}()
+	if w.started {
+		// the number of frames is known only at the end
+		count := make([]byte, 4)
+		binary.LittleEndian.PutUint32(count, w.frames)
+		if _, err := w.file.WriteAt(count, 24); err != nil {
+			_ = w.file.Close()
+			return err
+		}
+	}
+	return w.file.Close()
+}
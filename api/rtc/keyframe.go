@@ -0,0 +1,90 @@
+package rtc
+
+import (
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pion/rtcp"
+	"github.com/pion/rtp/codecs"
+	"github.com/pion/webrtc/v3"
+	"github.com/rs/zerolog/log"
+)
+
+// isKeyFrame reports whether the payload starts a keyframe of the codec, a layer is switched on keyframes only
+func isKeyFrame(mimeType string, payload []byte) bool {
+	switch strings.ToLower(mimeType) {
+	case strings.ToLower(webrtc.MimeTypeVP8):
+		vp8 := codecs.VP8Packet{}
+		if _, err := vp8.Unmarshal(payload); err != nil {
+			return false
+		}
+		return vp8.S == 1 && vp8.PID == 0 && isVP8KeyFrame(vp8.Payload)
+	case strings.ToLower(webrtc.MimeTypeVP9):
+		vp9 := codecs.VP9Packet{}
+		if _, err := vp9.Unmarshal(payload); err != nil {
+			return false
+		}
+		return !vp9.P && vp9.B
+	case strings.ToLower(webrtc.MimeTypeH264):
+		return isH264KeyFrame(payload)
+	case strings.ToLower(webrtc.MimeTypeAV1):
+		// the N flag of the aggregation header starts a new coded video sequence
+		return len(payload) > 0 && payload[0]&0x08 != 0
+	}
+	return false
+}
+
+const (
+	h264NALUTypeIDR   = 5
+	h264NALUTypeSPS   = 7
+	h264NALUTypeSTAPA = 24
+	h264NALUTypeFUA   = 28
+)
+
+// isH264KeyFrame looks for an IDR slice or a sequence parameter set in the single, aggregated or fragmented NAL units
+func isH264KeyFrame(payload []byte) bool {
+	if len(payload) < 2 {
+		return false
+	}
+	switch naluType := payload[0] & 0x1f; naluType {
+	case h264NALUTypeIDR, h264NALUTypeSPS:
+		return true
+	case h264NALUTypeSTAPA:
+		// 16 bits size prefixed NAL units
+		for offset := 1; offset+2 < len(payload); {
+			size := int(payload[offset])<<8 | int(payload[offset+1])
+			if t := payload[offset+2] & 0x1f; t == h264NALUTypeIDR || t == h264NALUTypeSPS {
+				return true
+			}
+			offset += 2 + size
+		}
+	case h264NALUTypeFUA:
+		// the start bit and the type of the fragmented NAL unit
+		return payload[1]&0x80 != 0 && payload[1]&0x1f == h264NALUTypeIDR
+	}
+	return false
+}
+
+// requestKeyFrame sends a PLI of the layer to the publisher on demand of a subscriber,
+// the requests of a layer are sent at most once per the configured interval
+func (t *UpTrack) requestKeyFrame(l *layer) {
+	now := time.Now().UnixNano()
+	requested := atomic.LoadInt64(&l.keyFrameRequested)
+	if now-requested < int64(API().settings.KeyFrameMinInterval) || !atomic.CompareAndSwapInt64(&l.keyFrameRequested, requested, now) {
+		return
+	}
+	err := t.publisher.WriteRTCP([]rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: uint32(l.SSRC())}})
+	if err != nil {
+		log.Debug().Err(err).Msg("Track Request Key Frame")
+	}
+}
+
+// requestKeyFrames requests a keyframe of every layer, e.g. when the forwarding is resumed
+func (t *UpTrack) requestKeyFrames() {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	for _, l := range t.layers {
+		t.requestKeyFrame(l)
+	}
+}
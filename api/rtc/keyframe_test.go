@@ -0,0 +1,63 @@
+package rtc
+
+import (
+	"testing"
+
+	"github.com/pion/webrtc/v3"
+)
+
+func TestIsH264KeyFrame(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload []byte
+		want    bool
+	}{
+		{"single IDR", []byte{0x65, 0x88}, true},
+		{"single SPS", []byte{0x67, 0x42}, true},
+		{"single non-IDR", []byte{0x41, 0x9a}, false},
+		{"single PPS", []byte{0x68, 0xce}, false},
+		{"STAP-A with SPS", []byte{0x78, 0x00, 0x02, 0x09, 0xf0, 0x00, 0x02, 0x67, 0x42}, true},
+		{"STAP-A with IDR", []byte{0x78, 0x00, 0x02, 0x68, 0xce, 0x00, 0x02, 0x65, 0x88}, true},
+		{"STAP-A without IDR", []byte{0x78, 0x00, 0x02, 0x09, 0xf0, 0x00, 0x02, 0x41, 0x9a}, false},
+		{"STAP-A truncated", []byte{0x78, 0x00, 0x02}, false},
+		{"FU-A IDR start", []byte{0x7c, 0x85, 0x88}, true},
+		{"FU-A IDR middle", []byte{0x7c, 0x05, 0x88}, false},
+		{"FU-A non-IDR start", []byte{0x7c, 0x81, 0x9a}, false},
+		{"too short", []byte{0x65}, false},
+	}
+	for _, test := range tests {
+		if got := isH264KeyFrame(test.payload); got != test.want {
+			t.Fatalf("%s: got %v", test.name, got)
+		}
+	}
+}
+
+func TestIsKeyFrame(t *testing.T) {
+	// a VP8 frame header of a keyframe has the inverse key frame flag cleared
+	vp8Key := []byte{0x10, 0x10, 0x02, 0x00, 0x9d, 0x01, 0x2a, 0x80, 0x02, 0xe0, 0x01}
+	vp8Delta := []byte{0x10, 0x11, 0x02, 0x00, 0x9d, 0x01, 0x2a, 0x80, 0x02, 0xe0, 0x01}
+	vp8Continued := []byte{0x00, 0x10, 0x02, 0x00, 0x9d, 0x01, 0x2a, 0x80, 0x02, 0xe0, 0x01}
+	tests := []struct {
+		name     string
+		mimeType string
+		payload  []byte
+		want     bool
+	}{
+		{"vp8 key", webrtc.MimeTypeVP8, vp8Key, true},
+		{"vp8 delta", webrtc.MimeTypeVP8, vp8Delta, false},
+		{"vp8 not the start of the frame", webrtc.MimeTypeVP8, vp8Continued, false},
+		{"vp9 key", webrtc.MimeTypeVP9, []byte{0x08, 0x00}, true},
+		{"vp9 inter predicted", webrtc.MimeTypeVP9, []byte{0x48, 0x00}, false},
+		{"vp9 not the start of the frame", webrtc.MimeTypeVP9, []byte{0x04, 0x00}, false},
+		{"h264 IDR", webrtc.MimeTypeH264, []byte{0x65, 0x88}, true},
+		{"h264 non-IDR", webrtc.MimeTypeH264, []byte{0x41, 0x9a}, false},
+		{"av1 new sequence", webrtc.MimeTypeAV1, []byte{0x18, 0x00}, true},
+		{"av1 same sequence", webrtc.MimeTypeAV1, []byte{0x10, 0x00}, false},
+		{"opus", webrtc.MimeTypeOpus, []byte{0x78, 0x00}, false},
+	}
+	for _, test := range tests {
+		if got := isKeyFrame(test.mimeType, test.payload); got != test.want {
+			t.Fatalf("%s: got %v", test.name, got)
+		}
+	}
+}
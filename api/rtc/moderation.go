@@ -0,0 +1,70 @@
+package rtc
+
+import (
+	"github.com/pion/webrtc/v3"
+)
+
+// Moderator returns the id of the participant moderating the session
+func (s *Session) Moderator() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.moderator
+}
+
+// IsModerator reports whether the participant moderates the session
+func (s *Session) IsModerator(peerId string) bool {
+	return s.Moderator() == peerId
+}
+
+// SetModerator passes the moderation to the participant
+func (s *Session) SetModerator(peerId string) {
+	s.mutex.Lock()
+	s.moderator = peerId
+	s.mutex.Unlock()
+}
+
+// ClaimModeration passes the moderation to the participant unless the moderator is a participant of the session,
+// it reports whether the moderation has been passed
+func (s *Session) ClaimModeration(peerId string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.peers[s.moderator]; ok || s.moderator == peerId {
+		return false
+	}
+	s.moderator = peerId
+	return true
+}
+
+// Lock locks or unlocks the session, a locked session refuses new participants except the moderator
+func (s *Session) Lock(locked bool) {
+	s.mutex.Lock()
+	s.locked = locked
+	s.mutex.Unlock()
+}
+
+func (s *Session) IsLocked() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.locked
+}
+
+// Mute stops or resumes forwarding of the tracks of the kind published by the participant,
+// the tracks published later are muted as well; a keyframe is requested when the video is resumed
+func (s *Session) Mute(peerId string, kind webrtc.RTPCodecType, muted bool) error {
+	peer, err := s.getPeer(peerId)
+	if err != nil {
+		return err
+	}
+	peer.setMuted(kind, muted)
+	if muted || kind != webrtc.RTPCodecTypeVideo {
+		return nil
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	for _, upTrack := range s.upTracks {
+		if upTrack.publisher == peer && upTrack.Kind() == kind {
+			upTrack.requestKeyFrames()
+		}
+	}
+	return nil
+}
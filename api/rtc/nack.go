@@ -0,0 +1,146 @@
+package rtc
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pion/rtcp"
+	"github.com/pion/rtp"
+	"github.com/pion/webrtc/v3"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// packets kept for retransmission per down track
+	nackHistorySize = 512
+	// minimal interval between retransmissions of a packet
+	nackResendInterval = 100 * time.Millisecond
+	// reordered packets are waited for before they are requested
+	nackDelay = 20 * time.Millisecond
+	// interval between requests of a missing packet
+	nackRetryInterval = 100 * time.Millisecond
+	// requests of a missing packet before it is given up
+	nackMaxRetries = 3
+	// a larger gap is not requested, the packets would be late anyway
+	nackMaxGap = 100
+)
+
+// supportsNack reports whether the generic NACK feedback has been negotiated for the codec
+func supportsNack(codec webrtc.RTPCodecCapability) bool {
+	return slices.ContainsFunc(codec.RTCPFeedback, func(feedback webrtc.RTCPFeedback) bool {
+		return feedback.Type == webrtc.TypeRTCPFBNACK && feedback.Parameter == ""
+	})
+}
+
+type historyEntry struct {
+	header  rtp.Header
+	payload []byte
+	valid   bool
+	// time of the last retransmission
+	resent time.Time
+}
+
+// packetHistory keeps the last packets written to a down track by their sequence numbers,
+// the packets requested by the subscriber are sent again from the history
+type packetHistory struct {
+	mutex   sync.Mutex
+	entries [nackHistorySize]historyEntry
+}
+
+// push stores the packet, the payload must not be modified afterwards
+func (h *packetHistory) push(header rtp.Header, payload []byte) {
+	h.mutex.Lock()
+	h.entries[header.SequenceNumber%nackHistorySize] = historyEntry{header: header, payload: payload, valid: true}
+	h.mutex.Unlock()
+}
+
+// get returns the packets of the sequence numbers to be sent again, the packets retransmitted
+// recently or missing from the history are skipped
+func (h *packetHistory) get(seqs []uint16, now time.Time) (packets []*rtp.Packet) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	for _, seq := range seqs {
+		entry := &h.entries[seq%nackHistorySize]
+		if !entry.valid || entry.header.SequenceNumber != seq || now.Sub(entry.resent) < nackResendInterval {
+			continue
+		}
+		entry.resent = now
+		packets = append(packets, &rtp.Packet{Header: entry.header, Payload: entry.payload})
+	}
+	return
+}
+
+type missingPacket struct {
+	// time the packet is requested at next
+	next    time.Time
+	retries int
+}
+
+// nackGenerator tracks the packets missing from a received stream, the missing packets are requested
+// from the publisher until they arrive or the retries are exhausted
+type nackGenerator struct {
+	started bool
+	highest uint16
+	missing map[uint16]*missingPacket
+	// a packet has been given up since the last pending call
+	lost bool
+}
+
+func newNackGenerator() *nackGenerator {
+	return &nackGenerator{missing: make(map[uint16]*missingPacket)}
+}
+
+// push records the received sequence number
+func (g *nackGenerator) push(seq uint16, now time.Time) {
+	if !g.started {
+		g.started = true
+		g.highest = seq
+		return
+	}
+	if !isOlderSeq(g.highest, seq) {
+		// a reordered or retransmitted packet
+		delete(g.missing, seq)
+		return
+	}
+	gap := seq - g.highest - 1
+	if gap > nackMaxGap {
+		// the stream has been restarted or the loss is too large to recover
+		clear(g.missing)
+		g.lost = true
+	} else {
+		for missing := g.highest + 1; missing != seq; missing++ {
+			g.missing[missing] = &missingPacket{next: now.Add(nackDelay)}
+		}
+	}
+	g.highest = seq
+}
+
+// pending returns the missing sequence numbers to be requested now,
+// lost reports whether a packet has been given up since the last call, the decoders lose sync then
+func (g *nackGenerator) pending(now time.Time) (seqs []uint16, lost bool) {
+	for seq, missing := range g.missing {
+		if now.Before(missing.next) {
+			continue
+		}
+		if missing.retries >= nackMaxRetries || g.highest-seq >= nackHistorySize {
+			delete(g.missing, seq)
+			g.lost = true
+			continue
+		}
+		missing.retries++
+		missing.next = now.Add(nackRetryInterval)
+		seqs = append(seqs, seq)
+	}
+	slices.Sort(seqs)
+	lost, g.lost = g.lost, false
+	return
+}
+
+// requestPackets sends a NACK of the lost packets of the layer to the publisher
+func (t *UpTrack) requestPackets(l *layer, seqs []uint16) {
+	err := t.publisher.WriteRTCP([]rtcp.Packet{&rtcp.TransportLayerNack{MediaSSRC: uint32(l.SSRC()), Nacks: rtcp.NackPairsFromSequenceNumbers(seqs)}})
+	if err != nil {
+		log.Debug().Err(err).Msg("Track NACK")
+	}
+}
@@ -0,0 +1,76 @@
+package rtc
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/pion/rtp"
+)
+
+func TestPacketHistory_Get(t *testing.T) {
+	h := &packetHistory{}
+	for seq := uint16(65000); seq != 100; seq++ {
+		h.push(rtp.Header{SequenceNumber: seq}, []byte{byte(seq)})
+	}
+	now := time.Now()
+	packets := h.get([]uint16{65534, 5, 99}, now)
+	if len(packets) != 3 || packets[1].SequenceNumber != 5 || packets[1].Payload[0] != 5 {
+		t.Fatal("unexpected packets", packets)
+	}
+	// overwritten by the newer packets
+	if packets = h.get([]uint16{65000}, now); len(packets) != 0 {
+		t.Fatal("overwritten packet returned")
+	}
+	// resent recently
+	if packets = h.get([]uint16{5}, now.Add(nackResendInterval/2)); len(packets) != 0 {
+		t.Fatal("packet resent too early")
+	}
+	if packets = h.get([]uint16{5}, now.Add(nackResendInterval)); len(packets) != 1 {
+		t.Fatal("packet not resent")
+	}
+}
+
+func TestNackGenerator_Pending(t *testing.T) {
+	g := newNackGenerator()
+	now := time.Now()
+	for _, seq := range []uint16{65533, 65534, 1, 2, 5} {
+		g.push(seq, now)
+	}
+	// the reordered packets are waited for
+	if seqs, _ := g.pending(now); len(seqs) != 0 {
+		t.Fatal("requested before the delay", seqs)
+	}
+	g.push(3, now)
+	now = now.Add(nackDelay)
+	if _, lost := g.pending(now.Add(-time.Millisecond)); lost {
+		t.Fatal("reported lost before the retries")
+	}
+	if seqs, _ := g.pending(now); !slices.Equal(seqs, []uint16{0, 4, 65535}) {
+		t.Fatal("unexpected missing packets", seqs)
+	}
+	if seqs, _ := g.pending(now.Add(nackRetryInterval / 2)); len(seqs) != 0 {
+		t.Fatal("requested before the retry interval", seqs)
+	}
+	g.push(0, now)
+	for i := 1; i < nackMaxRetries; i++ {
+		now = now.Add(nackRetryInterval)
+		if seqs, _ := g.pending(now); !slices.Equal(seqs, []uint16{4, 65535}) {
+			t.Fatal("unexpected retry", i, seqs)
+		}
+	}
+	if seqs, _ := g.pending(now.Add(nackRetryInterval)); len(seqs) != 0 {
+		t.Fatal("requested after the retries", seqs)
+	}
+}
+
+func TestNackGenerator_LargeGap(t *testing.T) {
+	g := newNackGenerator()
+	now := time.Now()
+	g.push(10, now)
+	g.push(12, now)
+	g.push(12+nackMaxGap+2, now)
+	if seqs, lost := g.pending(now.Add(nackDelay)); len(seqs) != 0 || !lost {
+		t.Fatal("large gap requested", seqs, lost)
+	}
+}
@@ -1,82 +1,151 @@
 package rtc
 
 import (
-	"math/rand"
+	"sync"
+	"sync/atomic"
 
 	"github.com/dchest/uniuri"
-	"github.com/pion/webrtc/v2"
-	"github.com/satori/go.uuid"
+	"github.com/pion/interceptor/pkg/cc"
+	"github.com/pion/webrtc/v3"
 )
 
 type Peer struct {
 	*webrtc.PeerConnection
-	audioTrack *webrtc.Track
-	videoTrack *webrtc.Track
+	Id         string
+	downTracks []*DownTrack
+	// serializes offer/answer exchanges
+	negotiation sync.Mutex
+	// number of the video down tracks bound to an up track, updated atomically
+	videoSources int32
+	// bits per second estimated by the peer as a receiver, zero if unknown; updated atomically
+	bandwidth uint64
+	// estimates the bandwidth towards the peer from its transport-wide congestion control feedback
+	estimator cc.BandwidthEstimator
+	// the peer has sent the transport-wide congestion control feedback, updated atomically
+	transportCC int32
+	// the published tracks of the kind are not forwarded, updated atomically
+	audioMuted int32
+	videoMuted int32
+	// codecs negotiated with the peer by its offer or by its subscriptions, guarded by the session lock
+	codecs map[webrtc.RTPCodecType]webrtc.RTPCodecCapability
 }
 
-func NewPeer() (peer Peer, err error) {
-	api := API()
-	peer.PeerConnection, err = api.NewPeerConnection(api.config)
+func NewPeer(id string) (peer *Peer, err error) {
+	connection, estimator, err := API().newPeerConnection(id)
 	if err != nil {
 		return
 	}
+	peer = &Peer{PeerConnection: connection, Id: id, estimator: estimator,
+		codecs: make(map[webrtc.RTPCodecType]webrtc.RTPCodecCapability)}
 	return
 }
 
-func (p *Peer) InitPeer() (err error) {
-	api := API()
-	p.PeerConnection, err = api.NewPeerConnection(api.config)
+func (p *Peer) Close() (err error) {
+	if p.PeerConnection != nil {
+		err = p.PeerConnection.Close()
+	}
+	return
+}
+
+func (p *Peer) addNewTrack(codec webrtc.RTPCodecCapability, streamId string) (track *DownTrack, err error) {
+	localTrack, err := webrtc.NewTrackLocalStaticRTP(codec, uniuri.New(), streamId)
+	if err != nil {
+		return
+	}
+	sender, err := p.PeerConnection.AddTrack(localTrack)
 	if err != nil {
 		return
 	}
-	_, err = p.PeerConnection.AddTrack(p.videoTrack)
+	// Only the codec of the track is negotiated, so the participant publishes with the codec of the session
+	for _, transceiver := range p.GetTransceivers() {
+		if transceiver.Sender() == sender {
+			err = transceiver.SetCodecPreferences([]webrtc.RTPCodecParameters{{RTPCodecCapability: codec}})
+			break
+		}
+	}
 	if err != nil {
+		_ = p.PeerConnection.RemoveTrack(sender)
 		return
 	}
-	_, err = p.PeerConnection.AddTrack(p.audioTrack)
+	track = newDownTrack(localTrack, sender, p)
+	p.downTracks = append(p.downTracks, track)
+	go track.readRTCP()
 	return
 }
 
-func (p *Peer) Close() (err error) {
-	if p.PeerConnection != nil {
-		err = p.PeerConnection.Close()
+func (p *Peer) removeTrack(track *DownTrack) (err error) {
+	for i, t := range p.downTracks {
+		if t == track {
+			p.downTracks = append(p.downTracks[:i], p.downTracks[i+1:]...)
+			break
+		}
 	}
-	return
+	return p.PeerConnection.RemoveTrack(track.sender)
 }
 
-func newAudioTrack() (*webrtc.Track, error) {
-	codec := getAudioCodec()
-	return webrtc.NewTrack(codec.PayloadType, rand.Uint32(), uniuri.New(), uuid.NewV4().String(), codec)
+// preferCodecs restricts the transceivers to the codecs of their kinds
+func (p *Peer) preferCodecs(codecs map[webrtc.RTPCodecType]webrtc.RTPCodecCapability) error {
+	for _, transceiver := range p.GetTransceivers() {
+		if codec, ok := codecs[transceiver.Kind()]; ok {
+			if err := transceiver.SetCodecPreferences([]webrtc.RTPCodecParameters{{RTPCodecCapability: codec}}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
-func newVideoTrack() (*webrtc.Track, error) {
-	codec := getVideoCodec()
-	return webrtc.NewTrack(codec.PayloadType, rand.Uint32(), uniuri.New(), uuid.NewV4().String(), codec)
+// downTrack returns the down track of the id, the session must be locked
+func (p *Peer) downTrack(id string) *DownTrack {
+	for _, t := range p.downTracks {
+		if t.ID() == id {
+			return t
+		}
+	}
+	return nil
 }
 
-func (p *Peer) addNewTrack(codec *webrtc.RTPCodec) (track *webrtc.Track, err error) {
-	track, err = webrtc.NewTrack(codec.PayloadType, rand.Uint32(), uniuri.New(), uuid.NewV4().String(), codec)
-	if err != nil {
-		return
+func (p *Peer) setBandwidth(bitrate uint64) {
+	atomic.StoreUint64(&p.bandwidth, bitrate)
+}
+
+func (p *Peer) setTransportCC() {
+	atomic.StoreInt32(&p.transportCC, 1)
+}
+
+// estimatedBandwidth returns the bits per second the peer is able to receive, the estimate of the transport-wide
+// congestion control is preferred to the one sent by the peer; zero if unknown
+func (p *Peer) estimatedBandwidth() uint64 {
+	if p.estimator != nil && atomic.LoadInt32(&p.transportCC) != 0 {
+		return uint64(p.estimator.GetTargetBitrate())
 	}
-	_, err = p.PeerConnection.AddTransceiverFromTrack(track)
-	return
+	return atomic.LoadUint64(&p.bandwidth)
 }
 
-func (p *Peer) AddAudioTrack() error {
-	track, err := p.addNewTrack(getAudioCodec())
-	if err != nil {
-		return err
+// videoBudget returns the share of the bandwidth of a forwarded video track, zero if the bandwidth is unknown
+func (p *Peer) videoBudget() uint64 {
+	bandwidth := p.estimatedBandwidth()
+	if sources := atomic.LoadInt32(&p.videoSources); sources > 1 {
+		return bandwidth / uint64(sources)
 	}
-	p.audioTrack = track
-	return nil
+	return bandwidth
 }
 
-func (p *Peer) AddVideoTrack() (err error) {
-	track, err := p.addNewTrack(getVideoCodec())
-	if err != nil {
-		return err
+func (p *Peer) mutedFlag(kind webrtc.RTPCodecType) *int32 {
+	if kind == webrtc.RTPCodecTypeAudio {
+		return &p.audioMuted
 	}
-	p.videoTrack = track
-	return nil
+	return &p.videoMuted
+}
+
+func (p *Peer) setMuted(kind webrtc.RTPCodecType, muted bool) {
+	var flag int32
+	if muted {
+		flag = 1
+	}
+	atomic.StoreInt32(p.mutedFlag(kind), flag)
+}
+
+func (p *Peer) isMuted(kind webrtc.RTPCodecType) bool {
+	return atomic.LoadInt32(p.mutedFlag(kind)) != 0
 }
@@ -0,0 +1,143 @@
+package rtc
+
+import (
+	"errors"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pion/rtcp"
+	"github.com/pion/rtp"
+	"github.com/pion/rtp/codecs"
+	"github.com/pion/webrtc/v3"
+	"github.com/pion/webrtc/v3/pkg/media"
+	"github.com/pion/webrtc/v3/pkg/media/oggwriter"
+	"github.com/pion/webrtc/v3/pkg/media/samplebuilder"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// packets queued for writing, the forwarding never waits for the disk
+	recordQueueSize = 512
+	// how many packets are kept to restore the order of the late ones
+	recordMaxLate = 256
+	// how long an incomplete frame is waited for
+	recordMaxDelay = time.Second
+	// minimal interval between keyframe requests of a recorder
+	recordPLIInterval = time.Second
+)
+
+// recordWriter writes the depacketized samples into a media container
+type recordWriter interface {
+	WriteSample(sample *media.Sample) error
+	// NeedsKeyFrame reports whether the writer is not able to continue until the next keyframe
+	NeedsKeyFrame() bool
+	Close() error
+}
+
+// oggWriter writes Opus samples into an Ogg file, the granule position follows the RTP timestamps
+// so the silence of lost packets is kept
+type oggWriter struct {
+	*oggwriter.OggWriter
+}
+
+func (w oggWriter) WriteSample(sample *media.Sample) error {
+	return w.WriteRTP(&rtp.Packet{Header: rtp.Header{Timestamp: sample.PacketTimestamp}, Payload: sample.Data})
+}
+
+func (w oggWriter) NeedsKeyFrame() bool {
+	return false
+}
+
+// trackRecorder writes the packets of an up track into a file
+type trackRecorder struct {
+	track   *UpTrack
+	packets chan *rtp.Packet
+	done    chan struct{}
+	builder *samplebuilder.SampleBuilder
+	writer  recordWriter
+	lastPLI time.Time
+}
+
+// isRecordable reports whether the tracks of the codec can be recorded, Opus into Ogg and VP8 into IVF
+func isRecordable(codec webrtc.RTPCodecParameters) bool {
+	return strings.EqualFold(codec.MimeType, webrtc.MimeTypeOpus) || strings.EqualFold(codec.MimeType, webrtc.MimeTypeVP8)
+}
+
+// newTrackRecorder creates the file named after the publisher and the track in the directory
+func newTrackRecorder(dir string, track *UpTrack) (recorder *trackRecorder, err error) {
+	codec := track.Codec()
+	fileName := filepath.Join(dir, track.publisher.Id+"-"+track.Kind().String()+"-"+strconv.FormatUint(uint64(track.SSRC()), 10))
+	var writer recordWriter
+	var depacketizer rtp.Depacketizer
+	switch strings.ToLower(codec.MimeType) {
+	case strings.ToLower(webrtc.MimeTypeOpus):
+		var ogg *oggwriter.OggWriter
+		ogg, err = oggwriter.New(fileName+".ogg", codec.ClockRate, codec.Channels)
+		writer = oggWriter{ogg}
+		depacketizer = &codecs.OpusPacket{}
+	case strings.ToLower(webrtc.MimeTypeVP8):
+		writer, err = newIVFWriter(fileName+".ivf", codec.ClockRate)
+		depacketizer = &codecs.VP8Packet{}
+	default:
+		err = errors.New("recording of " + codec.MimeType + " is not supported")
+	}
+	if err != nil {
+		return
+	}
+	recorder = &trackRecorder{
+		track:   track,
+		packets: make(chan *rtp.Packet, recordQueueSize),
+		done:    make(chan struct{}),
+		builder: samplebuilder.New(recordMaxLate, depacketizer, codec.ClockRate, samplebuilder.WithMaxTimeDelay(recordMaxDelay)),
+		writer:  writer,
+	}
+	go recorder.run()
+	return
+}
+
+// push queues a copy of the packet, the packet is dropped when the writing falls behind
+func (r *trackRecorder) push(packet *rtp.Packet) {
+	select {
+	case r.packets <- packet.Clone():
+	default:
+		log.Debug().Str("peer", r.track.publisher.Id).Msg("Recorder Queue Overflow")
+	}
+}
+
+// run reorders the packets, drops the incomplete samples and writes the complete ones
+func (r *trackRecorder) run() {
+	defer close(r.done)
+	for packet := range r.packets {
+		r.builder.Push(packet)
+		for sample := r.builder.Pop(); sample != nil; sample = r.builder.Pop() {
+			if err := r.writer.WriteSample(sample); err != nil {
+				log.Error().Err(err).Str("peer", r.track.publisher.Id).Msg("Recorder Write")
+			}
+		}
+		if r.writer.NeedsKeyFrame() {
+			r.requestKeyFrame()
+		}
+	}
+	if err := r.writer.Close(); err != nil {
+		log.Error().Err(err).Str("peer", r.track.publisher.Id).Msg("Recorder Close")
+	}
+}
+
+func (r *trackRecorder) requestKeyFrame() {
+	if time.Since(r.lastPLI) < recordPLIInterval {
+		return
+	}
+	r.lastPLI = time.Now()
+	err := r.track.publisher.WriteRTCP([]rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: uint32(r.track.SSRC())}})
+	if err != nil {
+		log.Debug().Err(err).Msg("Recorder PLI")
+	}
+}
+
+// close writes the queued packets and closes the file
+func (r *trackRecorder) close() {
+	close(r.packets)
+	<-r.done
+}
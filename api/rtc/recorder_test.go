@@ -0,0 +1,43 @@
+package rtc
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSession_Recording(t *testing.T) {
+	s := NewSession("session", nil)
+	dir := t.TempDir()
+	if _, err := s.StartRecording(dir); err != nil {
+		t.Fatal(err)
+	}
+	if !s.IsRecording() {
+		t.Fatal("recording not started")
+	}
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 1 {
+		t.Fatal("recording directory not created", entries, err)
+	}
+	if _, err := s.StartRecording(dir); err != RecordingStartedError {
+		t.Fatal("recording started twice", err)
+	}
+	if err := s.StopRecording(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.StopRecording(); err != RecordingStoppedError {
+		t.Fatal("recording stopped twice", err)
+	}
+}
+
+func TestSession_StartRecording_DirectoryFailure(t *testing.T) {
+	s := NewSession("session", nil)
+	file := t.TempDir() + "/file"
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.StartRecording(file); err == nil {
+		t.Fatal("recording started in a file")
+	}
+	if s.IsRecording() {
+		t.Fatal("recording kept after the failure")
+	}
+}
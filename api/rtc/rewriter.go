@@ -0,0 +1,86 @@
+package rtc
+
+import (
+	"time"
+
+	"github.com/pion/rtp"
+)
+
+// a forward jump of the sequence numbers beyond the gap is a restart of the source rather than a loss
+const maxSeqGap = 1000
+
+// rtpRewriter maps the sequence numbers and timestamps of the forwarded sources onto the monotonic space
+// of a down track, so a switch of the source is seamless for the subscriber. A source is switched when
+// the SSRC changes, e.g. a simulcast layer or a new publisher, or when the sequence numbers jump.
+// The losses of a source are kept as gaps, so the subscriber is able to detect them.
+type rtpRewriter struct {
+	clockRate uint32
+	started   bool
+	// current source
+	ssrc uint32
+	// first and highest sequence numbers of the source
+	firstSeq   uint16
+	highestSeq uint16
+	seqOffset  uint16
+	tsOffset   uint32
+	// highest written sequence number, its timestamp and the time it has been written at
+	lastSeq  uint16
+	lastTs   uint32
+	lastTime time.Time
+}
+
+func newRTPRewriter(clockRate uint32) rtpRewriter {
+	return rtpRewriter{clockRate: clockRate}
+}
+
+// rewrite returns the header of the packet in the space of the down track,
+// ok is false if the packet precedes the switch to its source and must be dropped
+func (r *rtpRewriter) rewrite(packet *rtp.Packet, now time.Time) (header rtp.Header, ok bool) {
+	seq := packet.SequenceNumber
+	switch {
+	case !r.started:
+		// the first source keeps its numbering
+		r.started = true
+		r.ssrc = packet.SSRC
+		r.firstSeq = seq
+		r.highestSeq = seq
+	case packet.SSRC != r.ssrc:
+		r.switchSource(packet, now)
+	case isOlderSeq(seq, r.highestSeq):
+		if isOlderSeq(seq, r.firstSeq) {
+			return header, false
+		}
+	case seq-r.highestSeq > maxSeqGap:
+		r.switchSource(packet, now)
+	default:
+		r.highestSeq = seq
+	}
+	header = packet.Header
+	header.SequenceNumber = seq + r.seqOffset
+	header.Timestamp = packet.Timestamp + r.tsOffset
+	if r.lastTime.IsZero() || isOlderSeq(r.lastSeq, header.SequenceNumber) {
+		r.lastSeq = header.SequenceNumber
+		r.lastTs = header.Timestamp
+		r.lastTime = now
+	}
+	return header, true
+}
+
+// switchSource continues the space after the last written packet with the packet of the new source,
+// the timestamp advances by the time elapsed since the last written packet
+func (r *rtpRewriter) switchSource(packet *rtp.Packet, now time.Time) {
+	elapsed := uint32(now.Sub(r.lastTime).Seconds() * float64(r.clockRate))
+	if elapsed == 0 {
+		elapsed = 1
+	}
+	r.ssrc = packet.SSRC
+	r.firstSeq = packet.SequenceNumber
+	r.highestSeq = packet.SequenceNumber
+	r.seqOffset = r.lastSeq + 1 - packet.SequenceNumber
+	r.tsOffset = r.lastTs + elapsed - packet.Timestamp
+}
+
+// isOlderSeq reports whether the sequence number precedes the other one, the wrap around is respected
+func isOlderSeq(seq, other uint16) bool {
+	return seq != other && other-seq < 0x8000
+}
@@ -0,0 +1,124 @@
+package rtc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pion/rtp"
+)
+
+const testClockRate = 90000
+
+func testPacket(ssrc uint32, seq uint16, ts uint32) *rtp.Packet {
+	return &rtp.Packet{Header: rtp.Header{Version: 2, SSRC: ssrc, SequenceNumber: seq, Timestamp: ts}}
+}
+
+// rewriteAll rewrites the packets sent every 20 ms starting at the time
+func rewriteAll(t *testing.T, r *rtpRewriter, start time.Time, packets []*rtp.Packet) (headers []rtp.Header) {
+	t.Helper()
+	for i, packet := range packets {
+		header, ok := r.rewrite(packet, start.Add(time.Duration(i)*20*time.Millisecond))
+		if !ok {
+			t.Fatalf("packet %d dropped", packet.SequenceNumber)
+		}
+		headers = append(headers, header)
+	}
+	return
+}
+
+func TestRTPRewriter_FirstSourceKept(t *testing.T) {
+	r := newRTPRewriter(testClockRate)
+	var packets []*rtp.Packet
+	for i := 0; i < 10; i++ {
+		packets = append(packets, testPacket(1, uint16(100+i), uint32(5000+i*1800)))
+	}
+	for i, header := range rewriteAll(t, &r, time.Now(), packets) {
+		if header.SequenceNumber != packets[i].SequenceNumber || header.Timestamp != packets[i].Timestamp {
+			t.Fatalf("packet %d rewritten to %d/%d", i, header.SequenceNumber, header.Timestamp)
+		}
+	}
+}
+
+func TestRTPRewriter_GapKept(t *testing.T) {
+	r := newRTPRewriter(testClockRate)
+	headers := rewriteAll(t, &r, time.Now(), []*rtp.Packet{
+		testPacket(1, 100, 0), testPacket(1, 101, 1800), testPacket(1, 105, 9000),
+	})
+	if headers[2].SequenceNumber != 105 {
+		t.Fatal("gap not kept", headers[2].SequenceNumber)
+	}
+}
+
+func TestRTPRewriter_SourceSwitch(t *testing.T) {
+	r := newRTPRewriter(testClockRate)
+	start := time.Now()
+	rewriteAll(t, &r, start, []*rtp.Packet{testPacket(1, 100, 5000), testPacket(1, 101, 6800)})
+
+	// the second source starts 100 ms after the last packet of the first one
+	now := start.Add(120 * time.Millisecond)
+	header, ok := r.rewrite(testPacket(2, 40000, 123456), now)
+	if !ok {
+		t.Fatal("first packet of the new source dropped")
+	}
+	if header.SequenceNumber != 102 {
+		t.Fatal("unexpected sequence number", header.SequenceNumber)
+	}
+	if header.Timestamp != 6800+testClockRate/10 {
+		t.Fatal("unexpected timestamp", header.Timestamp)
+	}
+	header, _ = r.rewrite(testPacket(2, 40001, 125256), now.Add(20*time.Millisecond))
+	if header.SequenceNumber != 103 || header.Timestamp != 6800+testClockRate/10+1800 {
+		t.Fatal("unexpected continuation", header.SequenceNumber, header.Timestamp)
+	}
+	// a late packet of the new source preceding the switch would collide with the first source
+	if _, ok = r.rewrite(testPacket(2, 39999, 121656), now.Add(40*time.Millisecond)); ok {
+		t.Fatal("packet preceding the switch forwarded")
+	}
+}
+
+func TestRTPRewriter_Reordered(t *testing.T) {
+	r := newRTPRewriter(testClockRate)
+	start := time.Now()
+	rewriteAll(t, &r, start, []*rtp.Packet{testPacket(1, 10, 0), testPacket(2, 500, 0), testPacket(2, 502, 3600)})
+	header, ok := r.rewrite(testPacket(2, 501, 1800), start.Add(time.Second))
+	if !ok {
+		t.Fatal("reordered packet dropped")
+	}
+	if header.SequenceNumber != 12 {
+		t.Fatal("unexpected sequence number", header.SequenceNumber)
+	}
+	// the reordered packet does not move the space back
+	header, _ = r.rewrite(testPacket(2, 503, 5400), start.Add(time.Second))
+	if header.SequenceNumber != 14 {
+		t.Fatal("unexpected sequence number", header.SequenceNumber)
+	}
+}
+
+func TestRTPRewriter_Restart(t *testing.T) {
+	r := newRTPRewriter(testClockRate)
+	start := time.Now()
+	rewriteAll(t, &r, start, []*rtp.Packet{testPacket(1, 200, 1000), testPacket(1, 201, 2800)})
+	// the publisher restarts the stream with the same SSRC
+	header, ok := r.rewrite(testPacket(1, 30000, 900000), start.Add(time.Second))
+	if !ok || header.SequenceNumber != 202 {
+		t.Fatal("restart not continued", header.SequenceNumber)
+	}
+	if header.Timestamp <= 2800 {
+		t.Fatal("timestamp moved back", header.Timestamp)
+	}
+}
+
+func TestRTPRewriter_WrapAround(t *testing.T) {
+	r := newRTPRewriter(testClockRate)
+	start := time.Now()
+	headers := rewriteAll(t, &r, start, []*rtp.Packet{
+		testPacket(1, 65534, 4294965000), testPacket(1, 65535, 4294966800), testPacket(1, 0, 1304), testPacket(1, 1, 3104),
+	})
+	if headers[2].SequenceNumber != 0 || headers[3].SequenceNumber != 1 {
+		t.Fatal("wrap around not kept", headers[2].SequenceNumber, headers[3].SequenceNumber)
+	}
+	header, ok := r.rewrite(testPacket(2, 65000, 0), start.Add(time.Second))
+	if !ok || header.SequenceNumber != 2 {
+		t.Fatal("switch after wrap around", header.SequenceNumber)
+	}
+}
@@ -1,58 +1,171 @@
 package rtc
 
 import (
-	"io"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/pion/rtcp"
 	"github.com/pion/rtp"
-	"github.com/pion/webrtc/v2"
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v3"
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	SessionClosedError = errors.New("session has been closed")
+	// GlareError is returned when the peer offer collides with the pending offer of the session,
+	// the session offer takes precedence and the peer is expected to roll back, the browser recognizes it by its message
+	GlareError            = errors.New("negotiation glare; the session offer is pending")
+	RecordingStartedError = errors.New("session is already being recorded")
+	RecordingStoppedError = errors.New("session is not being recorded")
+	SessionLockedError    = errors.New("session is locked")
+	NotRecordableError    = errors.New("no track of the session can be recorded")
+)
+
 type Session struct {
-	Id            string
-	peer          Peer
-	connectedPeer Peer
-	stop          int32
+	Id        string
+	signaling Signaling
+	mutex     sync.RWMutex
+	peers     map[string]*Peer
+	upTracks  []*UpTrack
+	// codecs of the tracks chosen by the first participant offering the kind
+	codecs map[webrtc.RTPCodecType]webrtc.RTPCodecCapability
+	// directory of the current recording, empty when the session is not recorded
+	recordDir string
+	// id of the participant speaking the most recently, the participants are notified about its changes
+	dominantSpeaker string
+	// the dominant speaker detection has been started, updated atomically
+	speakerDetection int32
+	// id of the participant moderating the session, the owner initially
+	moderator string
+	// locked session refuses new participants except the moderator
+	locked bool
+	// unix time in nanoseconds of the last media or signaling activity
+	lastActivity int64
+	// unix time in nanoseconds of the last call of the activity handler
+	activityNotified int64
+	activityInterval time.Duration
+	onActivity       func()
+	stop             int32
 }
 
-func NewSession(id string, desc *webrtc.SessionDescription) (session *Session, answer webrtc.SessionDescription, err error) {
-	peer, err := NewPeer()
-	if err != nil {
-		log.Error().Err(err).Msg("Session New Peer")
+func NewSession(id string, signaling Signaling) *Session {
+	return &Session{
+		Id:           id,
+		signaling:    signaling,
+		moderator:    id,
+		peers:        make(map[string]*Peer),
+		codecs:       make(map[webrtc.RTPCodecType]webrtc.RTPCodecCapability),
+		lastActivity: time.Now().UnixNano(),
+	}
+}
+
+// OnActivity sets the handler called on media activity of the session at most once per interval,
+// it should be set before the session is joined
+func (s *Session) OnActivity(interval time.Duration, f func()) {
+	s.activityInterval = interval
+	s.onActivity = f
+}
+
+// Touch records the activity of the session, e.g. a signaling message
+func (s *Session) Touch() {
+	atomic.StoreInt64(&s.lastActivity, time.Now().UnixNano())
+}
+
+// LastActivity returns the time of the last media or signaling activity
+func (s *Session) LastActivity() time.Time {
+	return time.Unix(0, atomic.LoadInt64(&s.lastActivity))
+}
+
+// touchMedia records the media activity and calls the activity handler if the interval has passed
+func (s *Session) touchMedia() {
+	now := time.Now().UnixNano()
+	atomic.StoreInt64(&s.lastActivity, now)
+	if s.onActivity == nil {
 		return
 	}
-	// Allow to send/receive audio track
-	err = peer.AddAudioTrack()
-	if err != nil {
-		log.Error().Err(err).Msg("Session Add Audio Track")
+	notified := atomic.LoadInt64(&s.activityNotified)
+	if now-notified >= int64(s.activityInterval) && atomic.CompareAndSwapInt64(&s.activityNotified, notified, now) {
+		s.onActivity()
+	}
+}
+
+// Join adds a new participant to the session, every published track is forwarded to the participant
+func (s *Session) Join(peerId string, desc *webrtc.SessionDescription) (answer webrtc.SessionDescription, err error) {
+	if s.IsStopped() {
+		err = SessionClosedError
 		return
 	}
-	// Allow to send/receive video track
-	err = peer.AddVideoTrack()
+	offer, err := desc.Unmarshal()
 	if err != nil {
-		log.Error().Err(err).Msg("Session Add Video Track")
 		return
 	}
-	audioTrack, err := newAudioTrack()
+	peer, err := NewPeer(peerId)
 	if err != nil {
-		log.Error().Err(err).Msg("Session New Audio Track")
+		log.Error().Err(err).Msg("Session New Peer")
 		return
 	}
-	videoTrack, err := newVideoTrack()
+	s.mutex.Lock()
+	if _, ok := s.peers[peerId]; ok {
+		s.mutex.Unlock()
+		_ = peer.Close()
+		err = errors.New("peer '" + peerId + "' has already joined")
+		return
+	}
+	if s.locked && peerId != s.moderator {
+		s.mutex.Unlock()
+		_ = peer.Close()
+		err = SessionLockedError
+		return
+	}
+	codecs, err := s.negotiateCodecs(offer)
 	if err != nil {
-		log.Error().Err(err).Msg("Session New Video Track")
+		s.mutex.Unlock()
+		_ = peer.Close()
 		return
 	}
-	session = &Session{Id: id, peer: peer, connectedPeer: Peer{nil, audioTrack, videoTrack}, stop: 0}
+	// An offer refused for one kind does not choose the codec of the other one. The codecs are
+	// chosen before the participant is negotiated so that a concurrent join cannot choose others,
+	// they are released by the leaving of the participant if the join fails
+	for kind, codec := range codecs {
+		s.codecs[kind] = codec
+	}
+	peer.codecs = codecs
+	s.peers[peerId] = peer
+	for _, upTrack := range s.upTracks {
+		s.subscribe(peer, upTrack)
+	}
+	s.mutex.Unlock()
+	defer func() {
+		if err != nil {
+			s.Leave(peerId)
+		}
+	}()
+	peer.OnTrack(func(remoteTrack *webrtc.TrackRemote, receiver *webrtc.RTPReceiver) {
+		s.publish(peer, remoteTrack, receiver)
+	})
+	// Trickle local candidates to the participant as they are gathered
+	peer.OnICECandidate(func(candidate *webrtc.ICECandidate) {
+		if candidate != nil {
+			s.sendCandidate(peerId, candidate.ToJSON())
+		}
+	})
 	// Set the remote SessionDescription
 	err = peer.SetRemoteDescription(*desc)
 	if err != nil {
 		log.Error().Err(err).Msg("Session Set Remote Description")
 		return
 	}
+	// The tracks published by the participant are received with the codecs of the session
+	err = peer.preferCodecs(codecs)
+	if err != nil {
+		log.Error().Err(err).Msg("Session Prefer Codecs")
+		return
+	}
 	// Create answer
 	answer, err = peer.CreateAnswer(nil)
 	if err != nil {
@@ -65,71 +178,239 @@ func NewSession(id string, desc *webrtc.SessionDescription) (session *Session, a
 		log.Error().Err(err).Msg("Session Set Local Description")
 		return
 	}
-	session.peer.OnTrack(func(remoteTrack *webrtc.Track, receiver *webrtc.RTPReceiver) {
-		switch remoteTrack.Kind() {
-		case webrtc.RTPCodecTypeVideo:
-			go session.initiatePLI(remoteTrack.SSRC(), session.peer)
-			go session.transmit(session.connectedPeer.videoTrack, remoteTrack)
-		case webrtc.RTPCodecTypeAudio:
-			go session.transmit(session.connectedPeer.audioTrack, remoteTrack)
-		}
+	// Tracks added or removed from now on are negotiated by the session
+	peer.OnNegotiationNeeded(func() {
+		go s.renegotiate(peer)
 	})
 	return
 }
 
-func (s *Session) Connect(desc *webrtc.SessionDescription) (answer webrtc.SessionDescription, err error) {
-	err = s.connectedPeer.InitPeer()
+// negotiateCodecs returns the codecs of the session supported by the offer, the session must be locked.
+// The codec of a kind is chosen by the first offer with a media section of the kind
+// in the order of the server preference, the later offers must support it. A kind neither offered
+// nor chosen yet is missing. The codecs are not chosen for the session until the participant has joined
+func (s *Session) negotiateCodecs(offer *sdp.SessionDescription) (codecs map[webrtc.RTPCodecType]webrtc.RTPCodecCapability, err error) {
+	api := API()
+	codecs = make(map[webrtc.RTPCodecType]webrtc.RTPCodecCapability)
+	for _, kind := range []webrtc.RTPCodecType{webrtc.RTPCodecTypeAudio, webrtc.RTPCodecTypeVideo} {
+		candidates := api.audioCodecs
+		if kind == webrtc.RTPCodecTypeVideo {
+			candidates = api.videoCodecs
+		}
+		chosen, isChosen := s.codecs[kind]
+		if isChosen {
+			candidates = []webrtc.RTPCodecParameters{{RTPCodecCapability: chosen}}
+		}
+		offered, ok := offeredCodecs(offer, kind)
+		if !ok {
+			// the track is not negotiated until the participant offers the kind
+			if isChosen {
+				codecs[kind] = chosen
+			}
+			continue
+		}
+		codec, ok := negotiateCodec(candidates, offered)
+		if !ok {
+			err = noCommonCodecError(kind, candidates)
+			return nil, err
+		}
+		codecs[kind] = codec
+	}
+	return codecs, nil
+}
+
+// releaseCodecs forgets the codecs negotiated with none of the participants, the session must be locked.
+// The next participant offering the kind chooses its codec, e.g. in an empty session
+func (s *Session) releaseCodecs() {
+	for kind := range s.codecs {
+		used := false
+		for _, peer := range s.peers {
+			if _, ok := peer.codecs[kind]; ok {
+				used = true
+				break
+			}
+		}
+		if !used {
+			delete(s.codecs, kind)
+		}
+	}
+}
+
+// Negotiate applies the offer initiated by the peer and returns the answer
+func (s *Session) Negotiate(peerId string, desc *webrtc.SessionDescription) (answer webrtc.SessionDescription, err error) {
+	peer, err := s.getPeer(peerId)
 	if err != nil {
-		log.Error().Err(err).Msg("Session Init Peer")
 		return
 	}
-	err = s.connectedPeer.SetRemoteDescription(*desc)
+	peer.negotiation.Lock()
+	defer peer.negotiation.Unlock()
+	if peer.SignalingState() == webrtc.SignalingStateHaveLocalOffer {
+		err = GlareError
+		return
+	}
+	err = peer.SetRemoteDescription(*desc)
 	if err != nil {
-		log.Error().Err(err).Msg("Session Set Remote Description")
+		log.Error().Err(err).Msg("Session Negotiate; Set Remote Description")
 		return
 	}
-	answer, err = s.connectedPeer.CreateAnswer(nil)
+	// The tracks added by the offer are received with the codecs of the session too
+	s.mutex.RLock()
+	err = peer.preferCodecs(s.codecs)
+	s.mutex.RUnlock()
 	if err != nil {
-		log.Error().Err(err).Msg("Session Create Answer")
+		log.Error().Err(err).Msg("Session Negotiate; Prefer Codecs")
 		return
 	}
-	err = s.connectedPeer.SetLocalDescription(answer)
+	answer, err = peer.CreateAnswer(nil)
 	if err != nil {
-		log.Error().Err(err).Msg("Session Set Local Description")
+		log.Error().Err(err).Msg("Session Negotiate; Create Answer")
 		return
 	}
-	s.connectedPeer.OnTrack(func(remoteTrack *webrtc.Track, receiver *webrtc.RTPReceiver) {
-		switch remoteTrack.Kind() {
-		case webrtc.RTPCodecTypeVideo:
-			go s.initiatePLI(remoteTrack.SSRC(), s.connectedPeer)
-			go s.transmit(s.peer.videoTrack, remoteTrack)
-		case webrtc.RTPCodecTypeAudio:
-			go s.transmit(s.peer.audioTrack, remoteTrack)
-		}
-	})
+	err = peer.SetLocalDescription(answer)
+	if err != nil {
+		log.Error().Err(err).Msg("Session Negotiate; Set Local Description")
+	}
 	return
 }
 
-func (s *Session) IsConnected() bool {
-	return s.connectedPeer.PeerConnection != nil
+// Answer applies the answer of the peer to the offer sent by the session
+func (s *Session) Answer(peerId string, desc *webrtc.SessionDescription) error {
+	peer, err := s.getPeer(peerId)
+	if err != nil {
+		return err
+	}
+	peer.negotiation.Lock()
+	defer peer.negotiation.Unlock()
+	return peer.SetRemoteDescription(*desc)
 }
 
-func (s *Session) Leave() {
-	err := s.connectedPeer.Close()
+// renegotiate sends a new offer to the peer when the set of forwarded tracks has been changed
+func (s *Session) renegotiate(peer *Peer) {
+	if s.IsStopped() || s.signaling == nil {
+		return
+	}
+	peer.negotiation.Lock()
+	defer peer.negotiation.Unlock()
+	// Negotiation needed is fired again once the signaling state is stable
+	if peer.SignalingState() != webrtc.SignalingStateStable {
+		return
+	}
+	offer, err := peer.CreateOffer(nil)
 	if err != nil {
-		log.Debug().Err(err).Msg("Session Leave; Connected Peer Close")
+		log.Error().Err(err).Msg("Session Renegotiate; Create Offer")
+		s.signaling.SendError(peer.Id, err)
+		return
 	}
+	err = peer.SetLocalDescription(offer)
+	if err != nil {
+		log.Error().Err(err).Msg("Session Renegotiate; Set Local Description")
+		s.signaling.SendError(peer.Id, err)
+		return
+	}
+	s.signaling.SendOffer(peer.Id, NewSessionDesc(offer))
 }
 
-func (s *Session) Close() {
-	atomic.StoreInt32(&s.stop, 1)
-	err := s.connectedPeer.Close()
+func (s *Session) sendError(peerId string, err error) {
+	if s.signaling != nil {
+		s.signaling.SendError(peerId, err)
+	}
+}
+
+func (s *Session) getPeer(peerId string) (*Peer, error) {
+	s.mutex.RLock()
+	peer, ok := s.peers[peerId]
+	s.mutex.RUnlock()
+	if !ok {
+		return nil, errors.New("peer '" + peerId + "' has not joined")
+	}
+	return peer, nil
+}
+
+// AddICECandidate adds the remote candidate trickled by the participant
+func (s *Session) AddICECandidate(peerId string, candidate IceCandidate) error {
+	peer, err := s.getPeer(peerId)
 	if err != nil {
-		log.Debug().Err(err).Msg("Session Close; Connected Peer Close")
+		return err
+	}
+	return peer.AddICECandidate(webrtc.ICECandidateInit{
+		Candidate:     candidate.Candidate,
+		SDPMid:        &candidate.SdpMid,
+		SDPMLineIndex: &candidate.SdpMLineIndex,
+	})
+}
+
+func (s *Session) sendCandidate(peerId string, candidate webrtc.ICECandidateInit) {
+	if s.signaling == nil {
+		return
+	}
+	c := IceCandidate{Candidate: candidate.Candidate}
+	if candidate.SDPMid != nil {
+		c.SdpMid = *candidate.SDPMid
 	}
-	err = s.peer.Close()
+	if candidate.SDPMLineIndex != nil {
+		c.SdpMLineIndex = *candidate.SDPMLineIndex
+	}
+	s.signaling.SendCandidate(peerId, c)
+}
+
+// Leave removes the participant from the session, the session itself stays alive
+func (s *Session) Leave(peerId string) {
+	s.mutex.Lock()
+	peer, ok := s.peers[peerId]
+	if !ok {
+		s.mutex.Unlock()
+		return
+	}
+	delete(s.peers, peerId)
+	if s.dominantSpeaker == peerId {
+		s.dominantSpeaker = ""
+	}
+	for _, track := range peer.downTracks {
+		if source := track.boundSource(); source != nil {
+			source.removeDownTrack(track)
+			track.unbind()
+		}
+	}
+	upTracks := s.upTracks[:0]
+	for _, upTrack := range s.upTracks {
+		if upTrack.publisher == peer {
+			s.unsubscribe(upTrack)
+		} else {
+			upTracks = append(upTracks, upTrack)
+		}
+	}
+	s.upTracks = upTracks
+	s.releaseCodecs()
+	s.mutex.Unlock()
+	err := peer.Close()
 	if err != nil {
-		log.Debug().Err(err).Msg("Session Close; Peer Close")
+		log.Debug().Err(err).Msg("Session Leave; Peer Close")
+	}
+}
+
+func (s *Session) Close() {
+	atomic.StoreInt32(&s.stop, 1)
+	s.mutex.Lock()
+	peers := s.peers
+	s.peers = make(map[string]*Peer)
+	recorders := make([]*trackRecorder, 0, len(s.upTracks))
+	for _, upTrack := range s.upTracks {
+		upTrack.Close()
+		if recorder := upTrack.detachRecorder(); recorder != nil {
+			recorders = append(recorders, recorder)
+		}
+	}
+	s.upTracks = nil
+	s.recordDir = ""
+	s.mutex.Unlock()
+	for _, recorder := range recorders {
+		recorder.close()
+	}
+	for _, peer := range peers {
+		err := peer.Close()
+		if err != nil {
+			log.Debug().Err(err).Msg("Session Close; Peer Close")
+		}
 	}
 }
 
@@ -137,13 +418,265 @@ func (s *Session) IsStopped() bool {
 	return atomic.LoadInt32(&s.stop) != 0
 }
 
-func (s *Session) initiatePLI(ssrc uint32, peer Peer) {
-	// Send a PLI on an interval so that the publisher is pushing a keyframe every 5 secs
-	ticker := time.NewTicker(time.Second * 5)
-	pliPkt := []rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: ssrc}}
-	for !s.IsStopped() {
+// PeerIds returns ids of the participants
+func (s *Session) PeerIds() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	ids := make([]string, 0, len(s.peers))
+	for id := range s.peers {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// HasPeer reports whether the participant has joined the session
+func (s *Session) HasPeer(peerId string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	_, ok := s.peers[peerId]
+	return ok
+}
+
+// StartRecording records every published track into its own file in a new subdirectory of the directory,
+// the tracks published later are recorded as well. The tracks of the codecs which cannot be recorded are skipped
+// and returned, the recording is refused if no published track can be recorded
+func (s *Session) StartRecording(dir string) (skipped []string, err error) {
+	if s.IsStopped() {
+		return nil, SessionClosedError
+	}
+	s.mutex.Lock()
+	if s.recordDir != "" {
+		s.mutex.Unlock()
+		return nil, RecordingStartedError
+	}
+	for _, upTrack := range s.upTracks {
+		if !isRecordable(upTrack.Codec()) {
+			skipped = append(skipped, upTrack.publisher.Id+" "+upTrack.Codec().MimeType)
+		}
+	}
+	if len(s.upTracks) > 0 && len(skipped) == len(s.upTracks) {
+		s.mutex.Unlock()
+		return skipped, NotRecordableError
+	}
+	// the directory is reserved under the lock, the files are created outside of it
+	recordDir := filepath.Join(dir, s.Id+"-"+time.Now().Format("20060102-150405"))
+	s.recordDir = recordDir
+	upTracks := make([]*UpTrack, len(s.upTracks))
+	copy(upTracks, s.upTracks)
+	s.mutex.Unlock()
+
+	if err = os.MkdirAll(recordDir, 0755); err != nil {
+		s.mutex.Lock()
+		if s.recordDir == recordDir {
+			s.recordDir = ""
+		}
+		s.mutex.Unlock()
+		return nil, err
+	}
+	for _, upTrack := range upTracks {
+		recorder := newRecorder(recordDir, upTrack)
+		if recorder == nil {
+			continue
+		}
+		s.mutex.RLock()
+		// the recording may have been stopped or the track unpublished meanwhile
+		attached := s.recordDir == recordDir && s.hasUpTrack(upTrack) && upTrack.startRecording(recorder)
+		s.mutex.RUnlock()
+		if !attached {
+			recorder.close()
+		}
+	}
+	return skipped, nil
+}
+
+// StopRecording stops the recording and closes the files
+func (s *Session) StopRecording() error {
+	s.mutex.Lock()
+	if s.recordDir == "" {
+		s.mutex.Unlock()
+		return RecordingStoppedError
+	}
+	s.recordDir = ""
+	recorders := make([]*trackRecorder, 0, len(s.upTracks))
+	for _, upTrack := range s.upTracks {
+		if recorder := upTrack.detachRecorder(); recorder != nil {
+			recorders = append(recorders, recorder)
+		}
+	}
+	s.mutex.Unlock()
+	// the queued packets are written outside of the lock
+	for _, recorder := range recorders {
+		recorder.close()
+	}
+	return nil
+}
+
+// IsRecording reports whether the session is being recorded
+func (s *Session) IsRecording() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.recordDir != ""
+}
+
+// record starts recording of the up track, the session must be locked
+func (s *Session) record(upTrack *UpTrack) {
+	if recorder := newRecorder(s.recordDir, upTrack); recorder != nil {
+		upTrack.startRecording(recorder)
+	}
+}
+
+// hasUpTrack reports whether the up track is published, the session must be locked
+func (s *Session) hasUpTrack(upTrack *UpTrack) bool {
+	for _, t := range s.upTracks {
+		if t == upTrack {
+			return true
+		}
+	}
+	return false
+}
+
+// newRecorder creates the recorder of the up track in the directory, nil if the track cannot be recorded
+func newRecorder(dir string, upTrack *UpTrack) *trackRecorder {
+	if !isRecordable(upTrack.Codec()) {
+		log.Warn().Str("peer", upTrack.publisher.Id).Str("codec", upTrack.Codec().MimeType).Msg("Session Record; Not Recordable")
+		return nil
+	}
+	recorder, err := newTrackRecorder(dir, upTrack)
+	if err != nil {
+		log.Error().Err(err).Msg("Session Record")
+		return nil
+	}
+	return recorder
+}
+
+// SetVideoSize sets the dimensions of the tile the peer renders the forwarded track in,
+// the layer of a simulcast track is chosen to fit the tile
+func (s *Session) SetVideoSize(peerId string, trackId string, width, height int) error {
+	peer, err := s.getPeer(peerId)
+	if err != nil {
+		return err
+	}
+	s.mutex.RLock()
+	track := peer.downTrack(trackId)
+	var source *UpTrack
+	if track != nil {
+		source = track.boundSource()
+	}
+	s.mutex.RUnlock()
+	if track == nil {
+		return errors.New("track '" + trackId + "' is not forwarded to the peer")
+	}
+	track.SetVideoSize(width, height)
+	if source != nil {
+		source.reselectLayer(track)
+	}
+	return nil
+}
+
+// Size returns the number of participants
+func (s *Session) Size() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return len(s.peers)
+}
+
+// publish starts forwarding of the track received from the peer to every other participant,
+// the encodings of a simulcast track are received as separate tracks and are added as layers
+func (s *Session) publish(publisher *Peer, remoteTrack *webrtc.TrackRemote, receiver *webrtc.RTPReceiver) {
+	s.mutex.Lock()
+	if s.peers[publisher.Id] != publisher {
+		s.mutex.Unlock()
+		return
+	}
+	for _, upTrack := range s.upTracks {
+		if upTrack.isLayerOf(publisher, remoteTrack) {
+			l := upTrack.addLayer(remoteTrack, receiver)
+			s.mutex.Unlock()
+			s.transmit(upTrack, l)
+			return
+		}
+	}
+	upTrack := newUpTrack(remoteTrack, receiver, publisher)
+	s.upTracks = append(s.upTracks, upTrack)
+	for _, peer := range s.peers {
+		if peer != publisher {
+			s.subscribe(peer, upTrack)
+		}
+	}
+	if s.recordDir != "" {
+		s.record(upTrack)
+	}
+	// the layers are added concurrently by the next encodings
+	first := upTrack.layers[0]
+	s.mutex.Unlock()
+	if remoteTrack.Kind() == webrtc.RTPCodecTypeVideo {
+		if API().settings.PLIInterval > 0 {
+			go s.initiatePLI(upTrack)
+		}
+		go s.selectLayers(upTrack)
+	}
+	if upTrack.speaking != nil && atomic.CompareAndSwapInt32(&s.speakerDetection, 0, 1) {
+		go s.detectDominantSpeaker()
+	}
+	s.transmit(upTrack, first)
+	s.unpublish(upTrack)
+}
+
+func (s *Session) unpublish(upTrack *UpTrack) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i, t := range s.upTracks {
+		if t == upTrack {
+			s.upTracks = append(s.upTracks[:i], s.upTracks[i+1:]...)
+			s.unsubscribe(upTrack)
+			break
+		}
+	}
+}
+
+// subscribe adds a down track of the up track to the peer, the session must be locked.
+// The down track belongs to the stream of the publisher, so it is never reused for another publisher
+func (s *Session) subscribe(peer *Peer, upTrack *UpTrack) {
+	track, err := peer.addNewTrack(upTrack.Codec().RTPCodecCapability, upTrack.streamId())
+	if err != nil {
+		log.Error().Err(err).Msg("Session Subscribe; Add Track")
+		s.sendError(peer.Id, err)
+		return
+	}
+	// the peer is bound to the codec by the renegotiation of the track
+	peer.codecs[upTrack.Kind()] = upTrack.Codec().RTPCodecCapability
+	track.bind(upTrack)
+}
+
+// unsubscribe removes all down tracks bound to the up track, the session must be locked;
+// the subscribers are notified about the removed tracks by the renegotiation
+func (s *Session) unsubscribe(upTrack *UpTrack) {
+	upTrack.Close()
+	upTrack.stopRecording()
+	for _, track := range upTrack.detach() {
+		track.unbind()
+		err := track.subscriber.removeTrack(track)
+		if err != nil {
+			log.Debug().Err(err).Msg("Session Unsubscribe; Remove Track")
+		}
+	}
+}
+
+// initiatePLI requests keyframes of the track on an interval as a fallback of the requests driven by the subscribers
+func (s *Session) initiatePLI(upTrack *UpTrack) {
+	// Send a PLI on an interval so that the publisher is pushing a keyframe regularly
+	ticker := time.NewTicker(API().settings.PLIInterval)
+	for !s.IsStopped() && !upTrack.IsStopped() {
 		_ = <-ticker.C
-		err := peer.WriteRTCP(pliPkt)
+		if !upTrack.hasSubscribers() {
+			continue
+		}
+		// every layer of a simulcast track
+		var pliPkt []rtcp.Packet
+		for _, ssrc := range upTrack.ssrcs() {
+			pliPkt = append(pliPkt, &rtcp.PictureLossIndication{MediaSSRC: uint32(ssrc)})
+		}
+		err := upTrack.publisher.WriteRTCP(pliPkt)
 		if err != nil {
 			log.Debug().Err(err).Msg("Session initiate PLI")
 		}
@@ -151,31 +684,65 @@ func (s *Session) initiatePLI(ssrc uint32, peer Peer) {
 	ticker.Stop()
 }
 
-func ReadRTP(track *webrtc.Track, bytes []byte, packet *rtp.Packet) (err error) {
-	n, err := track.Read(bytes)
+func ReadRTP(track *webrtc.TrackRemote, bytes []byte, packet *rtp.Packet) (err error) {
+	n, _, err := track.Read(bytes)
 	if err != nil {
 		return
 	}
-	packet.ExtensionPayload = nil
 	err = packet.Unmarshal(bytes[:n])
 	return
 }
 
-func (s *Session) transmit(track *webrtc.Track, remoteTrack *webrtc.Track) {
-	bytes := make([]byte, 1460)
+// selectLayers chooses the layers forwarded to the subscribers of a simulcast track on an interval,
+// the publisher is sent the bandwidth available to the subscribers
+func (s *Session) selectLayers(upTrack *UpTrack) {
+	ticker := time.NewTicker(layerSelectionInterval)
+	defer ticker.Stop()
+	last := time.Now()
+	for !s.IsStopped() && !upTrack.IsStopped() {
+		now := <-ticker.C
+		upTrack.selectLayers(now.Sub(last))
+		upTrack.sendBandwidthEstimate()
+		last = now
+	}
+}
+
+// transmit forwards the packets of the layer until the track ends, the lost packets are requested from the publisher
+// and a keyframe is requested when the loss cannot be recovered; the audio level updates the speaking score.
+// Nothing is forwarded while the publisher is muted.
+func (s *Session) transmit(upTrack *UpTrack, l *layer) {
+	bytes := make([]byte, API().settings.RTPBufferSize)
 	packet := rtp.Packet{}
-	for !s.IsStopped() {
-		err := ReadRTP(remoteTrack, bytes, &packet)
+	var nacks *nackGenerator
+	if supportsNack(l.Codec().RTPCodecCapability) {
+		nacks = newNackGenerator()
+	}
+	for !s.IsStopped() && !upTrack.IsStopped() {
+		err := ReadRTP(l.TrackRemote, bytes, &packet)
 		if err != nil {
-			log.Error().Err(err).Msg("Session Track Read")
+			log.Debug().Err(err).Msg("Session Track Read")
 			break
 		}
-		packet.SSRC = track.SSRC()
-		err = track.WriteRTP(&packet)
-		// ErrClosedPipe means we don't have any subscribers, this is ok if no peers have connected yet
-		if err != nil && err != io.ErrClosedPipe {
-			log.Error().Err(err).Msg("Session Track Write")
-			break
+		s.touchMedia()
+		atomic.AddUint64(&l.bytes, uint64(len(packet.Payload)))
+		// the packets of a muted publisher are dropped
+		if upTrack.publisher.isMuted(upTrack.Kind()) {
+			continue
+		}
+		if upTrack.speaking != nil {
+			upTrack.speaking.push(&packet, time.Now())
+		}
+		if nacks != nil {
+			now := time.Now()
+			nacks.push(packet.SequenceNumber, now)
+			seqs, lost := nacks.pending(now)
+			if len(seqs) > 0 {
+				upTrack.requestPackets(l, seqs)
+			}
+			if lost && upTrack.hasSubscribers() {
+				upTrack.requestKeyFrame(l)
+			}
 		}
+		upTrack.forward(l, &packet)
 	}
 }
@@ -1,6 +1,12 @@
 package rtc
 
+import "github.com/pion/webrtc/v3"
+
 type SessionDesc struct {
 	Type string
 	Sdp  string
 }
+
+func NewSessionDesc(desc webrtc.SessionDescription) SessionDesc {
+	return SessionDesc{Type: desc.Type.String(), Sdp: desc.SDP}
+}
@@ -0,0 +1,20 @@
+package rtc
+
+// Signaling delivers messages initiated by a session to its participants
+type Signaling interface {
+	// SendCandidate sends the local ICE candidate gathered for the peer
+	SendCandidate(peerId string, candidate IceCandidate)
+	// SendOffer sends the offer of the session initiated renegotiation, the peer is expected to reply with an answer
+	SendOffer(peerId string, offer SessionDesc)
+	// SendError notifies the peer about an error which has occurred out of the peer request
+	SendError(peerId string, err error)
+	// SendDominantSpeaker notifies the peer about the new dominant speaker of the session,
+	// the stream id identifies the stream the peer receives the speaker audio in, empty for the speaker
+	SendDominantSpeaker(peerId string, speakerId string, streamId string)
+}
+
+type IceCandidate struct {
+	Candidate     string
+	SdpMid        string
+	SdpMLineIndex uint16
+}
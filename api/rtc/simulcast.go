@@ -0,0 +1,135 @@
+package rtc
+
+import (
+	"cmp"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/pion/webrtc/v3"
+)
+
+const (
+	// interval of the layer bitrate measurement and of the layer selection
+	layerSelectionInterval = time.Second
+	// maximal tile dimensions served by the lower layers, the layers are expected to be scaled down by two
+	lowLayerMaxSize    = 320
+	middleLayerMaxSize = 640
+)
+
+// simulcastRIDs are the encodings sent by the browser from the lowest resolution to the highest one
+var simulcastRIDs = []string{"q", "h", "f"}
+
+// layer is an encoding of a simulcast up track, a plain track has a single layer
+type layer struct {
+	*webrtc.TrackRemote
+	// received payload bytes, updated atomically
+	bytes uint64
+	// bits per second measured by the last layer selection, updated atomically
+	bitrate uint64
+	// unix time in nanoseconds of the last keyframe request, updated atomically
+	keyFrameRequested int64
+	// position of the encoding from the lowest one
+	rank int
+}
+
+func newLayer(remoteTrack *webrtc.TrackRemote, receiver *webrtc.RTPReceiver) *layer {
+	return &layer{TrackRemote: remoteTrack, rank: layerRank(remoteTrack, receiver)}
+}
+
+// layerRank ranks the known RIDs by their resolution, the other encodings follow them
+// in the order of the offer of the publisher
+func layerRank(remoteTrack *webrtc.TrackRemote, receiver *webrtc.RTPReceiver) int {
+	if i := slices.Index(simulcastRIDs, remoteTrack.RID()); i >= 0 {
+		return i
+	}
+	rank := len(simulcastRIDs)
+	if receiver != nil {
+		for _, track := range receiver.Tracks() {
+			if track.RID() == remoteTrack.RID() {
+				break
+			}
+			rank++
+		}
+	}
+	return rank
+}
+
+// measure computes the bitrate of the bytes received since the last measurement
+func (l *layer) measure(elapsed time.Duration) {
+	bytes := atomic.SwapUint64(&l.bytes, 0)
+	atomic.StoreUint64(&l.bitrate, uint64(float64(bytes*8)/elapsed.Seconds()))
+}
+
+func (l *layer) getBitrate() uint64 {
+	return atomic.LoadUint64(&l.bitrate)
+}
+
+// selectLayers measures the bitrates of the layers and chooses the forwarded layer of every down track
+func (t *UpTrack) selectLayers(elapsed time.Duration) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	for _, l := range t.layers {
+		l.measure(elapsed)
+	}
+	if len(t.layers) < 2 {
+		return
+	}
+	ranked := t.rankedLayers()
+	for _, track := range t.downTracks {
+		t.selectLayer(track, ranked)
+	}
+}
+
+// reselectLayer chooses the forwarded layer of the down track after its constraints have been changed
+func (t *UpTrack) reselectLayer(track *DownTrack) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if len(t.layers) > 1 {
+		t.selectLayer(track, t.rankedLayers())
+	}
+}
+
+// rankedLayers returns the layers from the lowest encoding to the highest one, the up track must be locked.
+// The measured bitrate does not rank the layers, an encoding paused by the publisher is measured at zero
+func (t *UpTrack) rankedLayers() []*layer {
+	ranked := slices.Clone(t.layers)
+	slices.SortStableFunc(ranked, func(a, b *layer) int {
+		return cmp.Compare(a.rank, b.rank)
+	})
+	return ranked
+}
+
+// selectLayer chooses the highest layer fitting both the tile size and the bandwidth share of the subscriber,
+// the lowest received layer is forwarded if none fits and the layers not being received are skipped;
+// a keyframe of the new layer is requested to switch quickly
+func (t *UpTrack) selectLayer(track *DownTrack, ranked []*layer) {
+	highest := len(ranked) - 1
+	if width, height := track.videoSize(); width > 0 || height > 0 {
+		size := max(width, height)
+		if size <= lowLayerMaxSize {
+			highest = 0
+		} else if size <= middleLayerMaxSize {
+			highest = min(highest, 1)
+		}
+	}
+	budget := track.subscriber.videoBudget()
+	var selected *layer
+	for i, l := range ranked {
+		bitrate := l.getBitrate()
+		if bitrate == 0 {
+			continue
+		}
+		if selected != nil && (i > highest || budget > 0 && bitrate > budget) {
+			continue
+		}
+		selected = l
+	}
+	if selected == nil {
+		// nothing has been received since the last selection
+		selected = ranked[0]
+	}
+	if track.setTargetLayer(selected) {
+		t.requestKeyFrame(selected)
+	}
+}
@@ -0,0 +1,42 @@
+package rtc
+
+import (
+	"testing"
+)
+
+func TestUpTrack_SelectLayer(t *testing.T) {
+	tests := []struct {
+		name      string
+		bitrates  []uint64
+		bandwidth uint64
+		size      int
+		want      int
+	}{
+		{"highest", []uint64{150_000, 500_000, 1_500_000}, 0, 0, 2},
+		{"bandwidth", []uint64{150_000, 500_000, 1_500_000}, 1_000_000, 0, 1},
+		{"small tile", []uint64{150_000, 500_000, 1_500_000}, 0, 320, 0},
+		{"middle tile", []uint64{150_000, 500_000, 1_500_000}, 0, 480, 1},
+		{"nothing fits", []uint64{150_000, 500_000, 1_500_000}, 100_000, 0, 0},
+		{"paused highest", []uint64{150_000, 500_000, 0}, 0, 0, 1},
+		{"paused lowest", []uint64{0, 500_000, 1_500_000}, 0, 320, 1},
+		{"paused lowest bandwidth", []uint64{0, 500_000, 1_500_000}, 100_000, 0, 1},
+		{"nothing received", []uint64{0, 0, 0}, 0, 0, 0},
+		// the bitrate of a layer does not rank it
+		{"higher layer cheaper", []uint64{150_000, 900_000, 600_000}, 700_000, 0, 2},
+	}
+	for _, test := range tests {
+		layers := make([]*layer, len(test.bitrates))
+		for i, bitrate := range test.bitrates {
+			layers[i] = &layer{rank: i, bitrate: bitrate}
+		}
+		// the layers are received in any order
+		upTrack := &UpTrack{layers: []*layer{layers[2], layers[0], layers[1]}}
+		track := &DownTrack{subscriber: &Peer{bandwidth: test.bandwidth}}
+		track.SetVideoSize(test.size, test.size)
+
+		upTrack.selectLayer(track, upTrack.rankedLayers())
+		if track.targetLayer != layers[test.want] {
+			t.Fatalf("%s: layer %d not selected", test.name, test.want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package rtc
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/pion/rtp"
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v3"
+)
+
+const (
+	// weight of a packet in the smoothed score, an audio packet lasts 20 ms usually
+	speakingScoreSmoothing = 0.05
+	// the score of a track without packets, e.g. muted or in discontinuous transmission, is reset after the timeout
+	speakingScoreTimeout = 500 * time.Millisecond
+	// minimal score of a speaking participant, the quieter levels are background noise
+	minSpeakingScore = 0.5
+	// a participant replaces the speaking dominant speaker when louder by the margin
+	dominantSpeakerMargin = 0.1
+	// interval of the dominant speaker detection
+	dominantSpeakerInterval = 300 * time.Millisecond
+)
+
+// speakingScore is the smoothed audio level of an up track, from 0 for the silence to 1 for the loudest level
+type speakingScore struct {
+	// id of the negotiated audio level header extension
+	extensionId uint8
+	// bits of the float64 score, updated atomically
+	score uint64
+	// unix time in nanoseconds of the last level, updated atomically
+	updated int64
+}
+
+// newSpeakingScore returns nil if the audio level header extension has not been negotiated with the publisher
+func newSpeakingScore(receiver *webrtc.RTPReceiver) *speakingScore {
+	for _, extension := range receiver.GetParameters().HeaderExtensions {
+		if extension.URI == sdp.AudioLevelURI {
+			return &speakingScore{extensionId: uint8(extension.ID)}
+		}
+	}
+	return nil
+}
+
+// push adds the audio level of the packet to the score, it is called by the reader of the track only
+func (s *speakingScore) push(packet *rtp.Packet, now time.Time) {
+	payload := packet.GetExtension(s.extensionId)
+	if payload == nil {
+		return
+	}
+	extension := rtp.AudioLevelExtension{}
+	if err := extension.Unmarshal(payload); err != nil {
+		return
+	}
+	// the level is in -dBov from 0 to 127
+	level := 1 - float64(extension.Level)/127
+	score := s.get(now)
+	score += (level - score) * speakingScoreSmoothing
+	atomic.StoreUint64(&s.score, math.Float64bits(score))
+	atomic.StoreInt64(&s.updated, now.UnixNano())
+}
+
+func (s *speakingScore) get(now time.Time) float64 {
+	if now.UnixNano()-atomic.LoadInt64(&s.updated) > int64(speakingScoreTimeout) {
+		return 0
+	}
+	return math.Float64frombits(atomic.LoadUint64(&s.score))
+}
+
+// detectDominantSpeaker chooses the dominant speaker on an interval until the session is closed
+func (s *Session) detectDominantSpeaker() {
+	ticker := time.NewTicker(dominantSpeakerInterval)
+	defer ticker.Stop()
+	for !s.IsStopped() {
+		now := <-ticker.C
+		s.updateDominantSpeaker(now)
+	}
+}
+
+// updateDominantSpeaker chooses the participant with the highest speaking score, the dominant speaker is kept
+// unless the participant is louder by the margin; every participant is notified about a change
+func (s *Session) updateDominantSpeaker(now time.Time) {
+	s.mutex.Lock()
+	var speaker *UpTrack
+	var best, current float64
+	for _, upTrack := range s.upTracks {
+		if upTrack.speaking == nil {
+			continue
+		}
+		score := upTrack.speaking.get(now)
+		if upTrack.publisher.Id == s.dominantSpeaker {
+			current = max(current, score)
+		}
+		if score > best {
+			speaker, best = upTrack, score
+		}
+	}
+	if speaker == nil || best < minSpeakingScore || speaker.publisher.Id == s.dominantSpeaker ||
+		current >= minSpeakingScore && best < current+dominantSpeakerMargin {
+		s.mutex.Unlock()
+		return
+	}
+	s.dominantSpeaker = speaker.publisher.Id
+	peerIds := make([]string, 0, len(s.peers))
+	for peerId := range s.peers {
+		peerIds = append(peerIds, peerId)
+	}
+	streamIds := speaker.subscriberStreams()
+	s.mutex.Unlock()
+	if s.signaling == nil {
+		return
+	}
+	for _, peerId := range peerIds {
+		s.signaling.SendDominantSpeaker(peerId, speaker.publisher.Id, streamIds[peerId])
+	}
+}
@@ -0,0 +1,21 @@
+package rtc
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSession_UpdateDominantSpeaker_WithoutSignaling(t *testing.T) {
+	s := NewSession("session", nil)
+	now := time.Now()
+	speaker := &UpTrack{publisher: &Peer{Id: "speaker"},
+		speaking: &speakingScore{score: math.Float64bits(0.9), updated: now.UnixNano()}}
+	s.upTracks = append(s.upTracks, speaker)
+	s.peers["listener"] = &Peer{Id: "listener"}
+
+	s.updateDominantSpeaker(now)
+	if s.dominantSpeaker != "speaker" {
+		t.Fatal("dominant speaker not chosen", s.dominantSpeaker)
+	}
+}
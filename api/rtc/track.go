@@ -0,0 +1,342 @@
+package rtc
+
+import (
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pion/rtcp"
+	"github.com/pion/rtp"
+	"github.com/pion/webrtc/v3"
+	"github.com/rs/zerolog/log"
+)
+
+// UpTrack is a track received from a publishing peer, the embedded remote track is the first received layer
+type UpTrack struct {
+	*webrtc.TrackRemote
+	publisher *Peer
+	mutex     sync.RWMutex
+	// encodings of a simulcast track in the order of reception
+	layers     []*layer
+	downTracks []*DownTrack
+	// records the first layer
+	recorder *trackRecorder
+	// audio level of an audio track, nil if not available
+	speaking *speakingScore
+	stop     int32
+}
+
+// DownTrack is a track forwarding an up track to a subscribing peer
+type DownTrack struct {
+	*webrtc.TrackLocalStaticRTP
+	sender     *webrtc.RTPSender
+	subscriber *Peer
+	// guards the source, the layers and the rewriter, the layers of a simulcast track are forwarded concurrently
+	mutex  sync.Mutex
+	source *UpTrack
+	// forwarded layer, nil until the first packet
+	layer *layer
+	// layer switched to on its next keyframe
+	targetLayer *layer
+	rewriter    rtpRewriter
+	// written packets requested again by the subscriber, nil if NACK has not been negotiated
+	history *packetHistory
+	// dimensions of the tile the subscriber renders the track in, zero if unknown; updated atomically
+	width  int32
+	height int32
+}
+
+func newUpTrack(remoteTrack *webrtc.TrackRemote, receiver *webrtc.RTPReceiver, publisher *Peer) *UpTrack {
+	upTrack := &UpTrack{TrackRemote: remoteTrack, publisher: publisher, layers: []*layer{newLayer(remoteTrack, receiver)}}
+	if remoteTrack.Kind() == webrtc.RTPCodecTypeAudio {
+		upTrack.speaking = newSpeakingScore(receiver)
+	}
+	return upTrack
+}
+
+// addLayer adds the next received encoding of a simulcast track
+func (t *UpTrack) addLayer(remoteTrack *webrtc.TrackRemote, receiver *webrtc.RTPReceiver) *layer {
+	l := newLayer(remoteTrack, receiver)
+	t.mutex.Lock()
+	t.layers = append(t.layers, l)
+	t.mutex.Unlock()
+	return l
+}
+
+// isLayerOf reports whether the remote track is another encoding of the simulcast track
+func (t *UpTrack) isLayerOf(publisher *Peer, remoteTrack *webrtc.TrackRemote) bool {
+	return t.publisher == publisher && remoteTrack.RID() != "" && t.RID() != "" &&
+		t.ID() == remoteTrack.ID() && t.Kind() == remoteTrack.Kind() && !t.IsStopped()
+}
+
+// ssrcs returns the SSRCs of the layers
+func (t *UpTrack) ssrcs() []webrtc.SSRC {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	ssrcs := make([]webrtc.SSRC, 0, len(t.layers))
+	for _, l := range t.layers {
+		ssrcs = append(ssrcs, l.SSRC())
+	}
+	return ssrcs
+}
+
+// streamId groups the forwarded tracks of the publisher the same way the publisher does
+func (t *UpTrack) streamId() string {
+	if streamId := t.StreamID(); streamId != "" {
+		return streamId
+	}
+	return t.publisher.Id
+}
+
+func (t *UpTrack) addDownTrack(track *DownTrack) {
+	t.mutex.Lock()
+	t.downTracks = append(t.downTracks, track)
+	t.mutex.Unlock()
+}
+
+func (t *UpTrack) removeDownTrack(track *DownTrack) {
+	t.mutex.Lock()
+	for i, d := range t.downTracks {
+		if d == track {
+			t.downTracks = append(t.downTracks[:i], t.downTracks[i+1:]...)
+			break
+		}
+	}
+	t.mutex.Unlock()
+}
+
+// detach unbinds all down tracks and returns them
+func (t *UpTrack) detach() (downTracks []*DownTrack) {
+	t.mutex.Lock()
+	downTracks = t.downTracks
+	t.downTracks = nil
+	t.mutex.Unlock()
+	return
+}
+
+// subscriberStreams returns the ids of the streams the subscribers receive the track in by the subscriber ids
+func (t *UpTrack) subscriberStreams() map[string]string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	streamIds := make(map[string]string, len(t.downTracks))
+	for _, track := range t.downTracks {
+		streamIds[track.subscriber.Id] = track.StreamID()
+	}
+	return streamIds
+}
+
+func (t *UpTrack) hasSubscribers() bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return len(t.downTracks) > 0
+}
+
+// startRecording tees the forwarded packets into the recorder,
+// false if the track is already being recorded
+func (t *UpTrack) startRecording(recorder *trackRecorder) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.recorder != nil {
+		return false
+	}
+	t.recorder = recorder
+	return true
+}
+
+// detachRecorder detaches the recorder without closing it
+func (t *UpTrack) detachRecorder() *trackRecorder {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	recorder := t.recorder
+	t.recorder = nil
+	return recorder
+}
+
+// stopRecording detaches the recorder and closes its file
+func (t *UpTrack) stopRecording() {
+	if recorder := t.detachRecorder(); recorder != nil {
+		recorder.close()
+	}
+}
+
+// forward writes the packet of the layer to the subscribers of the layer and to the recorder
+func (t *UpTrack) forward(l *layer, packet *rtp.Packet) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if t.recorder != nil && l == t.layers[0] {
+		t.recorder.push(packet)
+	}
+	if len(t.downTracks) == 0 {
+		return
+	}
+	keyFrame := false
+	if t.Kind() == webrtc.RTPCodecTypeVideo {
+		keyFrame = isKeyFrame(t.Codec().MimeType, packet.Payload)
+		// the payload is kept by the histories of the down tracks, the read buffer is reused
+		packet = &rtp.Packet{Header: packet.Header, Payload: slices.Clone(packet.Payload)}
+	}
+	for _, track := range t.downTracks {
+		track.forward(l, packet, keyFrame)
+	}
+}
+
+func (t *UpTrack) Close() {
+	atomic.StoreInt32(&t.stop, 1)
+}
+
+func (t *UpTrack) IsStopped() bool {
+	return atomic.LoadInt32(&t.stop) != 0
+}
+
+func newDownTrack(track *webrtc.TrackLocalStaticRTP, sender *webrtc.RTPSender, subscriber *Peer) *DownTrack {
+	downTrack := &DownTrack{TrackLocalStaticRTP: track, sender: sender, subscriber: subscriber, rewriter: newRTPRewriter(track.Codec().ClockRate)}
+	if track.Kind() == webrtc.RTPCodecTypeVideo && supportsNack(track.Codec()) {
+		downTrack.history = &packetHistory{}
+	}
+	return downTrack
+}
+
+// bind starts forwarding of the up track, the session must be locked
+func (t *DownTrack) bind(upTrack *UpTrack) {
+	t.mutex.Lock()
+	t.source = upTrack
+	t.layer = nil
+	t.targetLayer = nil
+	t.mutex.Unlock()
+	if t.Kind() == webrtc.RTPCodecTypeVideo {
+		atomic.AddInt32(&t.subscriber.videoSources, 1)
+	}
+	upTrack.addDownTrack(t)
+}
+
+// unbind stops forwarding of the up track which has been detached from the down track, the session must be locked
+func (t *DownTrack) unbind() {
+	t.mutex.Lock()
+	source := t.source
+	t.source = nil
+	t.mutex.Unlock()
+	if source == nil {
+		return
+	}
+	if t.Kind() == webrtc.RTPCodecTypeVideo {
+		atomic.AddInt32(&t.subscriber.videoSources, -1)
+	}
+}
+
+// boundSource returns the forwarded up track, nil if the down track is free
+func (t *DownTrack) boundSource() *UpTrack {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.source
+}
+
+// forward writes the packet if the layer is forwarded, the target layer replaces the forwarded one on a keyframe;
+// the SSRC is rewritten by the local track and the sequence numbers and timestamps by the rewriter,
+// so the subscriber sees a single continuous stream whatever the source is.
+// A keyframe is requested when a new source starts forwarding with a delta frame, the subscriber could not decode it.
+func (t *DownTrack) forward(l *layer, packet *rtp.Packet, keyFrame bool) {
+	t.mutex.Lock()
+	if t.targetLayer == nil {
+		t.targetLayer = l
+	}
+	started := false
+	if l != t.layer {
+		if l != t.targetLayer || t.layer != nil && !keyFrame {
+			t.mutex.Unlock()
+			return
+		}
+		started = t.layer == nil
+		t.layer = l
+	}
+	source := t.source
+	header, ok := t.rewriter.rewrite(packet, time.Now())
+	t.mutex.Unlock()
+	if !ok {
+		return
+	}
+	if started && !keyFrame && source != nil && t.Kind() == webrtc.RTPCodecTypeVideo {
+		source.requestKeyFrame(l)
+	}
+	// the header extensions are negotiated with the publisher, not with the subscriber
+	header.Extension = false
+	header.Extensions = nil
+	if t.history != nil {
+		t.history.push(header, packet.Payload)
+	}
+	err := t.WriteRTP(&rtp.Packet{Header: header, Payload: packet.Payload})
+	// ErrClosedPipe means the subscriber has not been connected yet
+	if err != nil && err != io.ErrClosedPipe {
+		log.Debug().Err(err).Str("peer", t.subscriber.Id).Msg("Track Forward")
+	}
+}
+
+// setTargetLayer sets the layer forwarded from the next keyframe, it reports whether a switch is pending
+func (t *DownTrack) setTargetLayer(l *layer) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.targetLayer = l
+	return t.layer != nil && t.layer != l
+}
+
+// SetVideoSize sets the dimensions of the tile the subscriber renders the track in
+func (t *DownTrack) SetVideoSize(width, height int) {
+	atomic.StoreInt32(&t.width, int32(width))
+	atomic.StoreInt32(&t.height, int32(height))
+}
+
+func (t *DownTrack) videoSize() (width, height int) {
+	return int(atomic.LoadInt32(&t.width)), int(atomic.LoadInt32(&t.height))
+}
+
+// readRTCP reads RTCP sent back by the subscriber, the bandwidth estimated by the subscriber is kept,
+// the lost packets are sent again and the keyframe requests are passed to the publisher
+func (t *DownTrack) readRTCP() {
+	for {
+		packets, _, err := t.sender.ReadRTCP()
+		if err != nil {
+			return
+		}
+		for _, packet := range packets {
+			switch p := packet.(type) {
+			case *rtcp.ReceiverEstimatedMaximumBitrate:
+				t.subscriber.setBandwidth(uint64(p.Bitrate))
+			case *rtcp.TransportLayerCC:
+				// consumed by the bandwidth estimator
+				t.subscriber.setTransportCC()
+			case *rtcp.TransportLayerNack:
+				t.retransmit(p)
+			case *rtcp.PictureLossIndication, *rtcp.FullIntraRequest:
+				t.requestKeyFrame()
+			}
+		}
+	}
+}
+
+// requestKeyFrame requests a keyframe of the forwarded layer from the publisher of the source
+func (t *DownTrack) requestKeyFrame() {
+	t.mutex.Lock()
+	source, l := t.source, t.layer
+	t.mutex.Unlock()
+	if source != nil && l != nil {
+		source.requestKeyFrame(l)
+	}
+}
+
+// retransmit sends the packets requested by the subscriber again from the history
+func (t *DownTrack) retransmit(nack *rtcp.TransportLayerNack) {
+	if t.history == nil {
+		return
+	}
+	var seqs []uint16
+	for _, pair := range nack.Nacks {
+		seqs = append(seqs, pair.PacketList()...)
+	}
+	for _, packet := range t.history.get(seqs, time.Now()) {
+		if err := t.WriteRTP(packet); err != nil {
+			log.Debug().Err(err).Str("peer", t.subscriber.Id).Msg("Track Retransmit")
+			return
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package rtc
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/pion/webrtc/v3"
+)
+
+// ICEServer is a STUN or TURN server the way RTCIceServer describes it to the browser
+type ICEServer struct {
+	Urls       []string
+	Username   string
+	Credential string
+}
+
+// TURNCredentials generates the time-limited credentials of the TURN REST API scheme,
+// the username is the expiration unix time and the user id, the credential is the HMAC-SHA1 of the username
+func TURNCredentials(secret string, userId string, ttl time.Duration) (username string, credential string) {
+	username = strconv.FormatInt(time.Now().Add(ttl).Unix(), 10) + ":" + userId
+	credential = TURNPassword(secret, username)
+	return
+}
+
+// TURNPassword returns the password of the TURN REST API username
+func TURNPassword(secret string, username string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write([]byte(username))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ICEServers returns the configured STUN and TURN servers, the TURN credentials are issued to the user
+func ICEServers(userId string) []ICEServer {
+	settings := API().settings
+	var servers []ICEServer
+	if len(settings.STUNServers) > 0 {
+		servers = append(servers, ICEServer{Urls: settings.STUNServers})
+	}
+	if len(settings.TURN.URLs) > 0 {
+		username, credential := TURNCredentials(settings.TURN.Secret, userId, settings.TURN.CredentialTTL)
+		servers = append(servers, ICEServer{Urls: settings.TURN.URLs, Username: username, Credential: credential})
+	}
+	return servers
+}
+
+// configuration returns the configuration of a peer connection of the server
+func (a *webrtcApi) configuration(peerId string) webrtc.Configuration {
+	config := a.config
+	config.ICEServers = nil
+	for _, server := range ICEServers(peerId) {
+		iceServer := webrtc.ICEServer{URLs: server.Urls}
+		if server.Username != "" {
+			iceServer.Username = server.Username
+			iceServer.Credential = server.Credential
+			iceServer.CredentialType = webrtc.ICECredentialTypePassword
+		}
+		config.ICEServers = append(config.ICEServers, iceServer)
+	}
+	return config
+}
@@ -1,51 +1,257 @@
 package rtc
 
 import (
+	"errors"
+	"net"
+	"slices"
+	"strings"
 	"sync"
 
-	"github.com/pion/webrtc/v2"
+	"github.com/pion/ice/v2"
+	"github.com/pion/interceptor"
+	"github.com/pion/interceptor/pkg/cc"
+	"github.com/pion/interceptor/pkg/gcc"
+	"github.com/pion/interceptor/pkg/twcc"
+	"github.com/pion/sdp/v3"
+	"github.com/pion/webrtc/v3"
 )
 
 type webrtcApi struct {
 	*webrtc.API
-	config webrtc.Configuration
+	// the ICE servers are added per peer connection
+	config   webrtc.Configuration
+	settings Config
+	// negotiated codecs in the order of preference
+	audioCodecs []webrtc.RTPCodecParameters
+	videoCodecs []webrtc.RTPCodecParameters
+	// serializes creation of the peer connections, the bandwidth estimator is handed over by the callback
+	estimatorMutex sync.Mutex
+	estimator      cc.BandwidthEstimator
 }
 
+// number of packets buffered per ICE-TCP connection until it is bound to a peer connection
+const tcpMuxReadBufferSize = 8
+
+var videoRTCPFeedback = []webrtc.RTCPFeedback{{Type: "goog-remb"}, {Type: "transport-cc"}, {Type: "ccm", Parameter: "fir"}, {Type: "nack"}, {Type: "nack", Parameter: "pli"}}
+
+var audioRTCPFeedback = []webrtc.RTCPFeedback{{Type: "transport-cc"}}
+
+// header extensions identifying the encodings of a simulcast track
+var simulcastHeaderExtensions = []string{sdp.SDESMidURI, sdp.SDESRTPStreamIDURI}
+
+// supported codecs by the configuration names
 var (
-	audioCodec *webrtc.RTPCodec
-	videoCodec *webrtc.RTPCodec
-	mediaEngine webrtc.MediaEngine
-	api *webrtcApi
+	audioCodecs = map[string]webrtc.RTPCodecParameters{
+		"opus": {
+			RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus, ClockRate: 48000, Channels: 2, SDPFmtpLine: "minptime=10;useinbandfec=1", RTCPFeedback: audioRTCPFeedback},
+			PayloadType:        111,
+		},
+	}
+	videoCodecs = map[string]webrtc.RTPCodecParameters{
+		"vp8": {
+			RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeVP8, ClockRate: 90000, RTCPFeedback: videoRTCPFeedback},
+			PayloadType:        96,
+		},
+		"vp9": {
+			RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeVP9, ClockRate: 90000, SDPFmtpLine: "profile-id=0", RTCPFeedback: videoRTCPFeedback},
+			PayloadType:        98,
+		},
+		// constrained baseline, the profile supported by every browser
+		"h264": {
+			RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeH264, ClockRate: 90000, SDPFmtpLine: "level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=42e01f", RTCPFeedback: videoRTCPFeedback},
+			PayloadType:        102,
+		},
+		"av1": {
+			RTPCodecCapability: webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeAV1, ClockRate: 90000, RTCPFeedback: videoRTCPFeedback},
+			PayloadType:        45,
+		},
+	}
+)
+
+var (
+	api  *webrtcApi
 	once sync.Once
 )
 
+// Init creates the WebRTC API with the configuration, it must be called before the first session is created
+func Init(config Config) (err error) {
+	if err = config.Validate(); err != nil {
+		return
+	}
+	initialized := false
+	once.Do(func() {
+		initialized = true
+		api, err = newWebrtcApi(config)
+	})
+	if !initialized {
+		err = errors.New("WebRTC API has already been initialized")
+	}
+	return
+}
+
+// API returns the WebRTC API, the default configuration is used unless Init has been called
 func API() *webrtcApi {
 	once.Do(func() {
-		mediaEngine = webrtc.MediaEngine{}
-		// Setup the codecs you want to use
-		audioCodec = webrtc.NewRTPOpusCodec(webrtc.DefaultPayloadTypeOpus, 48000)
-		mediaEngine.RegisterCodec(audioCodec)
-		videoCodec = webrtc.NewRTPVP8Codec(webrtc.DefaultPayloadTypeVP8, 90000)
-		mediaEngine.RegisterCodec(videoCodec)
-		// Create the API object with the MediaEngine
-		api = &webrtcApi{
-			webrtc.NewAPI(webrtc.WithMediaEngine(mediaEngine)),
-			webrtc.Configuration{
-				ICEServers: []webrtc.ICEServer{
-					{
-						URLs: []string{"stun:stun.l.google.com:19302"},
-					},
-				},
-			},
+		var err error
+		if api, err = newWebrtcApi(DefaultConfig()); err != nil {
+			panic(err)
 		}
 	})
 	return api
 }
 
-func getAudioCodec() *webrtc.RTPCodec {
-	return audioCodec
+func newWebrtcApi(config Config) (*webrtcApi, error) {
+	mediaEngine := &webrtc.MediaEngine{}
+	a := &webrtcApi{settings: config}
+	// Setup the codecs you want to use
+	for _, name := range config.AudioCodecs {
+		codec := audioCodecs[strings.ToLower(name)]
+		if err := mediaEngine.RegisterCodec(codec, webrtc.RTPCodecTypeAudio); err != nil {
+			return nil, err
+		}
+		a.audioCodecs = append(a.audioCodecs, codec)
+	}
+	for _, name := range config.VideoCodecs {
+		codec := videoCodecs[strings.ToLower(name)]
+		if err := mediaEngine.RegisterCodec(codec, webrtc.RTPCodecTypeVideo); err != nil {
+			return nil, err
+		}
+		a.videoCodecs = append(a.videoCodecs, codec)
+	}
+	for _, extension := range simulcastHeaderExtensions {
+		if err := mediaEngine.RegisterHeaderExtension(webrtc.RTPHeaderExtensionCapability{URI: extension}, webrtc.RTPCodecTypeVideo); err != nil {
+			return nil, err
+		}
+	}
+	if err := mediaEngine.RegisterHeaderExtension(webrtc.RTPHeaderExtensionCapability{URI: sdp.AudioLevelURI}, webrtc.RTPCodecTypeAudio); err != nil {
+		return nil, err
+	}
+	registry, err := a.newInterceptorRegistry(mediaEngine)
+	if err != nil {
+		return nil, err
+	}
+	settingEngine, err := newSettingEngine(config.ICE)
+	if err != nil {
+		return nil, err
+	}
+	// Create the API object with the MediaEngine
+	a.API = webrtc.NewAPI(webrtc.WithMediaEngine(mediaEngine), webrtc.WithSettingEngine(settingEngine), webrtc.WithInterceptorRegistry(registry))
+	return a, nil
 }
 
-func getVideoCodec() *webrtc.RTPCodec {
-	return videoCodec
+// newInterceptorRegistry enables the transport-wide congestion control: the publishers are sent the feedback
+// of the received packets, and the bandwidth towards the subscribers is estimated from their feedback
+func (a *webrtcApi) newInterceptorRegistry(mediaEngine *webrtc.MediaEngine) (*interceptor.Registry, error) {
+	for _, kind := range []webrtc.RTPCodecType{webrtc.RTPCodecTypeAudio, webrtc.RTPCodecTypeVideo} {
+		if err := mediaEngine.RegisterHeaderExtension(webrtc.RTPHeaderExtensionCapability{URI: sdp.TransportCCURI}, kind); err != nil {
+			return nil, err
+		}
+	}
+	registry := &interceptor.Registry{}
+	// the written packets are not paced, the forwarded bitrate is limited by the layer selection
+	estimator, err := cc.NewInterceptor(func() (cc.BandwidthEstimator, error) {
+		return gcc.NewSendSideBWE(gcc.SendSideBWEInitialBitrate(initialBandwidth), gcc.SendSideBWEMinBitrate(minBandwidth),
+			gcc.SendSideBWEPacer(gcc.NewNoOpPacer()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	estimator.OnNewPeerConnection(func(_ string, estimator cc.BandwidthEstimator) {
+		a.estimator = estimator
+	})
+	registry.Add(estimator)
+	// the sequence numbers are added after the estimator in the chain, so it sees them
+	headerExtension, err := twcc.NewHeaderExtensionInterceptor()
+	if err != nil {
+		return nil, err
+	}
+	registry.Add(headerExtension)
+	feedback, err := twcc.NewSenderInterceptor()
+	if err != nil {
+		return nil, err
+	}
+	registry.Add(feedback)
+	return registry, nil
+}
+
+// newPeerConnection creates the peer connection with its bandwidth estimator
+func (a *webrtcApi) newPeerConnection(id string) (*webrtc.PeerConnection, cc.BandwidthEstimator, error) {
+	a.estimatorMutex.Lock()
+	defer a.estimatorMutex.Unlock()
+	a.estimator = nil
+	connection, err := a.NewPeerConnection(a.configuration(id))
+	return connection, a.estimator, err
+}
+
+// newSettingEngine applies the restrictions of the ICE transport
+func newSettingEngine(config ICEConfig) (settingEngine webrtc.SettingEngine, err error) {
+	if config.PortMin != 0 {
+		if err = settingEngine.SetEphemeralUDPPortRange(uint16(config.PortMin), uint16(config.PortMax)); err != nil {
+			return
+		}
+	}
+	if len(config.NAT1To1IPs) > 0 {
+		candidateType, _ := webrtc.NewICECandidateType(config.NAT1To1CandidateType)
+		settingEngine.SetNAT1To1IPs(config.NAT1To1IPs, candidateType)
+	}
+	names := config.NetworkTypes
+	// pion gathers only UDP candidates by default
+	if len(names) == 0 && config.TCPMuxPort != 0 {
+		names = []string{"udp4", "udp6", "tcp4", "tcp6"}
+	}
+	if len(names) > 0 {
+		networkTypes := make([]webrtc.NetworkType, 0, len(names))
+		for _, name := range names {
+			networkType, _ := webrtc.NewNetworkType(name)
+			networkTypes = append(networkTypes, networkType)
+		}
+		settingEngine.SetNetworkTypes(networkTypes)
+	}
+	var interfaceFilter func(string) bool
+	if len(config.Interfaces) > 0 || len(config.ExcludedInterfaces) > 0 {
+		interfaceFilter = func(name string) bool {
+			return (len(config.Interfaces) == 0 || slices.Contains(config.Interfaces, name)) && !slices.Contains(config.ExcludedInterfaces, name)
+		}
+		settingEngine.SetInterfaceFilter(interfaceFilter)
+	}
+	// All peer connections share the single ports, the packets are demultiplexed by the ICE ufrag
+	var udpMux *ice.MultiUDPMuxDefault
+	if config.UDPMuxPort != 0 {
+		var options []ice.UDPMuxFromPortOption
+		if interfaceFilter != nil {
+			options = append(options, ice.UDPMuxFromPortWithInterfaceFilter(interfaceFilter))
+		}
+		if networks := udpNetworkTypes(config.NetworkTypes); len(networks) > 0 {
+			options = append(options, ice.UDPMuxFromPortWithNetworks(networks...))
+		}
+		if udpMux, err = ice.NewMultiUDPMuxFromPort(config.UDPMuxPort, options...); err != nil {
+			return
+		}
+		settingEngine.SetICEUDPMux(udpMux)
+	}
+	if config.TCPMuxPort != 0 {
+		var listener *net.TCPListener
+		if listener, err = net.ListenTCP("tcp", &net.TCPAddr{Port: config.TCPMuxPort}); err != nil {
+			// the UDP port is released, e.g. for a retry
+			if udpMux != nil {
+				_ = udpMux.Close()
+			}
+			return
+		}
+		settingEngine.SetICETCPMux(webrtc.NewICETCPMux(nil, listener, tcpMuxReadBufferSize))
+	}
+	return
+}
+
+// udpNetworkTypes returns the UDP types of the configured network types
+func udpNetworkTypes(names []string) (networks []ice.NetworkType) {
+	for _, name := range names {
+		switch name {
+		case "udp4":
+			networks = append(networks, ice.NetworkTypeUDP4)
+		case "udp6":
+			networks = append(networks, ice.NetworkTypeUDP6)
+		}
+	}
+	return
 }
@@ -0,0 +1,31 @@
+package rtc
+
+import (
+	"net"
+	"testing"
+)
+
+func TestNewSettingEngine_ReleasesUDPMux(t *testing.T) {
+	// the TCP mux port is taken, so the setting engine fails after the UDP mux has been bound
+	taken, err := net.ListenTCP("tcp", &net.TCPAddr{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer taken.Close()
+	free, err := net.ListenUDP("udp", &net.UDPAddr{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	udpPort := free.LocalAddr().(*net.UDPAddr).Port
+	_ = free.Close()
+
+	config := ICEConfig{UDPMuxPort: udpPort, TCPMuxPort: taken.Addr().(*net.TCPAddr).Port}
+	if _, err = newSettingEngine(config); err == nil {
+		t.Fatal("taken TCP mux port accepted")
+	}
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{Port: udpPort})
+	if err != nil {
+		t.Fatal("UDP mux port not released", err)
+	}
+	_ = conn.Close()
+}
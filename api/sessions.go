@@ -1,26 +1,70 @@
 package api
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 
-	"github.com/pion/webrtc/v2"
+	"github.com/pion/webrtc/v3"
 	"github.com/rs/zerolog/log"
 
 	"video-chat/api/cache"
+	"video-chat/api/rpc/websocket"
 	"video-chat/api/rtc"
 )
 
+const (
+	candidateTopic = "candidate"
+	offerTopic     = "offer"
+	eventTopic     = "event"
+	// the session id is a part of the URL shared with the participants
+	maxSessionIdLength = 128
+)
+
 type sessions struct {
-	cache *cache.Cache
+	cache     *cache.Cache[string, *rtc.Session]
+	signaling signaling
+	// client id -> id of the joined session
+	members sync.Map
+	// optional persistent registry of sessions, nil if disabled
+	registry *cache.Registry
+	config   Config
+}
+
+// signaling pushes messages of sessions to the clients subscribed to the service topics
+type signaling struct {
+	service *websocket.Service
+}
+
+// OwnerDesc is the answer to the creator of a session, the owner key is empty unless the session is persisted
+type OwnerDesc struct {
+	Type string
+	Sdp  string
+	// OwnerKey restores the moderation of the creator joining the session again after a restart of the server
+	OwnerKey string
 }
 
 type Sessions interface {
-	New(id string, sdpTypeStr string, sdp string) (rtc.SessionDesc, error)
-	Join(id string, sdpTypeStr string, sdp string) (rtc.SessionDesc, error)
-	Close(id string)
-	Leave(id string)
+	New(id string, sdpTypeStr string, sdp string, context *websocket.Context) (OwnerDesc, error)
+	Join(id string, sdpTypeStr string, sdp string, ownerKey string, context *websocket.Context) (rtc.SessionDesc, error)
+	Close(id string, context *websocket.Context) error
+	Leave(id string, context *websocket.Context)
+	AddCandidate(id string, candidate string, sdpMid string, sdpMLineIndex uint16, context *websocket.Context) error
+	Negotiate(id string, sdpTypeStr string, sdp string, context *websocket.Context) (rtc.SessionDesc, error)
+	Answer(id string, sdpTypeStr string, sdp string, context *websocket.Context) error
+	StartRecording(id string, context *websocket.Context) error
+	StopRecording(id string, context *websocket.Context) error
+	ICEServers(context *websocket.Context) ([]rtc.ICEServer, error)
+	SetVideoSize(id string, trackId string, width int, height int, context *websocket.Context) error
+	Mute(id string, clientId string, kind string, muted bool, context *websocket.Context) error
+	Kick(id string, clientId string, context *websocket.Context) error
+	Lock(id string, locked bool, context *websocket.Context) error
+	TransferModeration(id string, clientId string, context *websocket.Context) error
 }
 
 func toSDPType(typeStr string) (SDPType webrtc.SDPType, err error) {
@@ -39,85 +83,561 @@ func toSDPType(typeStr string) (SDPType webrtc.SDPType, err error) {
 	return
 }
 
-func (sessions *sessions) New(id string, sdpTypeStr string, sdp string) (answer rtc.SessionDesc, err error) {
+// New creates the session of the client, the session id is a room of the token chosen by the token issuer or the id
+// of the client, which is the subject of the token; the owner key of a persisted session is returned to the creator only
+func (sessions *sessions) New(id string, sdpTypeStr string, sdp string, context *websocket.Context) (answer OwnerDesc, err error) {
+	if err = validateSessionId(id); err != nil {
+		return
+	}
+	// nobody chooses the session id of a client which is not authenticated
+	if context.Claims == nil && id != context.ClientID {
+		err = errors.New("session id '" + id + "' is not the id of the client")
+		return
+	}
+	if err = authorize(context, id, true); err != nil {
+		return
+	}
+	if sessions.exists(id) {
+		err = errors.New("session '" + id + "' already exists")
+		return
+	}
 	sdpType, err := toSDPType(sdpTypeStr)
 	if err != nil {
 		return
 	}
-	session, result, err := rtc.NewSession(id, &webrtc.SessionDescription{Type: sdpType, SDP: sdp})
+	// the session id may be a room of the token, the creator is the client
+	session := rtc.NewSession(id, &sessions.signaling)
+	session.SetModerator(context.ClientID)
+	result, err := session.Join(context.ClientID, &webrtc.SessionDescription{Type: sdpType, SDP: sdp})
 	if err != nil {
+		session.Close()
 		return
 	}
-	err = sessions.cache.Add(id, session, cache.NoExpiration)
+	err = sessions.add(session)
 	if err != nil {
+		session.Close()
 		return
 	}
-	answer.Type = result.Type.String()
-	answer.Sdp = result.SDP
+	sessions.members.Store(context.ClientID, id)
+	answer = OwnerDesc{Type: result.Type.String(), Sdp: result.SDP}
+	if sessions.registry != nil {
+		ownerKey, err := newOwnerKey()
+		if err == nil {
+			now := time.Now()
+			err = sessions.registry.Put(cache.SessionInfo{Id: id, OwnerId: context.ClientID, OwnerKey: cache.OwnerKeyHash(ownerKey),
+				Created: now, Expiration: now.Add(sessions.config.RegistryExpiration)})
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("Session New; Registry Put")
+		} else {
+			answer.OwnerKey = ownerKey
+		}
+	}
 	return
 }
 
-func (sessions *sessions) Join(id string, sdpTypeStr string, sdp string) (answer rtc.SessionDesc, err error) {
-	sdpType, err := toSDPType(sdpTypeStr)
+func validateSessionId(id string) error {
+	if id == "" || len(id) > maxSessionIdLength {
+		return errors.New("session id must be 1 to " + strconv.Itoa(maxSessionIdLength) + " bytes long")
+	}
+	for _, r := range id {
+		if unicode.IsControl(r) {
+			return errors.New("session id must not contain control characters")
+		}
+	}
+	return nil
+}
+
+// exists reports whether the session is active or persisted, a persisted session is restored by joining it
+func (sessions *sessions) exists(id string) bool {
+	if _, err := sessions.cache.Get(id); err == nil {
+		return true
+	}
+	if sessions.registry == nil {
+		return false
+	}
+	_, err := sessions.registry.Get(id)
+	return err == nil
+}
+
+// newOwnerKey returns a random key of the owner of a session
+func newOwnerKey() (string, error) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// add caches the session until it is idle, media activity keeps the session alive
+func (sessions *sessions) add(session *rtc.Session) error {
+	id := session.Id
+	session.OnActivity(sessions.config.IdleTimeout/4, func() {
+		if _, err := sessions.cache.KeepAlive(id, sessions.config.IdleTimeout); err != nil {
+			log.Debug().Err(err).Str("session", id).Msg("Session Keep Alive")
+		}
+		// the handler is called by the forwarding, the registry is written aside
+		if !session.IsStopped() {
+			go sessions.extendRegistry(id)
+		}
+	})
+	return sessions.cache.Add(id, session, sessions.config.IdleTimeout)
+}
+
+// active returns the session and keeps it alive
+func (sessions *sessions) active(id string) (*rtc.Session, error) {
+	session, err := sessions.cache.KeepAlive(id, sessions.config.IdleTimeout)
 	if err != nil {
+		return nil, err
+	}
+	session.Touch()
+	return session, nil
+}
+
+// session returns the active session if the token of the client allows it, it is called on every RPC call of the session
+func (sessions *sessions) session(id string, context *websocket.Context) (*rtc.Session, error) {
+	if err := authorize(context, id, false); err != nil {
+		return nil, err
+	}
+	return sessions.active(id)
+}
+
+// get returns the active session, a session known by the registry only is rebuilt after a restart of the server
+func (sessions *sessions) get(id string) (*rtc.Session, error) {
+	session, err := sessions.active(id)
+	if err != cache.NotFoundError || sessions.registry == nil {
+		return session, err
+	}
+	info, err := sessions.registry.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	session = rtc.NewSession(id, &sessions.signaling)
+	session.SetModerator(info.OwnerId)
+	session.Lock(info.Settings.Locked)
+	if err = sessions.add(session); err != nil {
+		// restored concurrently by another participant
+		return sessions.active(id)
+	}
+	log.Info().Str("session", id).Msg("Session Restored")
+	return session, nil
+}
+
+// evict closes the session removed from the cache when it has been idle or the cache is full
+func (sessions *sessions) evict(session *rtc.Session) {
+	log.Info().Str("session", session.Id).Time("lastActivity", session.LastActivity()).Msg("Session Evicted")
+	sessions.close(session, "")
+}
+
+// Join adds the client to the session, the client presenting the owner key of the session becomes its moderator
+// unless the moderator is a participant, e.g. when the owner joins a session restored after a restart
+func (sessions *sessions) Join(id string, sdpTypeStr string, sdp string, ownerKey string, context *websocket.Context) (answer rtc.SessionDesc, err error) {
+	if err = authorize(context, id, false); err != nil {
 		return
 	}
-	session, err := sessions.cache.Get(id)
+	sdpType, err := toSDPType(sdpTypeStr)
 	if err != nil {
 		return
 	}
-	if session.IsConnected() {
-		err = errors.New("the callee is already connected")
+	session, err := sessions.get(id)
+	if err != nil {
 		return
 	}
-	result, err := session.Connect(&webrtc.SessionDescription{Type: sdpType, SDP: sdp})
+	if ownerKey != "" {
+		// the moderator may join a locked session
+		if err = sessions.claimModeration(session, ownerKey, context.ClientID); err != nil {
+			return
+		}
+	}
+	result, err := session.Join(context.ClientID, &webrtc.SessionDescription{Type: sdpType, SDP: sdp})
 	if err != nil {
 		return
 	}
-	answer.Type = result.Type.String()
-	answer.Sdp = result.SDP
+	sessions.members.Store(context.ClientID, id)
+	sessions.extendRegistry(id)
+	sessions.signaling.notify(Event{Type: ParticipantJoinedEvent, SessionId: id, ClientId: context.ClientID}, context.ClientID, session.PeerIds())
+	answer = rtc.NewSessionDesc(result)
 	return
 }
 
-func (sessions *sessions) Close(id string) {
+// Close closes the session for every participant, only the moderator is allowed to close it
+func (sessions *sessions) Close(id string, context *websocket.Context) error {
+	if err := authorize(context, id, false); err != nil {
+		return err
+	}
 	session, err := sessions.cache.Get(id)
 	if err != nil {
-		log.Debug().Err(err).Msg("Session Close; Get")
-		return
+		return err
 	}
-	session.Close()
-	err = sessions.cache.Delete(id)
-	if err != nil {
+	if !session.IsModerator(context.ClientID) {
+		return notModeratorError(context.ClientID)
+	}
+	sessions.remove(session, context.ClientID)
+	return nil
+}
+
+// remove closes the session and removes it from the cache, the excluded client is not notified
+func (sessions *sessions) remove(session *rtc.Session, excluded string) {
+	sessions.close(session, excluded)
+	if err := sessions.cache.Delete(session.Id); err != nil {
 		log.Error().Err(err).Msg("Session Close; Delete")
 	}
 }
 
-func (sessions *sessions) Leave(id string) {
+// close closes the session, forgets it in the registry and notifies the participants except the excluded one,
+// e.g. the moderator closing the session
+func (sessions *sessions) close(session *rtc.Session, excluded string) {
+	peerIds := session.PeerIds()
+	session.Close()
+	if sessions.registry != nil {
+		if err := sessions.registry.Delete(session.Id); err != nil {
+			log.Error().Err(err).Msg("Session Close; Registry Delete")
+		}
+	}
+	for _, peerId := range peerIds {
+		sessions.members.Delete(peerId)
+	}
+	sessions.signaling.notify(Event{Type: SessionClosedEvent, SessionId: session.Id}, excluded, peerIds)
+}
+
+// Leave removes the client from the session, the client is removed on disconnect anyway if the token has expired
+func (sessions *sessions) Leave(id string, context *websocket.Context) {
+	if err := authorize(context, id, false); err != nil {
+		log.Debug().Err(err).Msg("Session Leave; Authorize")
+		return
+	}
+	sessions.leave(id, context.ClientID)
+}
+
+func (sessions *sessions) leave(id string, clientID string) {
+	sessions.members.Delete(clientID)
 	session, err := sessions.cache.Get(id)
 	if err != nil {
 		log.Debug().Err(err).Msg("Session Leave; Get")
 		return
 	}
-	session.Leave()
+	session.Leave(clientID)
+	sessions.signaling.notify(Event{Type: ParticipantLeftEvent, SessionId: id, ClientId: clientID}, clientID, session.PeerIds())
+}
+
+func (sessions *sessions) AddCandidate(id string, candidate string, sdpMid string, sdpMLineIndex uint16, context *websocket.Context) error {
+	session, err := sessions.session(id, context)
+	if err != nil {
+		return err
+	}
+	return session.AddICECandidate(context.ClientID, rtc.IceCandidate{Candidate: candidate, SdpMid: sdpMid, SdpMLineIndex: sdpMLineIndex})
+}
+
+// Negotiate renegotiates the session on the client initiative, e.g. when a screen sharing track is added
+func (sessions *sessions) Negotiate(id string, sdpTypeStr string, sdp string, context *websocket.Context) (answer rtc.SessionDesc, err error) {
+	sdpType, err := toSDPType(sdpTypeStr)
+	if err != nil {
+		return
+	}
+	session, err := sessions.session(id, context)
+	if err != nil {
+		return
+	}
+	result, err := session.Negotiate(context.ClientID, &webrtc.SessionDescription{Type: sdpType, SDP: sdp})
+	if err != nil {
+		return
+	}
+	answer = rtc.NewSessionDesc(result)
+	return
+}
+
+// Answer completes the renegotiation initiated by the server
+func (sessions *sessions) Answer(id string, sdpTypeStr string, sdp string, context *websocket.Context) error {
+	sdpType, err := toSDPType(sdpTypeStr)
+	if err != nil {
+		return err
+	}
+	session, err := sessions.session(id, context)
+	if err != nil {
+		return err
+	}
+	return session.Answer(context.ClientID, &webrtc.SessionDescription{Type: sdpType, SDP: sdp})
+}
+
+// StartRecording records the tracks of every participant, the participants are notified along with the tracks
+// which cannot be recorded
+func (sessions *sessions) StartRecording(id string, context *websocket.Context) error {
+	session, err := sessions.participantSession(id, context)
+	if err != nil {
+		return err
+	}
+	skipped, err := session.StartRecording(sessions.config.RecordDir)
+	if err != nil {
+		return err
+	}
+	sessions.signaling.notify(Event{Type: RecordingStartedEvent, SessionId: id, ClientId: context.ClientID, Skipped: skipped}, "", session.PeerIds())
+	return nil
+}
+
+// StopRecording stops the recording of the session, the participants are notified
+func (sessions *sessions) StopRecording(id string, context *websocket.Context) error {
+	session, err := sessions.participantSession(id, context)
+	if err != nil {
+		return err
+	}
+	err = session.StopRecording()
+	if err != nil {
+		return err
+	}
+	sessions.signaling.notify(Event{Type: RecordingStoppedEvent, SessionId: id, ClientId: context.ClientID}, "", session.PeerIds())
+	return nil
+}
+
+// ICEServers returns the STUN and TURN servers the client should use, the TURN credentials are time-limited
+func (sessions *sessions) ICEServers(context *websocket.Context) ([]rtc.ICEServer, error) {
+	if err := checkExpiration(context); err != nil {
+		return nil, err
+	}
+	return rtc.ICEServers(context.ClientID), nil
+}
+
+// SetVideoSize reports the size of the tile the client renders the track in, the simulcast layer is chosen to fit it
+func (sessions *sessions) SetVideoSize(id string, trackId string, width int, height int, context *websocket.Context) error {
+	session, err := sessions.session(id, context)
+	if err != nil {
+		return err
+	}
+	return session.SetVideoSize(context.ClientID, trackId, width, height)
+}
+
+// Mute stops or resumes forwarding of the audio or video published by the participant, the participants are notified
+func (sessions *sessions) Mute(id string, clientId string, kind string, muted bool, context *websocket.Context) error {
+	codecType := webrtc.NewRTPCodecType(kind)
+	if codecType == 0 {
+		return errors.New("Unknown track kind: " + kind)
+	}
+	session, err := sessions.moderatedSession(id, context)
+	if err != nil {
+		return err
+	}
+	if err = session.Mute(clientId, codecType, muted); err != nil {
+		return err
+	}
+	eventType := ParticipantUnmutedEvent
+	if muted {
+		eventType = ParticipantMutedEvent
+	}
+	sessions.signaling.notify(Event{Type: eventType, SessionId: id, ClientId: clientId, Kind: codecType.String()}, "", session.PeerIds())
+	return nil
+}
+
+// Kick removes the participant from the session, the participant is notified along with the others
+func (sessions *sessions) Kick(id string, clientId string, context *websocket.Context) error {
+	session, err := sessions.moderatedSession(id, context)
+	if err != nil {
+		return err
+	}
+	if clientId == context.ClientID {
+		return errors.New("moderator '" + clientId + "' cannot kick itself")
+	}
+	if !session.HasPeer(clientId) {
+		return errors.New("client '" + clientId + "' is not a participant of the session")
+	}
+	peerIds := session.PeerIds()
+	sessions.members.Delete(clientId)
+	session.Leave(clientId)
+	sessions.signaling.notify(Event{Type: ParticipantKickedEvent, SessionId: id, ClientId: clientId}, "", peerIds)
+	return nil
+}
+
+// Lock locks the session against new participants or unlocks it, the participants are notified
+func (sessions *sessions) Lock(id string, locked bool, context *websocket.Context) error {
+	session, err := sessions.moderatedSession(id, context)
+	if err != nil {
+		return err
+	}
+	session.Lock(locked)
+	sessions.updateRegistry(id, func(info *cache.SessionInfo) {
+		info.Settings.Locked = locked
+	})
+	eventType := SessionUnlockedEvent
+	if locked {
+		eventType = SessionLockedEvent
+	}
+	sessions.signaling.notify(Event{Type: eventType, SessionId: id, ClientId: context.ClientID}, "", session.PeerIds())
+	return nil
+}
+
+// TransferModeration passes the moderation to another participant, the participants are notified
+func (sessions *sessions) TransferModeration(id string, clientId string, context *websocket.Context) error {
+	session, err := sessions.moderatedSession(id, context)
+	if err != nil {
+		return err
+	}
+	if !session.HasPeer(clientId) {
+		return errors.New("client '" + clientId + "' is not a participant of the session")
+	}
+	session.SetModerator(clientId)
+	sessions.updateRegistry(id, func(info *cache.SessionInfo) {
+		info.OwnerId = clientId
+	})
+	sessions.signaling.notify(Event{Type: ModeratorChangedEvent, SessionId: id, ClientId: clientId}, "", session.PeerIds())
+	return nil
+}
+
+// claimModeration passes the moderation to the client presenting the owner key if the moderator is absent
+func (sessions *sessions) claimModeration(session *rtc.Session, ownerKey string, clientID string) error {
+	if sessions.registry == nil {
+		return nil
+	}
+	info, err := sessions.registry.Get(session.Id)
+	if err != nil {
+		return err
+	}
+	if !info.IsOwnerKey(ownerKey) {
+		return errors.New("owner key of session '" + session.Id + "' is not valid")
+	}
+	if !session.ClaimModeration(clientID) {
+		return nil
+	}
+	sessions.updateRegistry(session.Id, func(info *cache.SessionInfo) {
+		info.OwnerId = clientID
+	})
+	log.Info().Str("session", session.Id).Str("client", clientID).Msg("Session Moderation Claimed")
+	// the client is notified along with the participants, it has not joined yet
+	sessions.signaling.notify(Event{Type: ModeratorChangedEvent, SessionId: session.Id, ClientId: clientID}, "",
+		append(session.PeerIds(), clientID))
+	return nil
+}
+
+// moderatedSession returns the session if the client moderates it
+func (sessions *sessions) moderatedSession(id string, context *websocket.Context) (*rtc.Session, error) {
+	session, err := sessions.session(id, context)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsModerator(context.ClientID) {
+		return nil, notModeratorError(context.ClientID)
+	}
+	return session, nil
+}
+
+func notModeratorError(clientID string) error {
+	return errors.New("client '" + clientID + "' is not the moderator of the session")
+}
+
+// updateRegistry stores the changed metadata of the session if the registry is enabled
+func (sessions *sessions) updateRegistry(id string, update func(info *cache.SessionInfo)) {
+	if sessions.registry == nil {
+		return
+	}
+	info, err := sessions.registry.Get(id)
+	if err == nil {
+		update(&info)
+		err = sessions.registry.Put(info)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("Session Update; Registry")
+	}
+}
+
+// extendRegistry keeps the session in the registry for the expiration since its last join or activity
+func (sessions *sessions) extendRegistry(id string) {
+	sessions.updateRegistry(id, func(info *cache.SessionInfo) {
+		info.Expiration = time.Now().Add(sessions.config.RegistryExpiration)
+	})
+}
+
+// authorize checks the token claims of the client creating the session or calling it,
+// every client is authorized if the authentication is disabled
+func authorize(context *websocket.Context, id string, create bool) error {
+	if err := checkExpiration(context); err != nil {
+		return err
+	}
+	claims := context.Claims
+	if claims == nil {
+		return nil
+	}
+	if create && !claims.IsHost() {
+		return errors.New("client '" + context.ClientID + "' is not allowed to create sessions")
+	}
+	if !claims.AllowsRoom(id) {
+		return errors.New("client '" + context.ClientID + "' is not allowed to access session '" + id + "'")
+	}
+	return nil
+}
+
+// checkExpiration refuses the client whose token has expired since the connection
+func checkExpiration(context *websocket.Context) error {
+	if context.Claims != nil && context.Claims.IsExpired(time.Now()) {
+		return errors.New("token of client '" + context.ClientID + "' has expired")
+	}
+	return nil
 }
 
+// participantSession returns the session if the client has joined it
+func (sessions *sessions) participantSession(id string, context *websocket.Context) (*rtc.Session, error) {
+	session, err := sessions.session(id, context)
+	if err != nil {
+		return nil, err
+	}
+	if !session.HasPeer(context.ClientID) {
+		return nil, errors.New("client '" + context.ClientID + "' is not a participant of the session")
+	}
+	return session, nil
+}
+
+func (signaling *signaling) SendCandidate(peerId string, candidate rtc.IceCandidate) {
+	signaling.service.Notify(candidateTopic, candidate, peerId)
+}
+
+func (signaling *signaling) SendOffer(peerId string, offer rtc.SessionDesc) {
+	signaling.service.Notify(offerTopic, offer, peerId)
+}
+
+func (signaling *signaling) SendError(peerId string, err error) {
+	signaling.service.Notify(eventTopic, Event{Type: ErrorEvent, ClientId: peerId, Error: err.Error()}, peerId)
+}
+
+func (signaling *signaling) SendDominantSpeaker(peerId string, speakerId string, streamId string) {
+	signaling.service.Notify(eventTopic, Event{Type: DominantSpeakerChangedEvent, ClientId: speakerId, StreamId: streamId}, peerId)
+}
+
+// Authorize reports whether the client is connected to the service, e.g. to allocate relays of the embedded TURN server
+func Authorize(clientID string) bool {
+	if _sessions.signaling.service == nil {
+		return false
+	}
+	_, ok := _sessions.signaling.service.Context(clientID)
+	return ok
+}
+
+// onClientDisconnect removes the client from its session, the session is closed when its moderator disconnects
 func onClientDisconnect(clientID string) {
-	session, err := _sessions.cache.Get(clientID)
+	id, ok := _sessions.members.Load(clientID)
+	if !ok {
+		return
+	}
+	session, err := _sessions.cache.Get(id.(string))
 	if err != nil {
+		_sessions.members.Delete(clientID)
 		log.Debug().Err(err).Msg("Client Disconnect; Session Get")
 		return
 	}
-	session.Close()
-	err = _sessions.cache.Delete(clientID)
-	if err != nil {
-		log.Error().Err(err).Msg("Client Disconnect; Session Delete")
+	if session.IsModerator(clientID) {
+		_sessions.remove(session, "")
+		return
 	}
+	_sessions.leave(id.(string), clientID)
 }
 
 var (
-	_sessions = sessions{cache.New(100_000, 10, time.Hour, time.Hour, nil)}
+	_sessions sessions
 )
 
+// initSessions creates the cache of sessions, idle sessions are evicted and closed
+func initSessions(config Config, registry *cache.Registry) {
+	_sessions.config = config
+	_sessions.registry = registry
+	_sessions.cache = cache.New[string, *rtc.Session](config.Cache.Size, config.Cache.Buckets, config.IdleTimeout,
+		config.Cache.CleanupInterval, config.Cache.Policy, _sessions.evict)
+}
+
 func API() Sessions {
 	return &_sessions
 }
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pion/webrtc/v3"
+
+	"video-chat/api/cache"
+	"video-chat/api/rpc/websocket"
+	"video-chat/api/rtc"
+)
+
+func newTestSessions(t *testing.T) *sessions {
+	t.Helper()
+	registry, err := cache.OpenRegistry(filepath.Join(t.TempDir(), "sessions.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = registry.Close() })
+	s := &sessions{registry: registry, config: DefaultConfig(), signaling: signaling{service: websocket.NewService(nil, nil)}}
+	s.cache = cache.New[string, *rtc.Session](16, 1, s.config.IdleTimeout, time.Hour, cache.Reject, func(*rtc.Session) {})
+	return s
+}
+
+func TestSessions_RestoreModerator(t *testing.T) {
+	s := newTestSessions(t)
+	ownerKey, err := newOwnerKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	// the client id of the owner before the restart is never presented again
+	err = s.registry.Put(cache.SessionInfo{Id: "session-1", OwnerId: "owner-before-restart", OwnerKey: cache.OwnerKeyHash(ownerKey),
+		Created: now, Expiration: now.Add(time.Hour), Settings: cache.RoomSettings{Locked: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := s.get("session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !session.IsModerator("owner-before-restart") || !session.IsLocked() {
+		t.Fatal("session state not restored")
+	}
+	if err = s.claimModeration(session, "wrong-key", "intruder"); err == nil || session.IsModerator("intruder") {
+		t.Fatal("moderation passed by a wrong key")
+	}
+	if err = s.claimModeration(session, ownerKey, "owner"); err != nil {
+		t.Fatal(err)
+	}
+	if !session.IsModerator("owner") {
+		t.Fatal("moderation not restored to the owner")
+	}
+	info, err := s.registry.Get("session-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.OwnerId != "owner" {
+		t.Fatal("moderator not persisted", info.OwnerId)
+	}
+}
+
+func TestSessions_ClaimsOfEveryCall(t *testing.T) {
+	s := newTestSessions(t)
+	session := rtc.NewSession("room-1", &s.signaling)
+	session.SetModerator("host-1")
+	if err := s.add(session); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	claims := &websocket.Claims{Subject: "host-1", Role: websocket.HostRole, ExpiresAt: now.Add(time.Hour).Unix(), Rooms: []string{"room-2"}}
+	context := &websocket.Context{ClientID: "host-1", Claims: claims}
+
+	// the moderator of the session is not allowed to access it by the token
+	if err := s.Lock("room-1", true, context); err == nil || session.IsLocked() {
+		t.Fatal("session moderated without the room in the token")
+	}
+	claims.Rooms = []string{"room-1"}
+	if err := s.Lock("room-1", true, context); err != nil || !session.IsLocked() {
+		t.Fatal("session not moderated", err)
+	}
+	claims.ExpiresAt = now.Add(-time.Hour).Unix()
+	if err := s.Lock("room-1", false, context); err == nil || !session.IsLocked() {
+		t.Fatal("session moderated with an expired token")
+	}
+	if _, err := s.ICEServers(context); err == nil {
+		t.Fatal("ICE servers returned for an expired token")
+	}
+}
+
+// newTestOffer returns the offer of a client connection publishing an audio track
+func newTestOffer(t *testing.T) (*webrtc.PeerConnection, webrtc.SessionDescription) {
+	t.Helper()
+	client, err := webrtc.NewPeerConnection(webrtc.Configuration{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	if _, err = client.AddTransceiverFromKind(webrtc.RTPCodecTypeAudio); err != nil {
+		t.Fatal(err)
+	}
+	offer, err := client.CreateOffer(nil)
+	if err == nil {
+		err = client.SetLocalDescription(offer)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, offer
+}
+
+func TestSessions_New_RoomOfToken(t *testing.T) {
+	s := newTestSessions(t)
+	claims := &websocket.Claims{Subject: "host-1", Role: websocket.HostRole, ExpiresAt: time.Now().Add(time.Hour).Unix(), Rooms: []string{"room-1"}}
+	context := &websocket.Context{ClientID: "host-1", Claims: claims}
+	client, offer := newTestOffer(t)
+
+	answer, err := s.New("room-1", offer.Type.String(), offer.SDP, context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err := s.cache.Get("room-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !session.HasPeer("host-1") || !session.IsModerator("host-1") || session.HasPeer("room-1") {
+		t.Fatal("session not joined by its creator", session.PeerIds())
+	}
+	if id, ok := s.members.Load("host-1"); !ok || id != "room-1" {
+		t.Fatal("creator not a member of the session", id)
+	}
+	if info, err := s.registry.Get("room-1"); err != nil || info.OwnerId != "host-1" {
+		t.Fatal("creator not persisted as the owner", info.OwnerId, err)
+	}
+
+	// the creator renegotiates the session it has joined by the id of the room
+	if err = client.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeAnswer, SDP: answer.Sdp}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.AddTransceiverFromKind(webrtc.RTPCodecTypeVideo); err != nil {
+		t.Fatal(err)
+	}
+	offer, err = client.CreateOffer(nil)
+	if err == nil {
+		err = client.SetLocalDescription(offer)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := s.Negotiate("room-1", offer.Type.String(), offer.SDP, context)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = client.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeAnswer, SDP: result.Sdp}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = s.Close("room-1", context); err != nil {
+		t.Fatal(err)
+	}
+	if !session.IsStopped() || s.exists("room-1") {
+		t.Fatal("session not closed")
+	}
+	if _, ok := s.members.Load("host-1"); ok {
+		t.Fatal("creator still a member of the closed session")
+	}
+}
+
+func TestSessions_New_Refused(t *testing.T) {
+	s := newTestSessions(t)
+	now := time.Now()
+	if err := s.registry.Put(cache.SessionInfo{Id: "room-1", OwnerId: "host-0", Created: now, Expiration: now.Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	claims := func(role string, rooms ...string) *websocket.Claims {
+		return &websocket.Claims{Subject: "client-1", Role: role, ExpiresAt: now.Add(time.Hour).Unix(), Rooms: rooms}
+	}
+	tests := []struct {
+		name    string
+		id      string
+		context *websocket.Context
+		want    string
+	}{
+		{"guest", "room-2", &websocket.Context{ClientID: "client-1", Claims: claims(websocket.GuestRole)}, "not allowed to create"},
+		{"room not in the token", "room-2", &websocket.Context{ClientID: "client-1", Claims: claims(websocket.HostRole, "room-3")}, "not allowed to access"},
+		{"persisted room", "room-1", &websocket.Context{ClientID: "client-1", Claims: claims(websocket.HostRole)}, "already exists"},
+		{"empty id", "", &websocket.Context{ClientID: "client-1", Claims: claims(websocket.HostRole)}, "bytes long"},
+		{"control character", "room\n2", &websocket.Context{ClientID: "client-1", Claims: claims(websocket.HostRole)}, "control characters"},
+		{"chosen without a token", "room-2", &websocket.Context{ClientID: "client-1"}, "not the id of the client"},
+	}
+	for _, test := range tests {
+		if _, err := s.New(test.id, "offer", "", test.context); err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Fatal(test.name, err)
+		}
+	}
+}
+
+func TestSessions_ExtendRegistry(t *testing.T) {
+	s := newTestSessions(t)
+	created := time.Now().Add(-time.Hour)
+	err := s.registry.Put(cache.SessionInfo{Id: "session-1", OwnerId: "owner", Created: created, Expiration: time.Now().Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.extendRegistry("session-1")
+	info, err := s.registry.Get("session-1")
+	if err != nil {
+		t.Fatal("session expired", err)
+	}
+	if info.OwnerId != "owner" || !info.Created.Equal(created) {
+		t.Fatal("session metadata changed", info)
+	}
+	if time.Until(info.Expiration) < s.config.RegistryExpiration-time.Minute {
+		t.Fatal("expiration not extended", info.Expiration)
+	}
+}
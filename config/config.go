@@ -0,0 +1,165 @@
+package config
+
+import (
+	"encoding"
+	"errors"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"video-chat/api"
+	"video-chat/api/relay"
+	"video-chat/api/rpc"
+	"video-chat/api/rtc"
+)
+
+// EnvPrefix prefixes the environment variables overriding the configuration,
+// e.g. VICHAT_SERVER_BIND or VICHAT_SESSIONS_CACHE_SIZE
+const EnvPrefix = "VICHAT"
+
+// Config of the server
+type Config struct {
+	Server   rpc.Config `yaml:"server"`
+	WebRTC   rtc.Config `yaml:"webrtc"`
+	Sessions api.Config `yaml:"sessions"`
+	// Embedded STUN/TURN server
+	TURNServer relay.Config `yaml:"turnServer"`
+}
+
+func Default() Config {
+	return Config{
+		Server:     rpc.DefaultConfig(),
+		WebRTC:     rtc.DefaultConfig(),
+		Sessions:   api.DefaultConfig(),
+		TURNServer: relay.DefaultConfig(),
+	}
+}
+
+// Load reads the YAML file over the defaults and applies the environment variables,
+// the file is optional if the path is empty
+func Load(path string) (config Config, err error) {
+	config = Default()
+	if path != "" {
+		var file *os.File
+		file, err = os.Open(path)
+		if err != nil {
+			return
+		}
+		decoder := yaml.NewDecoder(file)
+		decoder.KnownFields(true)
+		err = decoder.Decode(&config)
+		_ = file.Close()
+		if err != nil {
+			err = errors.New("config '" + path + "': " + err.Error())
+			return
+		}
+	}
+	err = applyEnv(reflect.ValueOf(&config).Elem(), EnvPrefix)
+	config.resolve()
+	return
+}
+
+// resolve fills the settings derived from the others
+func (c *Config) resolve() {
+	// the participants are pointed to the embedded server unless other servers are configured
+	if c.TURNServer.Enabled {
+		stunURLs, turnURLs := c.TURNServer.URLs()
+		if len(c.WebRTC.STUNServers) == 0 {
+			c.WebRTC.STUNServers = stunURLs
+		}
+		if len(c.WebRTC.TURN.URLs) == 0 {
+			c.WebRTC.TURN.URLs = turnURLs
+		}
+	}
+}
+
+func (c *Config) Validate() error {
+	if err := c.Server.Validate(); err != nil {
+		return errors.New("server: " + err.Error())
+	}
+	if err := c.WebRTC.Validate(); err != nil {
+		return errors.New("webrtc: " + err.Error())
+	}
+	if err := c.Sessions.Validate(); err != nil {
+		return errors.New("sessions: " + err.Error())
+	}
+	if err := c.TURNServer.Validate(); err != nil {
+		return errors.New("turnServer: " + err.Error())
+	}
+	if c.TURNServer.Enabled && c.WebRTC.TURN.Secret == "" {
+		return errors.New("turnServer: webrtc TURN secret must be set")
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// applyEnv overrides the fields by the variables named after the YAML keys,
+// lists are separated by commas
+func applyEnv(value reflect.Value, prefix string) error {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		key, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("yaml"), ",")
+		if key == "" || key == "-" {
+			continue
+		}
+		name := prefix + "_" + strings.ToUpper(key)
+		if field.Kind() == reflect.Struct {
+			if err := applyEnv(field, name); err != nil {
+				return err
+			}
+			continue
+		}
+		env, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setField(field, env); err != nil {
+			return errors.New(name + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+func setField(field reflect.Value, env string) error {
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(env))
+	}
+	switch {
+	case field.Type() == durationType:
+		d, err := time.ParseDuration(env)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.String:
+		field.SetString(env)
+	case field.Kind() == reflect.Int:
+		n, err := strconv.Atoi(env)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case field.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(env)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String:
+		var values []string
+		for _, v := range strings.Split(env, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		field.Set(reflect.ValueOf(values))
+	default:
+		return errors.New("unsupported type " + field.Type().String())
+	}
+	return nil
+}
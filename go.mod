@@ -1,17 +1,46 @@
 module video-chat
 
-go 1.13
+go 1.24
 
 require (
 	github.com/JekaMas/pretty v0.0.0-20161213095928-bfc6f9ec9574
 	github.com/dchest/uniuri v0.0.0-20160212164326-8902c56451e9
-	github.com/dgryski/go-farm v0.0.0-20191112170834-c2139c5d712b
 	github.com/fasthttp/websocket v1.4.1
 	github.com/hprose/hprose-golang v2.0.4+incompatible
-	github.com/pion/rtcp v1.2.1
-	github.com/pion/rtp v1.2.0
-	github.com/pion/webrtc/v2 v2.1.18
+	github.com/pion/ice/v2 v2.3.38
+	github.com/pion/interceptor v0.1.29
+	github.com/pion/rtcp v1.2.14
+	github.com/pion/rtp v1.8.7
+	github.com/pion/sdp/v3 v3.0.9
+	github.com/pion/turn/v2 v2.1.6
+	github.com/pion/webrtc/v3 v3.3.6
 	github.com/rs/zerolog v1.17.2
-	github.com/satori/go.uuid v1.2.0
 	github.com/valyala/fasthttp v1.7.0
+	go.etcd.io/bbolt v1.3.8
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/google/uuid v1.3.1 // indirect
+	github.com/klauspost/compress v1.8.2 // indirect
+	github.com/klauspost/cpuid v1.2.1 // indirect
+	github.com/kr/text v0.2.0 // indirect
+	github.com/pion/datachannel v1.5.8 // indirect
+	github.com/pion/dtls/v2 v2.2.12 // indirect
+	github.com/pion/logging v0.2.2 // indirect
+	github.com/pion/mdns v0.0.12 // indirect
+	github.com/pion/randutil v0.1.0 // indirect
+	github.com/pion/sctp v1.8.19 // indirect
+	github.com/pion/srtp/v2 v2.0.20 // indirect
+	github.com/pion/stun v0.6.1 // indirect
+	github.com/pion/transport/v2 v2.2.10 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/savsgio/gotils v0.0.0-20190714152828-365999d0a274 // indirect
+	github.com/stretchr/testify v1.9.0 // indirect
+	github.com/valyala/bytebufferpool v1.0.0 // indirect
+	github.com/wlynxg/anet v0.0.3 // indirect
+	golang.org/x/crypto v0.21.0 // indirect
+	golang.org/x/net v0.22.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
 )
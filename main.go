@@ -3,28 +3,112 @@ package main
 import (
 	"flag"
 	"os"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 
 	"video-chat/api"
+	"video-chat/api/cache"
+	"video-chat/api/relay"
 	"video-chat/api/rpc"
+	"video-chat/api/rtc"
+	"video-chat/config"
 )
 
-var bindAddr string
+var (
+	configPath   string
+	bindAddr     string
+	recordDir    string
+	registryPath string
+	idleTimeout  time.Duration
+)
 
 func init() {
 	// init logger
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: "2006-01-02 15:04:05", NoColor: true})
+	flag.StringVar(&configPath, "config", "", "configuration file (YAML)")
 	flag.StringVar(&bindAddr, "bind", "0.0.0.0:8080", "binding address")
+	flag.StringVar(&recordDir, "records", "records", "directory of session recordings")
+	flag.StringVar(&registryPath, "registry", "", "file of the persistent session registry, sessions are not persisted if empty")
+	flag.DurationVar(&idleTimeout, "idle", 5*time.Minute, "sessions without media or signaling activity for the duration are closed")
 	flag.Parse()
 }
 
+// loadConfig loads the configuration file and the environment, the flags set explicitly take precedence
+func loadConfig() (config.Config, error) {
+	c, err := config.Load(configPath)
+	if err != nil {
+		return c, err
+	}
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "bind":
+			c.Server.Bind = bindAddr
+		case "records":
+			c.Sessions.RecordDir = recordDir
+		case "registry":
+			c.Sessions.Registry = registryPath
+		case "idle":
+			c.Sessions.IdleTimeout = idleTimeout
+		}
+	})
+	return c, c.Validate()
+}
+
 func main() {
+	// the deferred cleanup of run, e.g. the unlock of the registry file, is skipped by os.Exit
+	if err := run(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// run starts the servers and serves until the shutdown, the errors are logged
+func run() error {
 	log.Info().Msg("Starting..")
 
-	s := rpc.NewServer(api.NewRpcService())
-	if err := s.ListenAndServe(bindAddr); err != nil {
+	c, err := loadConfig()
+	if err != nil {
+		log.Error().Err(err).Msg("Config")
+		return err
+	}
+	if err = rtc.Init(c.WebRTC); err != nil {
+		log.Error().Err(err).Msg("WebRTC")
+		return err
+	}
+
+	var registry *cache.Registry
+	if c.Sessions.Registry != "" {
+		registry, err = cache.OpenRegistry(c.Sessions.Registry)
+		if err != nil {
+			log.Error().Err(err).Msg("Session Registry")
+			return err
+		}
+		defer func() {
+			if err := registry.Close(); err != nil {
+				log.Error().Err(err).Msg("Session Registry Close")
+			}
+		}()
+	}
+
+	if c.TURNServer.Enabled {
+		turnServer, err := relay.NewServer(c.TURNServer, c.WebRTC.TURN.Secret, api.Authorize)
+		if err != nil {
+			log.Error().Err(err).Msg("TURN Server")
+			return err
+		}
+		defer turnServer.Close()
+		log.Info().Str("listen", c.TURNServer.Listen).Msg("TURN Server")
+	}
+
+	s, err := rpc.NewServer(api.NewRpcService(c.Sessions, registry), c.Server)
+	if err != nil {
+		log.Error().Err(err).Msg("RPC Server")
+		return err
+	}
+	if err = s.ListenAndServe(); err != nil {
 		log.Error().Err(err).Msg("RPC Server")
+		return err
 	}
+	return nil
 }
@@ -1,64 +1,330 @@
 let _leaveSession = null
+let _peerConnection = null
+let _recordSession = null
+let _setVideoSize = null
+let _moderateSession = null
+let _isModerator = false
+
+// the token issued by the application is passed in the page URL, e.g. ?token=<token>, and presented on the upgrade;
+// the connection of a secure page is secure too
+function rpcUrl() {
+    const token = new URL(document.location).searchParams.get("token")
+    const scheme = document.location.protocol === "https:" ? "wss" : "ws"
+    return `${scheme}://${document.location.host}/` + (token ? `?token=${encodeURIComponent(token)}` : "")
+}
 
 async function initRpcClient() {
-    let client = hprose.WebSocketClient.create(`ws://${document.location.host}/`, {Sessions: ["New", "Join", "Close", "Leave"]},
+    let client = hprose.WebSocketClient.create(rpcUrl(), {Sessions: ["New", "Join", "Close", "Leave", "AddCandidate", "Negotiate", "Answer", "StartRecording", "StopRecording", "ICEServers", "SetVideoSize", "Mute", "Kick", "Lock", "TransferModeration"]},
         {timeout: 86400000, idempotent: true, simple: true})
     let client_id = await client["#"]()
     return {client, client_id}
 }
 
-async function newSession() {
+// the session id is the room chosen by the token issuer, the id of the client otherwise
+async function newSession(room, onRemoteStream, onEvent) {
     const {client, client_id} = await initRpcClient()
-    const {peerConnection, localStream, remoteStream} = await createPeerConnection(async desc => client.Sessions.New(client_id, desc.type, desc.sdp))
-    _leaveSession = async () => {
-        await client.Sessions.Close(client_id)
-        peerConnection.close()
-    }
-    return {client_id, localStream, remoteStream}
+    const session_id = room || client_id
+    subscribeEvents(client, client_id, onEvent)
+    // the remote videos report their size as soon as they are rendered
+    _setVideoSize = (trackId, width, height) => client.Sessions.SetVideoSize(session_id, trackId, width, height)
+    const {peerConnection, localStream} = await createPeerConnection(client, client_id, session_id, async desc => {
+        const answer = await client.Sessions.New(session_id, desc.type, desc.sdp)
+        if (answer.ownerKey) {
+            localStorage.setItem(ownerKeyItem(session_id), answer.ownerKey)
+        }
+        return {type: answer.type, sdp: answer.sdp}
+    }, onRemoteStream)
+    _recordSession = recording(client, session_id)
+    _moderateSession = moderation(client, session_id)
+    // the owner moderates the session until the moderation is transferred
+    _isModerator = true
+    _leaveSession = leaving(client, client_id, session_id, peerConnection)
+    return {client_id, session_id, localStream}
 }
 
-async function joinSession(session_id) {
+async function joinSession(session_id, onRemoteStream, onEvent) {
     const {client, client_id} = await initRpcClient()
-    const {peerConnection, localStream, remoteStream} = await createPeerConnection(async desc => client.Sessions.Join(session_id, desc.type, desc.sdp))
-    _leaveSession = async () => {
-        await client.Sessions.Leave(session_id)
+    subscribeEvents(client, client_id, onEvent)
+    // the remote videos report their size as soon as they are rendered
+    _setVideoSize = (trackId, width, height) => client.Sessions.SetVideoSize(session_id, trackId, width, height)
+    // the owner regains the moderation by the owner key, the moderator-changed event is pushed before the answer
+    _isModerator = false
+    const ownerKey = localStorage.getItem(ownerKeyItem(session_id)) || ""
+    const {peerConnection, localStream} = await createPeerConnection(client, client_id, session_id,
+        async desc => client.Sessions.Join(session_id, desc.type, desc.sdp, ownerKey), onRemoteStream)
+    _recordSession = recording(client, session_id)
+    _moderateSession = moderation(client, session_id)
+    _leaveSession = leaving(client, client_id, session_id, peerConnection)
+    return {client_id, localStream}
+}
+
+// the key of the persisted session owned by the browser
+function ownerKeyItem(session_id) {
+    return `vichat-owner-${session_id}`
+}
+
+// the moderator closes the session for everyone, the other participants leave it
+function leaving(client, client_id, session_id, peerConnection) {
+    return async () => {
+        client.unsubscribe("candidate", client_id)
+        client.unsubscribe("offer", client_id)
+        client.unsubscribe("event", client_id)
+        if (_isModerator) {
+            await client.Sessions.Close(session_id)
+            localStorage.removeItem(ownerKeyItem(session_id))
+        } else {
+            await client.Sessions.Leave(session_id)
+        }
         peerConnection.close()
     }
-    return {client_id, localStream, remoteStream}
+}
+
+// session events: participant-joined, participant-left, session-closed, recording-started, recording-stopped,
+// dominant-speaker-changed, participant-muted, participant-unmuted, participant-kicked, session-locked,
+// session-unlocked, moderator-changed and error
+function subscribeEvents(client, client_id, onEvent) {
+    client.subscribe("event", client_id, async event => {
+        if (!event) {
+            return
+        }
+        if (event.type === "moderator-changed") {
+            _isModerator = event.clientId === client_id
+        }
+        if (event.type === "session-closed") {
+            localStorage.removeItem(ownerKeyItem(event.sessionId))
+        }
+        const removed = event.type === "session-closed" || event.type === "participant-kicked" && event.clientId === client_id
+        if (removed && _peerConnection !== null) {
+            _peerConnection.close()
+            resetSession()
+        }
+        onEvent(event)
+    })
 }
 
 async function leaveSession() {
     if (_leaveSession !== null) {
         await _leaveSession()
-        _leaveSession = null
+        resetSession()
+    }
+}
+
+function resetSession() {
+    _peerConnection = null
+    _leaveSession = null
+    _recordSession = null
+    _setVideoSize = null
+    _moderateSession = null
+    _isModerator = false
+}
+
+function recording(client, session_id) {
+    return async start => start ? client.Sessions.StartRecording(session_id) : client.Sessions.StopRecording(session_id)
+}
+
+// starts or stops the recording of the session, every participant is notified
+async function recordSession(start) {
+    if (_recordSession !== null) {
+        await _recordSession(start)
+    }
+}
+
+// the moderator controls, the server refuses them to the other participants
+function moderation(client, session_id) {
+    return {
+        mute: async (clientId, kind, muted) => client.Sessions.Mute(session_id, clientId, kind, muted),
+        kick: async clientId => client.Sessions.Kick(session_id, clientId),
+        lock: async locked => client.Sessions.Lock(session_id, locked),
+        transfer: async clientId => client.Sessions.TransferModeration(session_id, clientId)
+    }
+}
+
+// stops or resumes forwarding of the participant audio or video, the kind is "audio" or "video"
+async function muteParticipant(clientId, kind, muted) {
+    if (_moderateSession !== null) {
+        await _moderateSession.mute(clientId, kind, muted)
+    }
+}
+
+// removes the participant from the session
+async function kickParticipant(clientId) {
+    if (_moderateSession !== null) {
+        await _moderateSession.kick(clientId)
+    }
+}
+
+// locks the session against new participants or unlocks it
+async function lockSession(locked) {
+    if (_moderateSession !== null) {
+        await _moderateSession.lock(locked)
+    }
+}
+
+// passes the moderation of the session to another participant
+async function transferModeration(clientId) {
+    if (_moderateSession !== null) {
+        await _moderateSession.transfer(clientId)
+    }
+}
+
+// reports the size of the video element to the server, so the simulcast layer fitting the element is forwarded
+function watchVideoSize(video) {
+    new ResizeObserver(() => {
+        if (_setVideoSize === null || video.srcObject === null) {
+            return
+        }
+        const width = Math.round(video.clientWidth * window.devicePixelRatio)
+        const height = Math.round(video.clientHeight * window.devicePixelRatio)
+        video.srcObject.getVideoTracks().forEach(track =>
+            _setVideoSize(track.id, width, height).catch(e => console.error("video size", e)))
+    }).observe(video)
+}
+
+// adds the screen track to the session, the track is negotiated on the fly
+async function shareScreen() {
+    if (_peerConnection !== null) {
+        let screenStream = await navigator.mediaDevices.getDisplayMedia({video: true})
+        screenStream.getTracks().forEach(track => _peerConnection.addTransceiver(track, {streams: [screenStream], direction: "sendonly"}))
     }
 }
 
-async function createPeerConnection(getRemoteDescription) {
-    const configuration = {iceServers: [{urls: "stun:stun.l.google.com:19302"}]} // RTCConfiguration
-    const mediaConstraints = {video: {width: 640, height: 480, frameRate: 30}, audio: true} // MediaStreamConstraints
+async function createPeerConnection(client, client_id, session_id, getRemoteDescription, onRemoteStream) {
+    const configuration = {iceServers: await client.Sessions.ICEServers()} // RTCConfiguration
+    const mediaConstraints = {video: {width: 1280, height: 720, frameRate: 30}, audio: true} // MediaStreamConstraints
+    // the camera is published in three layers, the server forwards the layer fitting each subscriber
+    const sendEncodings = [
+        {rid: "q", scaleResolutionDownBy: 4, maxBitrate: 150000},
+        {rid: "h", scaleResolutionDownBy: 2, maxBitrate: 500000},
+        {rid: "f", maxBitrate: 1500000},
+    ]
     let peerConnection = new RTCPeerConnection(configuration)
+    let startTrickle = trickleCandidates(client, client_id, session_id, peerConnection)
+    let startRenegotiation = renegotiation(client, client_id, session_id, peerConnection)
 
     let localStream = await navigator.mediaDevices.getUserMedia(mediaConstraints)
-    localStream.getTracks().forEach(track => peerConnection.addTransceiver(track, {streams: [localStream], direction: "sendrecv"}))
+    localStream.getTracks().forEach(track => peerConnection.addTransceiver(track, track.kind === "video" ?
+        {streams: [localStream], direction: "sendrecv", sendEncodings} : {streams: [localStream], direction: "sendrecv"}))
+    peerConnection.ontrack = onTrack.bind(peerConnection, new Map(), onRemoteStream)
 
     let offer = await peerConnection.createOffer()
+    await peerConnection.setLocalDescription(offer)
 
     let desc = await getRemoteDescription(offer)
-    peerConnection.onicecandidate = onICECandidate.bind(peerConnection, desc)
-    let remoteStream = new MediaStream()
-    peerConnection.ontrack = onTrack.bind(peerConnection, remoteStream)
+    await peerConnection.setRemoteDescription(desc)
+    await startTrickle()
+    startRenegotiation()
+    _peerConnection = peerConnection
+    return {peerConnection, localStream}
+}
 
-    await peerConnection.setLocalDescription(offer)
-    return {peerConnection, localStream, remoteStream}
+// serializes renegotiations initiated by the server and by the browser; when both sides
+// renegotiate at once the server offer takes precedence and the browser offer is rolled back
+function renegotiation(client, client_id, session_id, peerConnection) {
+    let queue = null
+    let pending = []
+    const enqueue = task => {
+        queue = queue.then(task).catch(e => console.error("renegotiation", e))
+    }
+
+    client.subscribe("offer", client_id, offer => {
+        if (!offer) {
+            return
+        }
+        const task = async () => {
+            await peerConnection.setRemoteDescription(offer)
+            await peerConnection.setLocalDescription(await peerConnection.createAnswer())
+            const answer = peerConnection.localDescription
+            await client.Sessions.Answer(session_id, answer.type, answer.sdp)
+        }
+        if (queue === null) {
+            pending.push(task)
+        } else {
+            enqueue(task)
+        }
+    })
+    peerConnection.onnegotiationneeded = () => {
+        // the initial offer is negotiated by the session creation or joining
+        if (queue === null) {
+            return
+        }
+        enqueue(async () => {
+            const offer = await peerConnection.createOffer()
+            await peerConnection.setLocalDescription(offer)
+            let answer
+            try {
+                answer = await client.Sessions.Negotiate(session_id, offer.type, offer.sdp)
+            } catch (e) {
+                if (!isGlare(e)) {
+                    throw e
+                }
+                // glare, the pending server offer is going to be applied next
+                await peerConnection.setLocalDescription({type: "rollback"})
+                return
+            }
+            await peerConnection.setRemoteDescription(answer)
+        })
+    }
+    return () => {
+        queue = Promise.resolve()
+        pending.forEach(enqueue)
+        pending = []
+    }
+}
+
+// the error returned by the server when the browser offer collides with a pending server offer,
+// see rtc.GlareError
+function isGlare(e) {
+    return String(e && e.message || e).startsWith("negotiation glare")
 }
 
-async function onICECandidate(desc, event) {
-    if (event.candidate === null) {
-        await this.setRemoteDescription(desc)
+// exchanges ICE candidates with the server as soon as they are gathered,
+// candidates are queued until the session is established on both sides
+function trickleCandidates(client, client_id, session_id, peerConnection) {
+    let started = false
+    let localCandidates = []
+    let remoteCandidates = []
+    const sendCandidate = candidate => client.Sessions.AddCandidate(session_id, candidate.candidate, candidate.sdpMid, candidate.sdpMLineIndex)
+
+    peerConnection.onicecandidate = async event => {
+        if (event.candidate === null || event.candidate.candidate === "") {
+            return
+        }
+        if (started) {
+            await sendCandidate(event.candidate)
+        } else {
+            localCandidates.push(event.candidate)
+        }
+    }
+    client.subscribe("candidate", client_id, async candidate => {
+        if (!candidate) {
+            return
+        }
+        if (started) {
+            await peerConnection.addIceCandidate(candidate)
+        } else {
+            remoteCandidates.push(candidate)
+        }
+    })
+    return async () => {
+        started = true
+        await Promise.all(remoteCandidates.map(candidate => peerConnection.addIceCandidate(candidate)))
+        await Promise.all(localCandidates.map(sendCandidate))
+        localCandidates = []
+        remoteCandidates = []
     }
 }
 
-function onTrack(remoteStream, event) {
-    event.streams.forEach(stream => stream.getTracks().forEach(track => remoteStream.addTrack(track)))
+// every participant is delivered as a separate stream
+function onTrack(remoteStreams, onRemoteStream, event) {
+    event.streams.forEach(stream => {
+        if (!remoteStreams.has(stream.id)) {
+            remoteStreams.set(stream.id, stream)
+            stream.addEventListener("removetrack", () => {
+                if (stream.getTracks().length === 0) {
+                    remoteStreams.delete(stream.id)
+                }
+            })
+            onRemoteStream(stream)
+        }
+    })
 }